@@ -318,9 +318,38 @@ export const downloadPaymentReceipt = async (paymentId: string | number) => {
   window.URL.revokeObjectURL(url);
 };
 
-// Descargar libro de ventas pendientes en PDF
-export const downloadSalesBook = async () => {
-  const response = await api.get('/api/pdf/libro-ventas', {
+// Reporte generado en segundo plano (POST /api/reports/jobs)
+export interface ReportJob {
+  id: string;
+  type: string;
+  status: 'queued' | 'running' | 'done' | 'failed';
+  processed: number;
+  total: number;
+  errors: number;
+  error?: string;
+  size?: number;
+  created_at: string;
+  expires_at: string | null;
+}
+
+const REPORT_POLL_INTERVAL = 2000;
+
+// Descargar libro de ventas pendientes en PDF: se encola el reporte y se consulta hasta que termina
+export const downloadSalesBook = async (onProgress?: (job: ReportJob) => void) => {
+  let { data: job } = await api.post<ReportJob>('/api/reports/jobs', { type: 'sales_book' });
+
+  while (job.status === 'queued' || job.status === 'running') {
+    onProgress?.(job);
+    await new Promise((resolve) => setTimeout(resolve, REPORT_POLL_INTERVAL));
+    ({ data: job } = await api.get<ReportJob>(`/api/reports/jobs/${job.id}`));
+  }
+
+  if (job.status === 'failed') {
+    throw new Error(job.error || 'No se pudo generar el libro de ventas');
+  }
+  onProgress?.(job);
+
+  const response = await api.get(`/api/reports/jobs/${job.id}/download`, {
     responseType: 'blob',
   });
   // Crear un enlace para descargar el archivo
@@ -333,6 +362,42 @@ export const downloadSalesBook = async () => {
   link.parentNode?.removeChild(link);
 };
 
+export interface Collector {
+  id: number;
+  username: string;
+  firstName: string;
+  lastName: string;
+  is_active: boolean;
+  commission_rate: number;
+  clients_count: number;
+}
+
+// Filtro de las tarjetas de cobro: ventas puntuales, o las pendientes de un cobrador y/o una zona
+export interface CollectionCardsFilter {
+  saleIds?: number[];
+  collectorId?: number;
+  zone?: string;
+}
+
+// Descargar tarjetas de cobro; el servidor exige al menos un filtro
+export const downloadCollectionCards = async ({ saleIds, collectorId, zone }: CollectionCardsFilter) => {
+  const params = saleIds?.length
+    ? { sale_ids: saleIds.join(',') }
+    : { collector_id: collectorId || undefined, zone: zone?.trim() || undefined };
+  const response = await api.get('/api/pdf/tarjetas-cobro', {
+    params,
+    responseType: 'blob',
+  });
+  // Crear un enlace para descargar el archivo
+  const url = window.URL.createObjectURL(new Blob([response.data], { type: 'application/pdf' }));
+  const link = document.createElement('a');
+  link.href = url;
+  link.setAttribute('download', 'tarjetas_cobro.pdf');
+  document.body.appendChild(link);
+  link.click();
+  link.parentNode?.removeChild(link);
+};
+
 // Auth API functions
 export interface User {
   id: number;
@@ -1,6 +1,7 @@
 import React, { createContext, useContext, useEffect, useRef } from 'react';
 import { useAuth } from '../shared/hooks/useAuth';
 import { useIdleWithWarning } from '../shared/hooks/useIdleWithWarning';
+import { useLiveEvents } from '../shared/hooks/useLiveEvents';
 import { IdleWarning, UnauthorizedPage, UnauthenticatedPage } from '../shared/components/auth';
 
 // Crear contexto de autenticación
@@ -25,6 +26,9 @@ export function AuthProvider({ children }: AuthProviderProps) {
     },
   });
 
+  // Stream de eventos en vivo mientras haya sesión
+  useLiveEvents(auth.isAuthenticated);
+
   // Manejar el estado de autenticación sin causar loops infinitos
   useEffect(() => {
     if (auth.isAuthenticated && !wasAuthenticatedRef.current) {
@@ -55,6 +55,14 @@ export default function UserCard({ user, onEdit, onToggleActive, onResetPassword
       );
     }
 
+    if (permissions & PERMISSIONS.COBRADOR) {
+      chips.push(
+        <Chip key="cobrador" size="sm" color="default" variant="flat">
+          Cobrador
+        </Chip>
+      );
+    }
+
     if (permissions & PERMISSIONS.USUARIOS) {
       chips.push(
         <Chip key="usuarios" size="sm" color="danger" variant="flat">
@@ -86,6 +86,7 @@ export default function UserForm({
     if (formData.permissions & PERMISSIONS.DASHBOARD) selected.push('dashboard');
     if (formData.permissions & PERMISSIONS.VENTAS) selected.push('ventas');
     if (formData.permissions & PERMISSIONS.USUARIOS) selected.push('usuarios');
+    if (formData.permissions & PERMISSIONS.COBRADOR) selected.push('cobrador');
     return selected;
   };
 
@@ -97,6 +98,7 @@ export default function UserForm({
     if (selectedValues.includes('dashboard')) newPermissions |= PERMISSIONS.DASHBOARD;
     if (selectedValues.includes('ventas')) newPermissions |= PERMISSIONS.VENTAS;
     if (selectedValues.includes('usuarios')) newPermissions |= PERMISSIONS.USUARIOS;
+    if (selectedValues.includes('cobrador')) newPermissions |= PERMISSIONS.COBRADOR;
     
     setFormData(prev => ({ ...prev, permissions: newPermissions }));
   };
@@ -232,6 +234,13 @@ export default function UserForm({
                     <span className="text-xs text-default-500">Ver reportes y analytics</span>
                   </div>
                 </Checkbox>
+
+                <Checkbox value="cobrador">
+                  <div className="flex flex-col">
+                    <span className="text-sm font-medium">Cobrador</span>
+                    <span className="text-xs text-default-500">Hoja de ruta y cobro a sus clientes</span>
+                  </div>
+                </Checkbox>
               </div>
               
               {/* Permisos administrativos */}
@@ -1,19 +1,49 @@
 import { Card, CardBody } from "@heroui/card";
 import { Button } from "@heroui/button";
-import { RiBookOpenLine, RiBarChartLine, RiPieChartLine } from "react-icons/ri";
+import { Input } from "@heroui/input";
+import { Select, SelectItem } from "@heroui/select";
+import {
+  RiBookOpenLine,
+  RiBarChartLine,
+  RiPieChartLine,
+  RiScissorsCutLine,
+} from "react-icons/ri";
 import { useState } from "react";
+import { useQuery } from "@tanstack/react-query";
 
 import { useToast } from "@/shared/hooks/useToast";
-import { downloadSalesBook } from "@/api";
+import {
+  api,
+  downloadCollectionCards,
+  downloadSalesBook,
+  type Collector,
+  type ReportJob,
+} from "@/api";
 
 export function ReportsSection() {
   const { showSuccess, showApiError } = useToast();
   const [isDownloading, setIsDownloading] = useState(false);
+  const [progress, setProgress] = useState<ReportJob | null>(null);
+  const [isDownloadingCards, setIsDownloadingCards] = useState(false);
+  const [collectorId, setCollectorId] = useState<number | undefined>();
+  const [zone, setZone] = useState("");
+
+  // Cobradores para filtrar las tarjetas; sin permiso de clientes solo queda el filtro por zona
+  const { data: collectors = [] } = useQuery({
+    queryKey: ["collectors"],
+    queryFn: async (): Promise<Collector[]> => {
+      const response = await api.get("/api/collectors");
+      return response.data || [];
+    },
+    retry: false,
+  });
+
+  const canDownloadCards = !!collectorId || zone.trim() !== "";
 
   const handleDownloadSalesBook = async () => {
     setIsDownloading(true);
     try {
-      await downloadSalesBook();
+      await downloadSalesBook(setProgress);
       showSuccess(
         "Libro generado",
         "El libro de ventas pendientes se descargó correctamente."
@@ -25,6 +55,25 @@ export function ReportsSection() {
       );
     } finally {
       setIsDownloading(false);
+      setProgress(null);
+    }
+  };
+
+  const handleDownloadCollectionCards = async () => {
+    setIsDownloadingCards(true);
+    try {
+      await downloadCollectionCards({ collectorId, zone });
+      showSuccess(
+        "Tarjetas generadas",
+        "Las tarjetas de cobro de las ventas pendientes se descargaron correctamente."
+      );
+    } catch (error) {
+      showApiError(
+        "Error al generar tarjetas",
+        "No se pudieron descargar las tarjetas de cobro. Inténtalo de nuevo."
+      );
+    } finally {
+      setIsDownloadingCards(false);
     }
   };
 
@@ -51,7 +100,62 @@ export function ReportsSection() {
                 isLoading={isDownloading}
                 onPress={handleDownloadSalesBook}
               >
-                {isDownloading ? "Generando..." : "Descargar Libro"}
+                {isDownloading
+                  ? progress?.total
+                    ? `Generando... ${progress.processed}/${progress.total}`
+                    : "Generando..."
+                  : "Descargar Libro"}
+              </Button>
+            </div>
+          </CardBody>
+        </Card>
+
+        {/* Tarjetas de Cobro */}
+        <Card className="border border-default-200 hover:shadow-md transition-shadow">
+          <CardBody className="p-4">
+            <div className="flex flex-col gap-3">
+              <div className="flex items-center gap-2">
+                <RiScissorsCutLine className="text-secondary text-lg" />
+                <h4 className="font-medium">Tarjetas de Cobro</h4>
+              </div>
+              <p className="text-sm text-default-600">
+                Genera las tarjetas de cobro de las ventas pendientes de un
+                cobrador o de una zona, cuatro por hoja para recortar.
+              </p>
+              <Select
+                label="Cobrador"
+                selectedKeys={collectorId ? [String(collectorId)] : []}
+                size="sm"
+                onSelectionChange={(keys) => {
+                  const key = Array.from(keys)[0] as string | undefined;
+
+                  setCollectorId(key ? Number(key) : undefined);
+                }}
+              >
+                {collectors.map((collector) => (
+                  <SelectItem key={String(collector.id)}>
+                    {collector.firstName || collector.lastName
+                      ? `${collector.firstName} ${collector.lastName}`.trim()
+                      : collector.username}
+                  </SelectItem>
+                ))}
+              </Select>
+              <Input
+                label="Zona"
+                size="sm"
+                value={zone}
+                onValueChange={setZone}
+              />
+              <Button
+                className="font-medium"
+                color="secondary"
+                startContent={<RiScissorsCutLine />}
+                variant="flat"
+                isDisabled={!canDownloadCards}
+                isLoading={isDownloadingCards}
+                onPress={handleDownloadCollectionCards}
+              >
+                {isDownloadingCards ? "Generando..." : "Descargar Tarjetas"}
               </Button>
             </div>
           </CardBody>
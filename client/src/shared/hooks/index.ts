@@ -11,4 +11,5 @@ export { useTableActions } from './useTableActions';
 export { useToast } from './useToast';
 export { useIdle } from './useIdle';
 export { useIdleWithWarning } from './useIdleWithWarning';
-export { usePermissionRoute } from './usePermissionRoute'; 
\ No newline at end of file
+export { usePermissionRoute } from './usePermissionRoute';
+export { useLiveEvents } from './useLiveEvents'; 
\ No newline at end of file
@@ -24,6 +24,7 @@ export const PERMISSIONS = {
   DASHBOARD: 4,   // 100
   VENTAS: 8,      // 1000
   USUARIOS: 16,   // 10000
+  COBRADOR: 32,   // 100000
 } as const;
 
 // Helper functions for permission checking
@@ -32,6 +33,9 @@ export const hasPermission = (userPermissions: number, permission: number): bool
 };
 
 export const getUserRole = (permissions: number): string => {
+  if (permissions === PERMISSIONS.COBRADOR) return 'Cobrador';
+  // El permiso de cobrador no cambia el rol del resto de las secciones
+  permissions &= ~PERMISSIONS.COBRADOR;
   if (permissions === 0) return 'Sin permisos';
   if (permissions === 31) return 'Super Admin'; // 11111 - todos los permisos
   if (permissions >= 16) return 'Administrador'; // Incluye usuarios
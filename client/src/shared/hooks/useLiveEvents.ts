@@ -0,0 +1,84 @@
+import { useEffect } from 'react';
+import { useQueryClient } from '@tanstack/react-query';
+
+import { api, tokenManager } from '@/api';
+
+// Queries que hay que refrescar ante cada evento del servidor (GET /api/events)
+const invalidations: Record<string, string[][]> = {
+  'payment.created': [['dashboard-stats'], ['daily-collections'], ['quota-monthly-summary'], ['clients'], ['client'], ['sale-details']],
+  'payment.deleted': [['dashboard-stats'], ['daily-collections'], ['quota-monthly-summary'], ['clients'], ['client'], ['sale-details']],
+  'sale.created': [['dashboard-stats'], ['quota-monthly-summary'], ['clients'], ['client'], ['products'], ['product-stats']],
+  'sale.deleted': [['dashboard-stats'], ['quota-monthly-summary'], ['clients'], ['client'], ['products'], ['product-stats']],
+  'client.deleted': [['dashboard-stats'], ['quota-monthly-summary'], ['clients'], ['client-status-count']],
+  'client.state_changed': [['clients'], ['client'], ['client-status-count']],
+  'product.low_stock': [['products'], ['product-stats']],
+};
+
+const RECONNECT_DELAY = 5000;
+
+/**
+ * Mantiene abierto el stream de eventos en vivo y refresca las queries afectadas
+ * cuando otro usuario registra un pago, una venta o cambia el estado de un cliente.
+ */
+export function useLiveEvents(enabled = true) {
+  const queryClient = useQueryClient();
+
+  useEffect(() => {
+    if (!enabled) {
+      return;
+    }
+
+    let source: EventSource | null = null;
+    let reconnectTimer: ReturnType<typeof setTimeout> | undefined;
+    let stopped = false;
+
+    const reconnect = () => {
+      if (!stopped) {
+        reconnectTimer = setTimeout(connect, RECONNECT_DELAY);
+      }
+    };
+
+    const connect = async () => {
+      if (!tokenManager.getToken() || stopped) {
+        return;
+      }
+
+      // EventSource no permite headers: se pide un ticket de un solo uso para no poner el token en la URL
+      let ticket: string;
+      try {
+        const response = await api.post<{ ticket: string }>('/api/events/ticket');
+        ticket = response.data.ticket;
+      } catch {
+        reconnect();
+        return;
+      }
+      if (stopped) {
+        return;
+      }
+
+      const url = `${api.defaults.baseURL}/api/events?ticket=${encodeURIComponent(ticket)}`;
+      source = new EventSource(url);
+
+      Object.entries(invalidations).forEach(([type, queryKeys]) => {
+        source?.addEventListener(type, () => {
+          queryKeys.forEach((queryKey) => queryClient.invalidateQueries({ queryKey }));
+        });
+      });
+
+      source.onerror = () => {
+        // El ticket ya se usó, así que el reintento automático del navegador fallaría:
+        // se cierra y se vuelve a abrir con un ticket nuevo
+        source?.close();
+        reconnect();
+      };
+    };
+
+    connect();
+
+    return () => {
+      stopped = true;
+      clearTimeout(reconnectTimer);
+      source?.close();
+    };
+  }, [queryClient, enabled]);
+}
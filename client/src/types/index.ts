@@ -15,6 +15,8 @@ export interface ClientSummary {
   lastname: string;
   dni: string;
   state?: State;
+  risk_score?: number; // 0 a 100, más alto es más riesgoso
+  risk_level?: "low" | "medium" | "high";
 }
 
 export interface Client extends ClientSummary {
@@ -99,6 +101,7 @@ export interface CreateSaleDto {
   quotas: number;
   quota_price: number;
   products: CreateSaleProductDto[];
+  override_reason?: string; // Solo encargados: autoriza una venta fuera de las reglas de crédito
 }
 
 export interface CreateSaleProductDto {
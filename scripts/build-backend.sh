@@ -81,6 +81,11 @@ fi
 # Hacer el binario ejecutable
 chmod +x "$BUILD_DIR/$APP_NAME"
 
+# Construir la herramienta de administración (gostore config print, etc.)
+echo "🛠️  Construyendo CLI de administración..."
+GOOS=linux GOARCH=amd64 go build "${BUILD_FLAGS[@]}" -o "$BUILD_DIR/gostore" ./cmd/gostore
+chmod +x "$BUILD_DIR/gostore"
+
 echo "✅ Backend construido exitosamente!"
 echo "📁 Binario disponible en: $BUILD_DIR/$APP_NAME"
 
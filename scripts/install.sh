@@ -96,6 +96,13 @@ else
     exit 1
 fi
 
+# Copiar CLI de administración si existe
+if [ -f "$PROJECT_ROOT/build/gostore" ]; then
+    cp "$PROJECT_ROOT/build/gostore" "$INSTALL_DIR/bin/"
+    chmod +x "$INSTALL_DIR/bin/gostore"
+    echo "✅ CLI de administración copiada"
+fi
+
 # Copiar archivos estáticos del frontend
 if [ -d "$PROJECT_ROOT/server/static" ]; then
     cp -r "$PROJECT_ROOT/server/static"/* "$INSTALL_DIR/static/"
@@ -125,6 +132,7 @@ ENVIRONMENT=production
 PORT=8080
 STATIC_DIR=$INSTALL_DIR/static
 DB_PATH=$DB_DIR/gostore.db
+MIGRATIONS_DIR=$INSTALL_DIR/migrations
 JWT_SECRET_KEY=$(openssl rand -base64 32)
 
 # Configuración de logs
@@ -126,70 +126,44 @@ cmd_config() {
     fi
 }
 
+# Ejecuta la CLI de administración con la misma configuración que el servicio
+run_gostore_cli() {
+    # Los argumentos van como parámetros posicionales, nunca interpolados en el comando
+    sudo -u gostore bash -c 'set -a; source "$1/gostore.env"; cd "$2" || exit 1; shift 2; exec ./bin/gostore "$@"' _ "$CONFIG_DIR" "$INSTALL_DIR" "$@"
+}
+
 cmd_backup() {
     print_step "💾 Creando backup de la base de datos..."
-    
-    BACKUP_DIR="$INSTALL_DIR/backups"
-    mkdir -p "$BACKUP_DIR"
-    
-    TIMESTAMP=$(date +"%Y%m%d_%H%M%S")
-    BACKUP_FILE="$BACKUP_DIR/gostore_backup_$TIMESTAMP.db"
-    
-    if [ -f "$INSTALL_DIR/data/gostore.db" ]; then
-        cp "$INSTALL_DIR/data/gostore.db" "$BACKUP_FILE"
-        print_success "✅ Backup creado: $BACKUP_FILE"
-        
-        # Comprimir el backup
-        gzip "$BACKUP_FILE"
-        print_success "✅ Backup comprimido: $BACKUP_FILE.gz"
+
+    # Snapshot consistente y verificado (no hace falta detener el servicio)
+    if run_gostore_cli backup create; then
+        print_success "✅ Backup creado"
+        run_gostore_cli backup list
     else
-        print_error "❌ No se encontró la base de datos"
+        print_error "❌ No se pudo crear el backup"
+        return 1
     fi
 }
 
 cmd_restore() {
     print_step "🔄 Restaurando backup de la base de datos..."
-    
-    BACKUP_DIR="$INSTALL_DIR/backups"
-    
-    if [ ! -d "$BACKUP_DIR" ]; then
-        print_error "❌ No se encontró el directorio de backups"
-        return 1
-    fi
-    
+
     print_info "Backups disponibles:"
-    ls -la "$BACKUP_DIR"/*.gz 2>/dev/null || {
-        print_error "❌ No se encontraron backups"
-        return 1
-    }
-    
+    run_gostore_cli backup list || return 1
+
     echo ""
-    read -p "Ingresa el nombre del archivo de backup (sin ruta): " backup_file
-    
-    if [ -f "$BACKUP_DIR/$backup_file" ]; then
-        print_warning "⚠️  Esto sobrescribirá la base de datos actual"
-        read -p "¿Continuar? (y/N): " -n 1 -r
-        echo
-        if [[ $REPLY =~ ^[Yy]$ ]]; then
-            cmd_stop
-            
-            # Crear backup de seguridad
-            cp "$INSTALL_DIR/data/gostore.db" "$INSTALL_DIR/data/gostore.db.backup"
-            
-            # Restaurar
-            if [[ "$backup_file" == *.gz ]]; then
-                gunzip -c "$BACKUP_DIR/$backup_file" > "$INSTALL_DIR/data/gostore.db"
-            else
-                cp "$BACKUP_DIR/$backup_file" "$INSTALL_DIR/data/gostore.db"
-            fi
-            
-            chown gostore:gostore "$INSTALL_DIR/data/gostore.db"
-            
-            cmd_start
+    read -p "Ingresa el nombre del backup: " backup_file
+
+    print_warning "⚠️  Esto sobrescribirá la base de datos actual (se guarda una copia prerestore)"
+    read -p "¿Continuar? (y/N): " -n 1 -r
+    echo
+    if [[ $REPLY =~ ^[Yy]$ ]]; then
+        if run_gostore_cli backup restore "$backup_file"; then
             print_success "✅ Backup restaurado exitosamente"
+        else
+            print_error "❌ No se pudo restaurar el backup"
+            return 1
         fi
-    else
-        print_error "❌ Archivo de backup no encontrado"
     fi
 }
 
@@ -284,13 +258,19 @@ cmd_health() {
         print_error "❌ Puerto 8080 no disponible"
     fi
     
-    # Verificar API
+    # Verificar API (liveness) y dependencias (readiness: base de datos y PDF)
     if command -v curl &> /dev/null; then
-        if curl -s -f http://localhost:8080/api >/dev/null; then
+        if curl -s -f http://localhost:8080/healthz >/dev/null; then
             print_success "✅ API respondiendo"
         else
             print_warning "⚠️  API no responde correctamente"
         fi
+
+        if curl -s -f http://localhost:8080/readyz >/dev/null; then
+            print_success "✅ Base de datos y generación de PDF disponibles"
+        else
+            print_warning "⚠️  Servicio no listo: $(curl -s http://localhost:8080/readyz) (detalle en journalctl -u $SERVICE_NAME)"
+        fi
     fi
     
     # Verificar base de datos
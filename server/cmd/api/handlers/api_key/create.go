@@ -0,0 +1,38 @@
+package api_key
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/middleware"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var createRequest dto.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if createRequest.Name == "" {
+		http.Error(w, "Name is required", http.StatusBadRequest)
+		return
+	}
+
+	// Registrar qué usuario creó la key
+	var createdBy *int64
+	if claims, ok := middleware.GetUserClaims(r); ok {
+		createdBy = &claims.UserID
+	}
+
+	resp, err := h.Service.Create(r.Context(), &createRequest, createdBy)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, resp)
+}
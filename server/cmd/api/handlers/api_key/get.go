@@ -0,0 +1,35 @@
+package api_key
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) GetAPIKeys(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	keys, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, keys)
+}
+
+func (h *Handler) GetAPIKeyByID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid api key ID", http.StatusBadRequest)
+		return
+	}
+
+	key, err := h.Service.GetByID(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, key)
+}
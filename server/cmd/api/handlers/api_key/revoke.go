@@ -0,0 +1,26 @@
+package api_key
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid api key ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.Revoke(r.Context(), id); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, map[string]string{
+		"message": "API key revoked successfully",
+	})
+}
@@ -0,0 +1,22 @@
+package attachment
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) DeleteAttachment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, ok := attachmentID(w, ps)
+	if !ok {
+		return
+	}
+
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
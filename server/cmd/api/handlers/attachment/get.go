@@ -0,0 +1,81 @@
+package attachment
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request, ps httprouter.Params, ownerType string) {
+	id, err := ownerID(ps)
+	if err != nil {
+		http.Error(w, "Invalid "+ownerType+" ID", http.StatusBadRequest)
+		return
+	}
+
+	attachments, err := h.Service.GetByOwner(r.Context(), ownerType, id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, attachments)
+}
+
+func (h *Handler) GetAttachment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, ok := attachmentID(w, ps)
+	if !ok {
+		return
+	}
+
+	attachment, err := h.Service.GetByID(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, attachment)
+}
+
+// Download devuelve el archivo; con ?inline=true se muestra en el navegador en vez de descargarse
+func (h *Handler) Download(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.serve(w, r, ps, false)
+}
+
+// Thumbnail devuelve la miniatura JPEG de un adjunto de imagen
+func (h *Handler) Thumbnail(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.serve(w, r, ps, true)
+}
+
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request, ps httprouter.Params, thumbnail bool) {
+	id, ok := attachmentID(w, ps)
+	if !ok {
+		return
+	}
+
+	attachment, content, err := h.Service.Open(r.Context(), id, thumbnail)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+	defer content.Close()
+
+	if thumbnail {
+		w.Header().Set("Content-Type", "image/jpeg")
+	} else {
+		disposition := "attachment"
+		if r.URL.Query().Get("inline") == "true" {
+			disposition = "inline"
+		}
+		w.Header().Set("Content-Type", attachment.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.FileName}))
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	io.Copy(w, content)
+}
@@ -0,0 +1,57 @@
+package attachment
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/julienschmidt/httprouter"
+)
+
+type Handler struct {
+	Service ports.AttachmentService
+	MaxSize int64 // Tamaño máximo de un archivo en bytes
+}
+
+// ownerID lee el ID del dueño; en POST de ventas la ruta usa :sale_id como las notas
+func ownerID(ps httprouter.Params) (int64, error) {
+	id := ps.ByName("id")
+	if id == "" {
+		id = ps.ByName("sale_id")
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func attachmentID(w http.ResponseWriter, ps httprouter.Params) (int64, bool) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid attachment ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *Handler) UploadClientAttachment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.upload(w, r, ps, domain.AttachmentOwnerClient)
+}
+
+func (h *Handler) UploadSaleAttachment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.upload(w, r, ps, domain.AttachmentOwnerSale)
+}
+
+func (h *Handler) UploadPaymentAttachment(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.upload(w, r, ps, domain.AttachmentOwnerPayment)
+}
+
+func (h *Handler) GetClientAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.list(w, r, ps, domain.AttachmentOwnerClient)
+}
+
+func (h *Handler) GetSaleAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.list(w, r, ps, domain.AttachmentOwnerSale)
+}
+
+func (h *Handler) GetPaymentAttachments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.list(w, r, ps, domain.AttachmentOwnerPayment)
+}
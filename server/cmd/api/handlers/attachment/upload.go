@@ -0,0 +1,55 @@
+package attachment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/julienschmidt/httprouter"
+)
+
+// upload recibe multipart/form-data con el campo file
+func (h *Handler) upload(w http.ResponseWriter, r *http.Request, ps httprouter.Params, ownerType string) {
+	id, err := ownerID(ps)
+	if err != nil {
+		http.Error(w, "Invalid "+ownerType+" ID", http.StatusBadRequest)
+		return
+	}
+
+	// Margen para el resto del formulario; el límite del archivo lo valida el servicio
+	r.Body = http.MaxBytesReader(w, r.Body, h.MaxSize+64<<10)
+	if err := r.ParseMultipartForm(h.MaxSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			responses.Err(w, domain.NewAppError(domain.ErrCodeFileTooLarge, fmt.Sprintf("file must be at most %d MB", h.MaxSize>>20)))
+			return
+		}
+		http.Error(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "File is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Error reading file", http.StatusBadRequest)
+		return
+	}
+
+	attachment, err := h.Service.Upload(r.Context(), ownerType, id, header.Filename, content)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, attachment)
+}
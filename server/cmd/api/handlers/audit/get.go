@@ -0,0 +1,35 @@
+package audit
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Get lista el log de auditoría, lo más reciente primero; acepta actor_type, actor_id, limit y offset
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil {
+		offset = 0
+	}
+	actorID, err := strconv.ParseInt(query.Get("actor_id"), 10, 64)
+	if err != nil {
+		actorID = 0
+	}
+
+	entries, err := h.Service.Get(r.Context(), query.Get("actor_type"), actorID, limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, entries)
+}
@@ -0,0 +1,18 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) CreateBackup(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	backup, err := h.Service.Create(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, backup)
+}
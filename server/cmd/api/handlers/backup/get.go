@@ -0,0 +1,40 @@
+package backup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) GetBackups(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	backups, err := h.Service.List()
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, backups)
+}
+
+func (h *Handler) DownloadBackup(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	name := ps.ByName("name")
+
+	f, err := h.Service.Open(name)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.sqlite3")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", name))
+	http.ServeContent(w, r, name, info.ModTime(), f)
+}
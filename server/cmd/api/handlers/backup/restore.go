@@ -0,0 +1,21 @@
+package backup
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) RestoreBackup(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	name := ps.ByName("name")
+
+	if err := h.Service.Restore(r.Context(), name); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, map[string]string{
+		"message": "Backup restored successfully",
+	})
+}
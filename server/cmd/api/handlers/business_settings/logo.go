@@ -0,0 +1,69 @@
+package business_settings
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	businessSettingsSvc "github.com/benitez96/gostore/internal/services/business_settings"
+	"github.com/julienschmidt/httprouter"
+)
+
+// UploadLogo recibe multipart/form-data con el campo logo (PNG o JPEG)
+func (h *Handler) UploadLogo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	// Margen para el resto del formulario; el límite real lo valida el servicio
+	r.Body = http.MaxBytesReader(w, r.Body, businessSettingsSvc.MaxLogoSize+64<<10)
+	if err := r.ParseMultipartForm(businessSettingsSvc.MaxLogoSize); err != nil {
+		http.Error(w, "Invalid multipart form or file too large", http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("logo")
+	if err != nil {
+		http.Error(w, "Logo is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	logo, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Error reading logo", http.StatusBadRequest)
+		return
+	}
+
+	settings, err := h.Service.SetLogo(r.Context(), logo)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, settings)
+}
+
+func (h *Handler) GetLogo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	settings, err := h.Service.Get(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	if !settings.HasLogo {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", settings.LogoMime)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(settings.Logo)))
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(settings.Logo)
+}
+
+func (h *Handler) DeleteLogo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if _, err := h.Service.DeleteLogo(r.Context()); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package business_settings
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) GetSettings(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	settings, err := h.Service.Get(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, settings)
+}
+
+func (h *Handler) UpdateSettings(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var req dto.UpdateBusinessSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	settings, err := h.Service.Update(r.Context(), req)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, settings)
+}
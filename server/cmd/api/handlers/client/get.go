@@ -42,7 +42,10 @@ func (h *Handler) GetAllClients(
 		}
 	}
 
-	clients, err := h.Service.GetAll(search, limit, offset, stateIds)
+	// Orden: name (por defecto), risk_score o -risk_score
+	sort := r.URL.Query().Get("sort")
+
+	clients, err := h.Service.GetAll(search, limit, offset, stateIds, sort)
 
 	if err != nil {
 		responses.Err(w, err)
@@ -0,0 +1,112 @@
+package collector
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) GetCollectors(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	collectors, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, collectors)
+}
+
+// GetClients lista los clientes asignados; acepta ?zone= para filtrar
+func (h *Handler) GetClients(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, ok := collectorID(r, ps)
+	if !ok {
+		http.Error(w, "Invalid collector ID", http.StatusBadRequest)
+		return
+	}
+
+	clients, err := h.Service.GetClients(r.Context(), id, r.URL.Query().Get("zone"))
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, clients)
+}
+
+// GetRouteSheet devuelve la hoja de ruta de ?date= (hoy por defecto); con ?format=pdf la devuelve para imprimir
+func (h *Handler) GetRouteSheet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, ok := collectorID(r, ps)
+	if !ok {
+		http.Error(w, "Invalid collector ID", http.StatusBadRequest)
+		return
+	}
+
+	date, ok := parseDate(r, "date", time.Now())
+	if !ok {
+		http.Error(w, "date must be YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "pdf" {
+		http.Error(w, "format must be json or pdf", http.StatusBadRequest)
+		return
+	}
+
+	sheet, err := h.Service.RouteSheet(r.Context(), id, date)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	if format != "pdf" {
+		responses.Ok(w, sheet)
+		return
+	}
+
+	pdfContent, err := h.PDF.GenerateRouteSheetPDF(sheet)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("hoja_ruta_%d_%s.pdf", sheet.Collector.ID, sheet.Date.Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Content-Length", strconv.Itoa(len(pdfContent)))
+	w.Write(pdfContent)
+}
+
+// GetSummary devuelve lo cobrado y la comisión entre ?from= y ?to= (ambos inclusive).
+// Por defecto, desde el primer día del mes hasta hoy.
+func (h *Handler) GetSummary(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, ok := collectorID(r, ps)
+	if !ok {
+		http.Error(w, "Invalid collector ID", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	from, ok := parseDate(r, "from", time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local))
+	if !ok {
+		http.Error(w, "from must be YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	to, ok := parseDate(r, "to", time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local))
+	if !ok {
+		http.Error(w, "to must be YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	summary, err := h.Service.Summary(r.Context(), id, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, summary)
+}
@@ -0,0 +1,41 @@
+package collector
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/middleware"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/services/pdf"
+	"github.com/julienschmidt/httprouter"
+)
+
+type Handler struct {
+	Service ports.CollectorService
+	PDF     *pdf.Service
+}
+
+// collectorID lee el :id de la ruta; "me" es el usuario autenticado
+func collectorID(r *http.Request, ps httprouter.Params) (int64, bool) {
+	if ps.ByName("id") == "me" {
+		actor, ok := middleware.GetActor(r)
+		if !ok || actor.Type != domain.ActorTypeUser {
+			return 0, false
+		}
+		return actor.ID, true
+	}
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	return id, err == nil
+}
+
+// parseDate lee una fecha YYYY-MM-DD del query string; sin el parámetro devuelve def
+func parseDate(r *http.Request, name string, def time.Time) (time.Time, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return def, true
+	}
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	return date, err == nil
+}
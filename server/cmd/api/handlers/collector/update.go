@@ -0,0 +1,56 @@
+package collector
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// UpdateCollector cambia la comisión del cobrador
+func (h *Handler) UpdateCollector(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid collector ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.UpdateCollectorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	collector, err := h.Service.Update(r.Context(), id, req)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, collector)
+}
+
+// AssignClient asigna un cliente a un cobrador y una zona
+func (h *Handler) AssignClient(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.AssignCollectorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.AssignClient(r.Context(), clientID, req); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,71 @@
+package credit
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) GetPolicies(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	policies, err := h.Service.GetPolicies(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, policies)
+}
+
+// GetClientCredit devuelve la deuda del cliente, sus límites efectivos y si una venta de ?amount= los cumpliría
+func (h *Handler) GetClientCredit(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	amount := 0.0
+	if value := r.URL.Query().Get("amount"); value != "" {
+		amount, err = strconv.ParseFloat(value, 64)
+		if err != nil || amount < 0 {
+			http.Error(w, "Invalid amount", http.StatusBadRequest)
+			return
+		}
+	}
+
+	check, err := h.Service.Check(r.Context(), clientID, amount)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, check)
+}
+
+// GetOverrides lista las ventas autorizadas saltando las reglas; acepta client_id, limit y offset
+func (h *Handler) GetOverrides(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil {
+		offset = 0
+	}
+	clientID, err := strconv.ParseInt(query.Get("client_id"), 10, 64)
+	if err != nil {
+		clientID = 0
+	}
+
+	overrides, err := h.Service.GetOverrides(r.Context(), clientID, limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, overrides)
+}
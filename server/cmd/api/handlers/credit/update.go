@@ -0,0 +1,66 @@
+package credit
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// UpdatePolicy cambia las reglas de crédito del estado :id
+func (h *Handler) UpdatePolicy(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	stateID, err := strconv.Atoi(ps.ByName("id"))
+	if err != nil {
+		http.Error(w, "Invalid state ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.UpdateCreditPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	err = h.Service.UpdatePolicy(r.Context(), &domain.CreditPolicy{
+		StateID:        stateID,
+		AllowSales:     req.AllowSales,
+		MaxBalance:     req.MaxBalance,
+		MaxActiveSales: req.MaxActiveSales,
+	})
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UpdateClientLimits cambia los límites de crédito propios del cliente
+func (h *Handler) UpdateClientLimits(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.UpdateCreditLimitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	err = h.Service.UpdateClientLimits(r.Context(), clientID, domain.CreditLimits{
+		MaxBalance:     req.MaxBalance,
+		MaxActiveSales: req.MaxActiveSales,
+	})
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
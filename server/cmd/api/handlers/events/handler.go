@@ -0,0 +1,11 @@
+package events
+
+import (
+	"github.com/benitez96/gostore/internal/events"
+	"github.com/benitez96/gostore/internal/middleware"
+)
+
+type Handler struct {
+	Broker  *events.Broker
+	Tickets *middleware.StreamTickets
+}
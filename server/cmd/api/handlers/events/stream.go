@@ -0,0 +1,81 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/internal/middleware"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/julienschmidt/httprouter"
+)
+
+// heartbeat mantiene viva la conexión detrás de proxies que cortan streams inactivos
+const heartbeat = 25 * time.Second
+
+// Stream abre un stream Server-Sent Events con los eventos que el usuario puede ver
+// según sus permisos. Acepta ?types=payment.created,sale.created para filtrar.
+// No hay reenvío de eventos perdidos: al reconectar, el cliente debe recargar sus datos.
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	actor, ok := middleware.GetActor(r)
+	if !ok {
+		http.Error(w, "Invalid user context", http.StatusInternalServerError)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	var types []string
+	if raw := r.URL.Query().Get("types"); raw != "" {
+		types = strings.Split(raw, ",")
+	}
+
+	sub := h.Broker.Subscribe(actor.Permissions, types)
+	if sub == nil {
+		http.Error(w, "Server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	defer h.Broker.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx no debe bufferizar el stream
+	w.WriteHeader(http.StatusOK)
+
+	// El navegador reintenta a los 5s si se corta
+	fmt.Fprint(w, "retry: 5000\n: connected\n\n")
+	flusher.Flush()
+
+	log := logger.FromContext(r.Context())
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+		case event, open := <-sub.Events:
+			if !open {
+				// El broker cortó la conexión (apagado o cliente lento)
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				log.Error("live event encoding failed", "event", event.Type, "error", err)
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/middleware"
+	"github.com/julienschmidt/httprouter"
+)
+
+// CreateTicket emite un ticket de un solo uso para abrir el stream desde el navegador,
+// que con EventSource no puede mandar el header Authorization
+func (h *Handler) CreateTicket(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	actor, ok := middleware.GetActor(r)
+	if !ok {
+		http.Error(w, "Invalid user context", http.StatusInternalServerError)
+		return
+	}
+
+	ticket, expiresAt, err := h.Tickets.Issue(actor)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, dto.StreamTicketResponse{Ticket: ticket, ExpiresAt: expiresAt})
+}
@@ -0,0 +1,126 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/services/exporter"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/julienschmidt/httprouter"
+)
+
+type exportFunc func(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error
+
+// Query params: format (csv | xlsx, por defecto csv), search y states como en GET /api/clients.
+// Pagos acepta además from y to (YYYY-MM-DD, inclusive).
+func (h *Handler) ExportClients(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleExport(w, r, domain.ExportEntityClients, h.Service.ExportClients)
+}
+
+func (h *Handler) ExportSales(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleExport(w, r, domain.ExportEntitySales, h.Service.ExportSales)
+}
+
+func (h *Handler) ExportQuotas(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleExport(w, r, domain.ExportEntityQuotas, h.Service.ExportQuotas)
+}
+
+func (h *Handler) ExportPayments(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleExport(w, r, domain.ExportEntityPayments, h.Service.ExportPayments)
+}
+
+func (h *Handler) handleExport(w http.ResponseWriter, r *http.Request, entity string, run exportFunc) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = exporter.FormatCSV
+	}
+	contentType := exporter.ContentType(format)
+	if contentType == "" {
+		http.Error(w, "Invalid format, use csv or xlsx", http.StatusBadRequest)
+		return
+	}
+
+	filter := &dto.ExportFilter{
+		Search:   query.Get("search"),
+		StateIDs: parseStates(query.Get("states")),
+	}
+
+	var err error
+	if filter.From, err = parseDate(query.Get("from")); err != nil {
+		http.Error(w, "Invalid from date, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	if filter.To, err = parseDate(query.Get("to")); err != nil {
+		http.Error(w, "Invalid to date, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+
+	out := &attachment{
+		w:           w,
+		contentType: contentType,
+		filename:    fmt.Sprintf("%s-%s.%s", entity, time.Now().Format("20060102"), format),
+	}
+
+	if err := run(r.Context(), out, format, filter); err != nil {
+		// Una vez que empezó la descarga ya no se puede devolver un error JSON
+		if out.started {
+			logger.FromContext(r.Context()).Error("export interrupted", "entity", entity, "error", err)
+			return
+		}
+		responses.Err(w, err)
+	}
+}
+
+// attachment escribe los encabezados de descarga recién con el primer byte,
+// así un error antes de empezar todavía puede responderse como JSON
+type attachment struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (a *attachment) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.contentType)
+		a.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.filename))
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
+
+// parseStates interpreta "1,2" igual que GET /api/clients
+func parseStates(param string) []int64 {
+	var stateIDs []int64
+	if param == "" {
+		return stateIDs
+	}
+	for _, stateStr := range strings.Split(param, ",") {
+		if stateID, err := strconv.ParseInt(strings.TrimSpace(stateStr), 10, 64); err == nil {
+			stateIDs = append(stateIDs, stateID)
+		}
+	}
+	return stateIDs
+}
+
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
@@ -0,0 +1,26 @@
+package guarantor
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+func (h *Handler) CreateGuarantor(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var req dto.GuarantorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	guarantor := toGuarantor(req)
+	if err := h.Service.Create(r.Context(), guarantor); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, guarantor)
+}
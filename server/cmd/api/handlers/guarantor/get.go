@@ -0,0 +1,58 @@
+package guarantor
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetGuarantors lista los garantes; acepta search (nombre, apellido o DNI), limit y offset
+func (h *Handler) GetGuarantors(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil {
+		offset = 0
+	}
+
+	guarantors, err := h.Service.GetAll(r.Context(), query.Get("search"), limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, guarantors)
+}
+
+func (h *Handler) GetGuarantor(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid guarantor ID", http.StatusBadRequest)
+		return
+	}
+
+	guarantor, err := h.Service.GetByID(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, guarantor)
+}
+
+// GetFollowUps lista las ventas de clientes suspendidos con cuotas vencidas y sus garantes
+func (h *Handler) GetFollowUps(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	followUps, err := h.Service.FollowUps(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, followUps)
+}
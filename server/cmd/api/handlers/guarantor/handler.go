@@ -0,0 +1,22 @@
+package guarantor
+
+import (
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+type Handler struct {
+	Service ports.GuarantorService
+}
+
+func toGuarantor(req dto.GuarantorRequest) *domain.Guarantor {
+	return &domain.Guarantor{
+		Name:         req.Name,
+		Lastname:     req.Lastname,
+		Dni:          req.Dni,
+		Phone:        req.Phone,
+		Address:      req.Address,
+		Relationship: req.Relationship,
+	}
+}
@@ -0,0 +1,78 @@
+package guarantor
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// saleID lee el ID de la venta; en POST la ruta usa :sale_id como las notas
+func saleID(ps httprouter.Params) (int64, error) {
+	id := ps.ByName("id")
+	if id == "" {
+		id = ps.ByName("sale_id")
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
+func (h *Handler) GetSaleGuarantors(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := saleID(ps)
+	if err != nil {
+		http.Error(w, "Invalid sale ID", http.StatusBadRequest)
+		return
+	}
+
+	guarantors, err := h.Service.GetBySaleID(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, guarantors)
+}
+
+// LinkGuarantor vincula un garante existente a la venta
+func (h *Handler) LinkGuarantor(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := saleID(ps)
+	if err != nil {
+		http.Error(w, "Invalid sale ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.LinkGuarantorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.GuarantorID == 0 {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.Link(r.Context(), id, req.GuarantorID); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) UnlinkGuarantor(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := saleID(ps)
+	if err != nil {
+		http.Error(w, "Invalid sale ID", http.StatusBadRequest)
+		return
+	}
+	guarantorID, err := strconv.ParseInt(ps.ByName("guarantor_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid guarantor ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.Unlink(r.Context(), id, guarantorID); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,17 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Check es una dependencia que debe estar disponible para que el servicio esté listo
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type Handler struct {
+	Checks  []Check
+	Timeout time.Duration // Tiempo máximo por chequeo de readiness
+}
@@ -0,0 +1,15 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Healthz es el chequeo de liveness: responde mientras el proceso pueda atender requests
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	responses.Ok(w, map[string]string{
+		"status": "ok",
+	})
+}
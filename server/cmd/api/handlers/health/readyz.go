@@ -0,0 +1,53 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/julienschmidt/httprouter"
+)
+
+type readinessResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Readyz ejecuta todos los chequeos; devuelve 503 si alguno falla
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	resp := readinessResponse{
+		Status: "ok",
+		Checks: make(map[string]string, len(h.Checks)),
+	}
+
+	for _, check := range h.Checks {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		err := check.Run(ctx)
+		cancel()
+
+		if err != nil {
+			// El detalle queda en el log: el endpoint es público y no debe exponer rutas ni errores internos
+			logger.FromContext(r.Context()).Warn("readiness check failed", "check", check.Name, "error", err)
+			resp.Status = "unavailable"
+			resp.Checks[check.Name] = "fail"
+			continue
+		}
+		resp.Checks[check.Name] = "ok"
+	}
+
+	status := http.StatusOK
+	if resp.Status != "ok" {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
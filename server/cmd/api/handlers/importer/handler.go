@@ -0,0 +1,9 @@
+package importer
+
+import (
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+type Handler struct {
+	Service ports.ImportService
+}
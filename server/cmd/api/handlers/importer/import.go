@@ -0,0 +1,88 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	importerSvc "github.com/benitez96/gostore/internal/services/importer"
+	"github.com/julienschmidt/httprouter"
+)
+
+// maxUploadSize limita el tamaño del archivo subido
+const maxUploadSize = 20 << 20
+
+type importFunc func(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error)
+
+// ImportClients recibe multipart/form-data con los campos:
+// file (csv o xlsx), format (opcional), mapping (JSON opcional {"dni": "Documento"}) y dry_run.
+func (h *Handler) ImportClients(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleImport(w, r, h.Service.ImportClients)
+}
+
+func (h *Handler) ImportProducts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleImport(w, r, h.Service.ImportProducts)
+}
+
+// ImportSales importa ventas históricas: una fila por cuota con los pagos ya realizados
+func (h *Handler) ImportSales(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.handleImport(w, r, h.Service.ImportSales)
+}
+
+func (h *Handler) handleImport(w http.ResponseWriter, r *http.Request, run importFunc) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		http.Error(w, "Invalid multipart form or file too large", http.StatusBadRequest)
+		return
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "File is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	opts := &dto.ImportOptions{
+		Format: r.FormValue("format"),
+	}
+	if opts.Format == "" {
+		opts.Format = importerSvc.FormatFromFilename(fileHeader.Filename)
+	}
+
+	if raw := r.FormValue("dry_run"); raw != "" {
+		opts.DryRun, err = strconv.ParseBool(raw)
+		if err != nil {
+			http.Error(w, "Invalid dry_run value", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if raw := r.FormValue("mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &opts.Mapping); err != nil {
+			http.Error(w, "Invalid mapping, expected a JSON object", http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := run(r.Context(), file, opts)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	// Si hubo errores de validación no se importó nada
+	if !result.DryRun && len(result.Errors) > 0 {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	responses.Ok(w, result)
+}
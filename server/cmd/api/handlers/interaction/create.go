@@ -0,0 +1,78 @@
+package interaction
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// CreateInteraction registra una llamada, visita o mensaje al cliente, con la promesa de pago si la hubo
+func (h *Handler) CreateInteraction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.CreateInteractionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	interaction := &domain.ClientInteraction{
+		ClientID: clientID,
+		Type:     req.Type,
+		Outcome:  req.Outcome,
+		Notes:    req.Notes,
+	}
+	if req.Promise != nil {
+		promise, ok := toPromise(*req.Promise)
+		if !ok {
+			http.Error(w, "Invalid promised_date, expected YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		interaction.Promise = promise
+	}
+
+	if err := h.Service.Create(r.Context(), interaction); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, interaction)
+}
+
+// CreatePromise registra una promesa de pago del cliente
+func (h *Handler) CreatePromise(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.PaymentPromiseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	promise, ok := toPromise(req)
+	if !ok {
+		http.Error(w, "Invalid promised_date, expected YYYY-MM-DD", http.StatusBadRequest)
+		return
+	}
+	promise.ClientID = clientID
+
+	if err := h.Service.CreatePromise(r.Context(), promise); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Created(w, promise)
+}
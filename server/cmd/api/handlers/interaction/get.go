@@ -0,0 +1,67 @@
+package interaction
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetInteractions lista las gestiones del cliente, de la más reciente a la más vieja; acepta limit y offset
+func (h *Handler) GetInteractions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	limit, offset := pagination(r)
+	interactions, err := h.Service.GetByClientID(r.Context(), clientID, limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, interactions)
+}
+
+// GetPromises lista las promesas de pago del cliente; acepta limit y offset
+func (h *Handler) GetPromises(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	limit, offset := pagination(r)
+	promises, err := h.Service.GetPromisesByClientID(r.Context(), clientID, limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, promises)
+}
+
+// GetPromisesDue lista las promesas pendientes que vencen en date (YYYY-MM-DD, hoy por defecto)
+func (h *Handler) GetPromisesDue(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	date := time.Now()
+	if value := r.URL.Query().Get("date"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			http.Error(w, "Invalid date, expected YYYY-MM-DD", http.StatusBadRequest)
+			return
+		}
+		date = parsed
+	}
+
+	promises, err := h.Service.PromisesDue(r.Context(), date)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, promises)
+}
@@ -0,0 +1,43 @@
+package interaction
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+type Handler struct {
+	Service ports.InteractionService
+}
+
+// toPromise convierte el pedido en una promesa; la fecha va como YYYY-MM-DD
+func toPromise(req dto.PaymentPromiseRequest) (*domain.PaymentPromise, bool) {
+	date, err := time.ParseInLocation("2006-01-02", req.PromisedDate, time.Local)
+	if err != nil {
+		return nil, false
+	}
+	return &domain.PaymentPromise{
+		PromisedDate: date,
+		Amount:       req.Amount,
+		Notes:        req.Notes,
+	}, true
+}
+
+// pagination lee limit (50 por defecto) y offset de la query
+func pagination(r *http.Request) (int, int) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil {
+		offset = 0
+	}
+	return limit, offset
+}
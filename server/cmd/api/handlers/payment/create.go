@@ -6,6 +6,7 @@ import (
 
 	"github.com/benitez96/gostore/cmd/core/responses"
 	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
 
 	"github.com/julienschmidt/httprouter"
 )
@@ -15,17 +16,40 @@ func (h *Handler) CreatePayment(
 	r *http.Request,
 	_ httprouter.Params,
 ) {
-	var payment *domain.Payment
-	if err := json.NewDecoder(r.Body).Decode(&payment); err != nil {
+	var req struct {
+		domain.Payment
+		// SendReceipt pisa receipts.auto_send para este pago
+		SendReceipt *bool `json:"send_receipt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid input", http.StatusBadRequest)
 		return
 	}
+	payment := &req.Payment
+
+	if h.Collectors != nil {
+		if err := h.Collectors.AttributePayment(r.Context(), payment); err != nil {
+			responses.Err(w, err)
+			return
+		}
+	}
 
 	// Convert DTO to domain model
-	if err := h.Service.Create(payment); err != nil {
+	if err := h.Service.Create(r.Context(), payment); err != nil {
 		responses.Err(w, err)
 		return
 	}
 
+	sendReceipt := h.AutoSendReceipts
+	if req.SendReceipt != nil {
+		sendReceipt = *req.SendReceipt
+	}
+	if sendReceipt && h.Receipts != nil {
+		// El pago ya quedó registrado: si no se puede encolar el email solo se loguea
+		if _, err := h.Receipts.Enqueue(r.Context(), payment.ID); err != nil {
+			logger.FromContext(r.Context()).Warn("payment receipt not enqueued", "payment_id", payment.ID, "error", err)
+		}
+	}
+
 	w.WriteHeader(http.StatusCreated)
 }
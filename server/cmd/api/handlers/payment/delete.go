@@ -18,7 +18,7 @@ func (h *Handler) DeletePayment(
 		return
 	}
 
-	if err := h.Service.Delete(paymentID); err != nil {
+	if err := h.Service.Delete(r.Context(), paymentID); err != nil {
 		responses.Err(w, err)
 		return
 	}
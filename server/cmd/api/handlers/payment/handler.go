@@ -3,5 +3,10 @@ package payment
 import "github.com/benitez96/gostore/internal/ports"
 
 type Handler struct {
-	Service ports.PaymentService
+	Service  ports.PaymentService
+	Receipts ports.ReceiptService
+	// Collectors atribuye el pago al cobrador que lo registra (opcional)
+	Collectors ports.CollectorService
+	// AutoSendReceipts envía el comprobante por email al crear el pago, salvo que el pedido diga lo contrario
+	AutoSendReceipts bool
 }
@@ -0,0 +1,82 @@
+package pdf
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GenerateCollectionCard genera la tarjeta de cobro de una venta
+func (h *Handler) GenerateCollectionCard(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	idStr := ps.ByName("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "ID de venta inválido", http.StatusBadRequest)
+		return
+	}
+
+	pdfContent, err := h.Service.GenerateCollectionCardsPDF([]int{id})
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	writeCollectionCards(w, "tarjeta_venta_"+idStr+".pdf", pdfContent)
+}
+
+// GenerateCollectionCards genera las tarjetas de cobro en lote.
+// ?sale_ids=1,2,3 elige las ventas; si no, ?collector_id= y/o ?zone= generan las de las ventas
+// pendientes de los clientes de ese cobrador o de esa zona. Sin ningún filtro es un 400.
+func (h *Handler) GenerateCollectionCards(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	query := r.URL.Query()
+
+	var saleIDs []int
+	if value := query.Get("sale_ids"); value != "" {
+		for _, part := range strings.Split(value, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				http.Error(w, "sale_ids must be a comma separated list of sale IDs", http.StatusBadRequest)
+				return
+			}
+			saleIDs = append(saleIDs, id)
+		}
+	} else {
+		var collectorID int64
+		if value := query.Get("collector_id"); value != "" {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				http.Error(w, "collector_id must be a user ID", http.StatusBadRequest)
+				return
+			}
+			collectorID = id
+		}
+
+		pending, err := h.Collectors.PendingSaleIDs(r.Context(), collectorID, query.Get("zone"))
+		if err != nil {
+			responses.Err(w, err)
+			return
+		}
+		for _, id := range pending {
+			saleIDs = append(saleIDs, int(id))
+		}
+	}
+
+	pdfContent, err := h.Service.GenerateCollectionCardsPDF(saleIDs)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	writeCollectionCards(w, "tarjetas_cobro.pdf", pdfContent)
+}
+
+func writeCollectionCards(w http.ResponseWriter, filename string, pdfContent []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(pdfContent)))
+	w.Write(pdfContent)
+}
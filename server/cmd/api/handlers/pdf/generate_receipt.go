@@ -3,10 +3,10 @@ package pdf
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 
 	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/shared/logger"
 	"github.com/julienschmidt/httprouter"
 )
 
@@ -25,8 +25,7 @@ func (h *Handler) GeneratePaymentReceipt(
 		return
 	}
 
-	// Debug: imprimir el tipo y valor del PaymentID
-	log.Printf("PaymentID type: %T, value: %v", req.PaymentID, req.PaymentID)
+	log := logger.FromContext(r.Context())
 
 	// Convertir PaymentID a string
 	var paymentIDStr string
@@ -40,22 +39,20 @@ func (h *Handler) GeneratePaymentReceipt(
 	case int64:
 		paymentIDStr = fmt.Sprintf("%d", v)
 	default:
-		log.Printf("Unsupported PaymentID type: %T", req.PaymentID)
+		log.Warn("unsupported payment_id type", "type", fmt.Sprintf("%T", req.PaymentID))
 		http.Error(w, "Payment ID must be a string or number", http.StatusBadRequest)
 		return
 	}
 
-	log.Printf("Converted PaymentID to string: %s", paymentIDStr)
-
 	if paymentIDStr == "" {
 		http.Error(w, "Payment ID is required", http.StatusBadRequest)
 		return
 	}
 
 	// Generar el PDF usando el service
-	pdfContent, err := h.Service.GeneratePaymentReceiptFromID(paymentIDStr)
+	pdfContent, err := h.Service.GeneratePaymentReceiptFromID(r.Context(), paymentIDStr)
 	if err != nil {
-		log.Printf("Error generating PDF: %v", err)
+		log.Error("error generating payment receipt", "payment_id", paymentIDStr, "error", err)
 		responses.Err(w, err)
 		return
 	}
@@ -0,0 +1,41 @@
+package pdf
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/services/pdf"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GeneratePagare genera el pagaré de una venta
+func (h *Handler) GeneratePagare(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.generateSaleDocument(w, ps, pdf.SaleDocumentPagare)
+}
+
+// GenerateContract genera el contrato de venta en cuotas
+func (h *Handler) GenerateContract(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.generateSaleDocument(w, ps, pdf.SaleDocumentContract)
+}
+
+func (h *Handler) generateSaleDocument(w http.ResponseWriter, ps httprouter.Params, kind pdf.SaleDocumentKind) {
+	idStr := ps.ByName("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "ID de venta inválido", http.StatusBadRequest)
+		return
+	}
+
+	pdfContent, err := h.Service.GenerateSaleDocumentPDF(id, kind)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s_venta_%s.pdf", kind, idStr))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(pdfContent)))
+	w.Write(pdfContent)
+}
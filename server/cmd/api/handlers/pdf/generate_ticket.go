@@ -0,0 +1,69 @@
+package pdf
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/services/pdf"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GeneratePaymentTicket devuelve el comprobante de un pago para ticketera térmica.
+// ?format=pdf|escpos (pdf por defecto) y ?width=58|80 (por defecto el configurado).
+func (h *Handler) GeneratePaymentTicket(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	paymentID := ps.ByName("id")
+	if _, err := strconv.ParseInt(paymentID, 10, 64); err != nil {
+		http.Error(w, "Invalid payment ID", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	format := pdf.TicketFormat(query.Get("format"))
+	if format == "" {
+		format = pdf.TicketFormatPDF
+	}
+
+	width := 0
+	if value := query.Get("width"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			http.Error(w, "Invalid width", http.StatusBadRequest)
+			return
+		}
+		width = parsed
+	}
+
+	content, err := h.Service.GeneratePaymentTicket(r.Context(), paymentID, format, width)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	contentType, filename := "application/pdf", "ticket_"+paymentID+".pdf"
+	if format == pdf.TicketFormatESCPOS {
+		contentType, filename = "application/octet-stream", "ticket_"+paymentID+".bin"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(content)))
+	w.Write(content)
+}
+
+// PrintPaymentTicket envía el comprobante de un pago a la ticketera de red configurada
+func (h *Handler) PrintPaymentTicket(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	paymentID := ps.ByName("id")
+	if _, err := strconv.ParseInt(paymentID, 10, 64); err != nil {
+		http.Error(w, "Invalid payment ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.PrintPaymentTicket(r.Context(), paymentID); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/benitez96/gostore/internal/ports"
 	"github.com/benitez96/gostore/internal/services/pdf"
 	"github.com/julienschmidt/httprouter"
 )
 
 type Handler struct {
 	Service *pdf.Service
+	// Ventas pendientes por cobrador o zona para las tarjetas de cobro
+	Collectors ports.CollectorService
 }
 
 func (h *Handler) RegisterRoutes(router *httprouter.Router) {
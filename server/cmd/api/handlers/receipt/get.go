@@ -0,0 +1,26 @@
+package receipt
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetPaymentReceipts devuelve el historial de envíos del comprobante de un pago
+func (h *Handler) GetPaymentReceipts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	paymentID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid payment ID", http.StatusBadRequest)
+		return
+	}
+
+	deliveries, err := h.Service.GetByPaymentID(r.Context(), paymentID)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, deliveries)
+}
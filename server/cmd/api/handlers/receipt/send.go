@@ -0,0 +1,44 @@
+package receipt
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// SendReceipt envía por email el comprobante de un pago. Si el envío falla
+// queda pendiente (status "pending" y last_error) y el worker lo reintenta.
+func (h *Handler) SendReceipt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	paymentID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid payment ID", http.StatusBadRequest)
+		return
+	}
+
+	delivery, err := h.Service.Send(r.Context(), paymentID)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, delivery)
+}
+
+// RetryReceipt vuelve a encolar un envío fallido
+func (h *Handler) RetryReceipt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid receipt delivery ID", http.StatusBadRequest)
+		return
+	}
+
+	delivery, err := h.Service.Retry(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, delivery)
+}
@@ -0,0 +1,48 @@
+package reminder
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/julienschmidt/httprouter"
+)
+
+// GetReminders lista el outbox; acepta status, limit y offset
+func (h *Handler) GetReminders(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = 50
+	}
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil {
+		offset = 0
+	}
+
+	reminders, err := h.Service.GetAll(r.Context(), query.Get("status"), limit, offset)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, reminders)
+}
+
+// GetQuotaReminders devuelve el historial de envíos de una cuota
+func (h *Handler) GetQuotaReminders(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	quotaID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid quota ID", http.StatusBadRequest)
+		return
+	}
+
+	reminders, err := h.Service.GetByQuotaID(r.Context(), quotaID)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, reminders)
+}
@@ -0,0 +1,9 @@
+package reminder
+
+import (
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+type Handler struct {
+	Service ports.ReminderService
+}
@@ -0,0 +1,67 @@
+package reminder
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// RunReminders busca y envía recordatorios en el momento, sin esperar al worker
+func (h *Handler) RunReminders(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	enqueued, err := h.Service.Scan(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	sent, err := h.Service.Dispatch(r.Context())
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, dto.RunRemindersResponse{Enqueued: enqueued, Sent: sent})
+}
+
+// RetryReminder vuelve a encolar un recordatorio fallido
+func (h *Handler) RetryReminder(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid reminder ID", http.StatusBadRequest)
+		return
+	}
+
+	reminder, err := h.Service.Retry(r.Context(), id)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, reminder)
+}
+
+// UpdateClientOptOut activa o desactiva los recordatorios de un cliente
+func (h *Handler) UpdateClientOptOut(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	clientID, err := strconv.ParseInt(ps.ByName("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid client ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.UpdateRemindersOptOutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.OptOut == nil {
+		http.Error(w, "Invalid request body, expected {\"opt_out\": true|false}", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Service.SetClientOptOut(r.Context(), clientID, *req.OptOut); err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
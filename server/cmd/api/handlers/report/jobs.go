@@ -0,0 +1,81 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/benitez96/gostore/cmd/core/responses"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/middleware"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Nombre del archivo descargado según el tipo de reporte
+var filenames = map[string]string{
+	domain.ReportTypeSalesBook: "libro_ventas_pendientes.pdf",
+}
+
+type createJobRequest struct {
+	Type string `json:"type"`
+}
+
+// CreateJob encola la generación de un reporte y responde 202 con el job.
+// El avance se consulta en GET /api/reports/jobs/:id.
+func (h *Handler) CreateJob(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var req createJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+
+	requestedBy := ""
+	if actor, ok := middleware.GetActor(r); ok {
+		requestedBy = actor.String()
+	}
+
+	job, err := h.Service.Submit(r.Context(), req.Type, requestedBy)
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/api/reports/jobs/"+job.ID)
+	responses.Accepted(w, job)
+}
+
+// GetJob devuelve el estado y el avance de un reporte
+func (h *Handler) GetJob(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	job, err := h.Service.Get(r.Context(), ps.ByName("id"))
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+
+	responses.Ok(w, job)
+}
+
+// DownloadJob descarga el PDF de un reporte terminado
+func (h *Handler) DownloadJob(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	file, job, err := h.Service.Open(r.Context(), ps.ByName("id"))
+	if err != nil {
+		responses.Err(w, err)
+		return
+	}
+	defer file.Close()
+
+	filename, ok := filenames[job.Type]
+	if !ok {
+		filename = job.Type + ".pdf"
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", job.Size))
+
+	if _, err := io.Copy(w, file); err != nil {
+		slog.Error("error sending report", "job_id", job.ID, "error", err)
+	}
+}
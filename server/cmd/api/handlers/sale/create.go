@@ -22,11 +22,11 @@ func (h *Handler) CreateSale(
 		return
 	}
 
-	id, err := h.Service.Create(&dto); 
+	sale, err := h.Service.Create(r.Context(), &dto); 
 	if err != nil {
 		responses.Err(w, err)
 		return
 	}
 
-	responses.Created(w, id)
+	responses.Created(w, sale)
 }
@@ -14,7 +14,7 @@ type Handler struct {
 // RunStateUpdate ejecuta manualmente la actualización de estados
 func (h *Handler) RunStateUpdate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	// Ejecutar la actualización de estados
-	h.Service.UpdateStates()
+	h.Service.UpdateStates(r.Context())
 
 	// Responder con éxito
 	w.Header().Set("Content-Type", "application/json")
@@ -2,19 +2,28 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"flag"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/metrics"
 	"github.com/benitez96/gostore/internal/middleware"
 	"github.com/benitez96/gostore/internal/repositories/db"
 	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
 	"github.com/benitez96/gostore/internal/services/jwt"
 	"github.com/benitez96/gostore/internal/shared/constants"
+	"github.com/benitez96/gostore/internal/shared/logger"
 
 	clientHandler "github.com/benitez96/gostore/cmd/api/handlers/client"
 	paymentHandler "github.com/benitez96/gostore/cmd/api/handlers/payment"
@@ -52,6 +61,7 @@ import (
 
 	stateUpdaterSvc "github.com/benitez96/gostore/internal/services/state-updater"
 
+	healthHandler "github.com/benitez96/gostore/cmd/api/handlers/health"
 	pdfHandler "github.com/benitez96/gostore/cmd/api/handlers/pdf"
 	pdfSvc "github.com/benitez96/gostore/internal/services/pdf"
 
@@ -59,27 +69,68 @@ import (
 	userSvc "github.com/benitez96/gostore/internal/services/user"
 
 	userHandler "github.com/benitez96/gostore/cmd/api/handlers/user"
+
+	apiKeyHandler "github.com/benitez96/gostore/cmd/api/handlers/api_key"
+	backupHandler "github.com/benitez96/gostore/cmd/api/handlers/backup"
+	backupSvc "github.com/benitez96/gostore/internal/services/backup"
+
+	importerHandler "github.com/benitez96/gostore/cmd/api/handlers/importer"
+	importerRepository "github.com/benitez96/gostore/internal/repositories/importer"
+	importerSvc "github.com/benitez96/gostore/internal/services/importer"
+
+	exportHandler "github.com/benitez96/gostore/cmd/api/handlers/export"
+	exporterRepository "github.com/benitez96/gostore/internal/repositories/exporter"
+	exporterSvc "github.com/benitez96/gostore/internal/services/exporter"
+
+	attachmentHandler "github.com/benitez96/gostore/cmd/api/handlers/attachment"
+	auditHandler "github.com/benitez96/gostore/cmd/api/handlers/audit"
+	businessSettingsHandler "github.com/benitez96/gostore/cmd/api/handlers/business_settings"
+	collectorHandler "github.com/benitez96/gostore/cmd/api/handlers/collector"
+	creditHandler "github.com/benitez96/gostore/cmd/api/handlers/credit"
+	eventsHandler "github.com/benitez96/gostore/cmd/api/handlers/events"
+	guarantorHandler "github.com/benitez96/gostore/cmd/api/handlers/guarantor"
+	interactionHandler "github.com/benitez96/gostore/cmd/api/handlers/interaction"
+	receiptHandler "github.com/benitez96/gostore/cmd/api/handlers/receipt"
+	reminderHandler "github.com/benitez96/gostore/cmd/api/handlers/reminder"
+	reportHandler "github.com/benitez96/gostore/cmd/api/handlers/report"
+	webhookHandler "github.com/benitez96/gostore/cmd/api/handlers/webhook"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/events"
+	"github.com/benitez96/gostore/internal/notifier"
+	apiKeyRepository "github.com/benitez96/gostore/internal/repositories/api_key"
+	attachmentRepository "github.com/benitez96/gostore/internal/repositories/attachment"
+	auditRepository "github.com/benitez96/gostore/internal/repositories/audit"
+	businessSettingsRepository "github.com/benitez96/gostore/internal/repositories/business_settings"
+	collectorRepository "github.com/benitez96/gostore/internal/repositories/collector"
+	creditRepository "github.com/benitez96/gostore/internal/repositories/credit"
+	guarantorRepository "github.com/benitez96/gostore/internal/repositories/guarantor"
+	interactionRepository "github.com/benitez96/gostore/internal/repositories/interaction"
+	receiptRepository "github.com/benitez96/gostore/internal/repositories/receipt"
+	reminderRepository "github.com/benitez96/gostore/internal/repositories/reminder"
+	webhookRepository "github.com/benitez96/gostore/internal/repositories/webhook"
+	apiKeySvc "github.com/benitez96/gostore/internal/services/api_key"
+	attachmentSvc "github.com/benitez96/gostore/internal/services/attachment"
+	auditSvc "github.com/benitez96/gostore/internal/services/audit"
+	businessSettingsSvc "github.com/benitez96/gostore/internal/services/business_settings"
+	collectorSvc "github.com/benitez96/gostore/internal/services/collector"
+	creditSvc "github.com/benitez96/gostore/internal/services/credit"
+	guarantorSvc "github.com/benitez96/gostore/internal/services/guarantor"
+	interactionSvc "github.com/benitez96/gostore/internal/services/interaction"
+	receiptSvc "github.com/benitez96/gostore/internal/services/receipt"
+	reminderSvc "github.com/benitez96/gostore/internal/services/reminder"
+	reportSvc "github.com/benitez96/gostore/internal/services/report"
+	webhookSvc "github.com/benitez96/gostore/internal/services/webhook"
+	"github.com/benitez96/gostore/internal/storage"
 )
 
 // CORS middleware
-func enableCORS(next http.Handler) http.Handler {
+func enableCORS(next http.Handler, origin string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Determinar el origen permitido basado en el entorno
-		origin := os.Getenv("FRONTEND_URL")
-		if origin == "" {
-			// En desarrollo, permitir localhost:5173
-			// En producción, será la misma URL del servidor
-			if os.Getenv("ENVIRONMENT") == "production" {
-				origin = "*" // En producción, el frontend se sirve desde el mismo servidor
-			} else {
-				origin = "http://localhost:5173"
-			}
-		}
-
 		w.Header().Set("Access-Control-Allow-Origin", origin)
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, Content-Disposition")
 
 		// Handle preflight requests
 		if r.Method == "OPTIONS" {
@@ -115,25 +166,39 @@ func serveStaticFiles(staticDir string) http.Handler {
 }
 
 func main() {
+	configPath := flag.String("config", "", "Ruta al archivo de configuración JSON (por defecto $"+config.ConfigPathEnv+")")
+	flag.Parse()
 
-	dbConnection, err := db.Connect()
+	// Cargar y validar la configuración antes de inicializar cualquier componente
+	cfg, err := config.Load(*configPath)
 	if err != nil {
-		log.Fatal("No se pudo conectar a la base de datos:", err)
+		log.Fatal(err)
 	}
-	defer dbConnection.Close()
 
-	// Obtener JWT secret key de variable de entorno o usar una por defecto
-	jwtSecretKey := os.Getenv("JWT_SECRET_KEY")
-	if jwtSecretKey == "" {
-		jwtSecretKey = "your-secret-key-change-this-in-production" // ¡CAMBIAR EN PRODUCCIÓN!
-		log.Println("Warning: Using default JWT secret key. Set JWT_SECRET_KEY environment variable for production.")
+	// Logs estructurados en JSON; log.Printf del resto del código también pasa por acá
+	slog.SetDefault(logger.New(cfg.IsProduction()))
+	for _, warning := range cfg.Warnings() {
+		slog.Warn(warning)
+	}
+
+	dbConnection, err := db.Connect(cfg.Database)
+	if err != nil {
+		log.Fatal("No se pudo conectar a la base de datos:", err)
 	}
 
 	// Inicializar JWT service
-	jwtService := jwt.NewService(jwtSecretKey)
+	jwtService := jwt.NewService(cfg.Auth.JWTSecret)
 
-	// Inicializar middleware de autenticación
-	authMiddleware := middleware.NewAuthMiddleware(jwtService)
+	apiKeyRepository := apiKeyRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	apiKeySvc := apiKeySvc.Service{
+		Repo: &apiKeyRepository,
+	}
+
+	// Inicializar middleware de autenticación (JWT o API key)
+	authMiddleware := middleware.NewAuthMiddleware(jwtService, &apiKeySvc)
 
 	noteRepository := noteRepository.Repository{
 		Queries: sqlc.New(dbConnection),
@@ -175,21 +240,104 @@ func main() {
 		Queries: sqlc.New(dbConnection),
 	}
 
+	importerRepository := importerRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+		DB:      dbConnection,
+	}
+
+	exporterRepository := exporterRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	reminderRepository := reminderRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	receiptRepository := receiptRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	webhookRepository := webhookRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	businessSettingsRepository := businessSettingsRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	collectorRepository := collectorRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	guarantorRepository := guarantorRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	creditRepository := creditRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	interactionRepository := interactionRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+		DB:      dbConnection,
+	}
+
+	attachmentRepository := attachmentRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	auditRepository := auditRepository.Repository{
+		Queries: sqlc.New(dbConnection),
+	}
+
+	// Bus de eventos de negocio: lo consumen los webhooks y el stream SSE
+	eventBus := &events.Bus{}
+	liveEvents := &events.Broker{}
+	eventBus.Subscribe(liveEvents.Handle)
+
 	// Inicializar el StateUpdater service
 	stateUpdaterSvc := stateUpdaterSvc.Service{
 		QuotaRepo:   &quotaRepository,
 		SaleRepo:    &saleRepository,
 		ClientRepo:  &clientRepository,
 		PaymentRepo: &paymentRepository,
+		Events:      eventBus,
+	}
+
+	creditSvc := creditSvc.Service{
+		Repo: &creditRepository,
+	}
+
+	interactionSvc := interactionSvc.Service{
+		Repo:       &interactionRepository,
+		ClientRepo: &clientRepository,
+	}
+
+	// Log de auditoría de los requests que modifican datos
+	auditSvc := auditSvc.Service{
+		Repo: &auditRepository,
+	}
+
+	// Adjuntos de clientes, ventas y pagos guardados en disco
+	attachmentSvc := attachmentSvc.Service{
+		Repo:    &attachmentRepository,
+		Storage: &storage.Local{Dir: cfg.Attachments.Dir},
+		Config:  cfg.Attachments,
 	}
+	eventBus.Subscribe(attachmentSvc.HandleEvent)
 
 	saleSvc := saleSvc.Service{
-		Sr:           &saleRepository,
-		Spr:          &saleProductRepository,
-		Qr:           &quotaRepository,
-		Pr:           &paymentRepository,
-		ClientRepo:   &clientRepository,
-		StateUpdater: &stateUpdaterSvc,
+		Sr:                &saleRepository,
+		Spr:               &saleProductRepository,
+		Qr:                &quotaRepository,
+		Pr:                &paymentRepository,
+		ClientRepo:        &clientRepository,
+		StateUpdater:      &stateUpdaterSvc,
+		Events:            eventBus,
+		Products:          &productRepository,
+		LowStockThreshold: cfg.Inventory.LowStockThreshold,
+		Guarantors:        &guarantorRepository,
+		Credit:            &creditSvc,
 	}
 
 	paymentSvc := paymentSvc.Service{
@@ -198,6 +346,8 @@ func main() {
 		SaleRepo:     &saleRepository,
 		ClientRepo:   &clientRepository,
 		StateUpdater: &stateUpdaterSvc,
+		Events:       eventBus,
+		Promises:     &interactionSvc,
 	}
 
 	quotaSvc := quotaSvc.Service{
@@ -210,7 +360,9 @@ func main() {
 	}
 
 	productSvc := productSvc.Service{
-		Repo: &productRepository,
+		Repo:              &productRepository,
+		Events:            eventBus,
+		LowStockThreshold: cfg.Inventory.LowStockThreshold,
 	}
 
 	chartSvc := chartSvc.Service{
@@ -224,23 +376,92 @@ func main() {
 
 	// Inicializar el worker service
 	workerSvc := workerSvc.Service{
-		Queries: sqlc.New(dbConnection),
+		Queries:  sqlc.New(dbConnection),
+		Interval: time.Duration(cfg.Worker.StateUpdateInterval),
+		Events:   eventBus,
+		Promises: &interactionSvc,
 	}
 
 	clientSvc := clientSvc.Service{
 		Repo:    &clientRepository,
 		SaleSvc: &saleSvc,
+		Events:  eventBus,
+	}
+
+	backupSvc := backupSvc.Service{
+		DB:         dbConnection,
+		Dir:        cfg.Backup.Dir,
+		KeepDaily:  cfg.Backup.KeepDaily,
+		KeepWeekly: cfg.Backup.KeepWeekly,
+		Interval:   time.Duration(cfg.Backup.Interval),
+	}
+
+	importerSvc := importerSvc.Service{
+		Repo:         &importerRepository,
+		StateUpdater: &stateUpdaterSvc,
+	}
+
+	exporterSvc := exporterSvc.Service{
+		Repo: &exporterRepository,
+	}
+
+	// Notifiers por canal (email, whatsapp/sms o archivo en local)
+	notifiers := notifier.FromConfig(cfg.Notify)
+
+	reminderSvc := reminderSvc.Service{
+		Repo:      &reminderRepository,
+		Notifiers: notifiers,
+		Config:    cfg.Reminders,
+	}
+
+	webhookSvc := webhookSvc.Service{
+		Repo:   &webhookRepository,
+		Config: cfg.Webhooks,
+	}
+	eventBus.Subscribe(webhookSvc.HandleEvent)
+
+	businessSettingsSvc := businessSettingsSvc.Service{
+		Repo: &businessSettingsRepository,
+	}
+
+	collectorSvc := collectorSvc.Service{
+		Repo:       &collectorRepository,
+		Guarantors: &guarantorRepository,
+	}
+
+	guarantorSvc := guarantorSvc.Service{
+		Repo: &guarantorRepository,
 	}
 
 	// Inicializar el servicio PDF
-	pdfSvc := pdfSvc.NewService(&paymentSvc, &quotaSvc, &clientSvc, &saleSvc)
+	pdfSvc, err := pdfSvc.NewService(cfg.PDF, &paymentSvc, &quotaSvc, &clientSvc, &saleSvc, &businessSettingsSvc)
+	if err != nil {
+		log.Fatal("No se pudo inicializar el servicio PDF:", err)
+	}
+
+	// Envío de comprobantes por email (usa el PDF del comprobante)
+	receiptSvc := receiptSvc.Service{
+		Repo:     &receiptRepository,
+		PDF:      pdfSvc,
+		Notifier: notifiers[domain.ChannelEmail],
+		Config:   cfg.Receipts,
+	}
+
+	// Reportes PDF largos generados en segundo plano
+	reportSvc := reportSvc.Service{
+		Generator: pdfSvc,
+		Config:    cfg.Reports,
+	}
 
 	saleHandler := saleHandler.Handler{
 		Service: &saleSvc,
 	}
 
 	paymentHandler := paymentHandler.Handler{
-		Service: &paymentSvc,
+		Service:          &paymentSvc,
+		Receipts:         &receiptSvc,
+		Collectors:       &collectorSvc,
+		AutoSendReceipts: cfg.Receipts.AutoSend,
 	}
 
 	quotaHandler := quotaHandler.Handler{
@@ -272,11 +493,91 @@ func main() {
 	}
 
 	pdfHandler := pdfHandler.Handler{
-		Service: pdfSvc,
+		Service:    pdfSvc,
+		Collectors: &collectorSvc,
+	}
+
+	apiKeyHandler := apiKeyHandler.Handler{
+		Service: &apiKeySvc,
+	}
+
+	backupHandler := backupHandler.Handler{
+		Service: &backupSvc,
+	}
+
+	importerHandler := importerHandler.Handler{
+		Service: &importerSvc,
+	}
+
+	exportHandler := exportHandler.Handler{
+		Service: &exporterSvc,
+	}
+
+	reminderHandler := reminderHandler.Handler{
+		Service: &reminderSvc,
+	}
+
+	receiptHandler := receiptHandler.Handler{
+		Service: &receiptSvc,
+	}
+
+	webhookHandler := webhookHandler.Handler{
+		Service: &webhookSvc,
+	}
+
+	reportHandler := reportHandler.Handler{
+		Service: &reportSvc,
+	}
+
+	businessSettingsHandler := businessSettingsHandler.Handler{
+		Service: &businessSettingsSvc,
+	}
+
+	collectorHandler := collectorHandler.Handler{
+		Service: &collectorSvc,
+		PDF:     pdfSvc,
+	}
+
+	guarantorHandler := guarantorHandler.Handler{
+		Service: &guarantorSvc,
+	}
+
+	creditHandler := creditHandler.Handler{
+		Service: &creditSvc,
+	}
+
+	interactionHandler := interactionHandler.Handler{
+		Service: &interactionSvc,
+	}
+
+	attachmentHandler := attachmentHandler.Handler{
+		Service: &attachmentSvc,
+		MaxSize: attachmentSvc.MaxSize(),
+	}
+
+	auditHandler := auditHandler.Handler{
+		Service: &auditSvc,
+	}
+
+	eventsHandler := eventsHandler.Handler{
+		Broker:  liveEvents,
+		Tickets: &middleware.StreamTickets{},
+	}
+
+	healthHandler := healthHandler.Handler{
+		Checks: []healthHandler.Check{
+			{Name: "database", Run: dbConnection.PingContext},
+			{Name: "pdf", Run: pdfSvc.CheckRenderer},
+		},
+		Timeout: 5 * time.Second,
 	}
 
 	router := httprouter.New()
 
+	// Health routes (liveness y readiness, sin autenticación)
+	router.GET("/healthz", healthHandler.Healthz)
+	router.GET("/readyz", healthHandler.Readyz)
+
 	// Public routes (no authentication required)
 	router.POST("/api/auth/login", userHandler.Login)
 	router.POST("/api/auth/refresh", userHandler.RefreshToken)
@@ -290,6 +591,87 @@ func main() {
 	router.PUT("/api/clients/:id", authMiddleware.RequirePermission(constants.PermissionClients)(clientHandler.UpdateClient))
 	router.DELETE("/api/clients/:id", authMiddleware.RequirePermission(constants.PermissionClients)(clientHandler.DeleteClient))
 
+	// Import routes - Requiere el permiso de la entidad importada
+	router.POST("/api/import/clients", authMiddleware.RequirePermission(constants.PermissionClients)(importerHandler.ImportClients))
+	router.POST("/api/import/products", authMiddleware.RequirePermission(constants.PermissionProducts)(importerHandler.ImportProducts))
+	router.POST("/api/import/sales", authMiddleware.RequirePermission(constants.PermissionSales)(importerHandler.ImportSales))
+
+	// Export routes - Planillas CSV/XLSX con los mismos filtros que GET /api/clients
+	router.GET("/api/export/clients", authMiddleware.RequirePermission(constants.PermissionClients)(exportHandler.ExportClients))
+	router.GET("/api/export/sales", authMiddleware.RequirePermission(constants.PermissionSales)(exportHandler.ExportSales))
+	router.GET("/api/export/quotas", authMiddleware.RequirePermission(constants.PermissionSales)(exportHandler.ExportQuotas))
+	router.GET("/api/export/payments", authMiddleware.RequirePermission(constants.PermissionSales)(exportHandler.ExportPayments))
+
+	// Reminder routes - Outbox e historial de recordatorios de vencimiento
+	router.GET("/api/reminders", authMiddleware.RequirePermission(constants.PermissionSales)(reminderHandler.GetReminders))
+	router.POST("/api/reminders/:id/retry", authMiddleware.RequirePermission(constants.PermissionSales)(reminderHandler.RetryReminder))
+	router.GET("/api/quotas/:id/reminders", authMiddleware.RequirePermission(constants.PermissionSales)(reminderHandler.GetQuotaReminders))
+	router.PUT("/api/clients/:id/reminders", authMiddleware.RequirePermission(constants.PermissionClients)(reminderHandler.UpdateClientOptOut))
+	router.POST("/api/worker/send-reminders", authMiddleware.RequirePermission(constants.PermissionSales)(reminderHandler.RunReminders))
+
+	// Eventos en vivo (SSE): cada usuario recibe solo lo que sus permisos le dejan ver.
+	// El navegador abre el stream con un ticket de un solo uso en lugar del token
+	router.POST("/api/events/ticket", authMiddleware.RequireAuth(eventsHandler.CreateTicket))
+	router.GET("/api/events", authMiddleware.RequireStreamTicket(eventsHandler.Tickets, eventsHandler.Stream))
+
+	// Business settings - Datos del comercio para los PDFs: cualquiera los ve, solo admin los edita
+	router.GET("/api/settings/business", authMiddleware.RequireAuth(businessSettingsHandler.GetSettings))
+	router.PUT("/api/settings/business", authMiddleware.RequirePermission(constants.PermissionUsers)(businessSettingsHandler.UpdateSettings))
+	router.GET("/api/settings/business/logo", authMiddleware.RequireAuth(businessSettingsHandler.GetLogo))
+	router.PUT("/api/settings/business/logo", authMiddleware.RequirePermission(constants.PermissionUsers)(businessSettingsHandler.UploadLogo))
+	router.DELETE("/api/settings/business/logo", authMiddleware.RequirePermission(constants.PermissionUsers)(businessSettingsHandler.DeleteLogo))
+
+	// Collector routes - Los cobradores son usuarios con permiso de cobrador: cada uno ve su hoja de ruta
+	// y sus totales (/api/collectors/me/...); con permiso de ventas se ven los de cualquiera
+	collectorAccess := constants.PermissionSales | constants.PermissionCollector
+	router.GET("/api/collectors", authMiddleware.RequirePermission(constants.PermissionClients)(collectorHandler.GetCollectors))
+	router.PUT("/api/collectors/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(collectorHandler.UpdateCollector))
+	router.GET("/api/collectors/:id/clients", authMiddleware.RequirePermission(collectorAccess)(collectorHandler.GetClients))
+	router.GET("/api/collectors/:id/route", authMiddleware.RequirePermission(collectorAccess)(collectorHandler.GetRouteSheet))
+	router.GET("/api/collectors/:id/summary", authMiddleware.RequirePermission(collectorAccess)(collectorHandler.GetSummary))
+	router.PUT("/api/clients/:id/collector", authMiddleware.RequirePermission(constants.PermissionClients)(collectorHandler.AssignClient))
+
+	// Guarantor routes - Garantes de ventas de alto valor; el seguimiento lista los de clientes suspendidos
+	router.GET("/api/guarantors", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.GetGuarantors))
+	router.POST("/api/guarantors", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.CreateGuarantor))
+	router.GET("/api/guarantors/:id", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.GetGuarantor))
+	router.PUT("/api/guarantors/:id", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.UpdateGuarantor))
+	router.DELETE("/api/guarantors/:id", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.DeleteGuarantor))
+	router.GET("/api/guarantors-follow-up", authMiddleware.RequirePermission(collectorAccess)(guarantorHandler.GetFollowUps))
+	router.GET("/api/sales/:id/guarantors", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.GetSaleGuarantors))
+	router.POST("/api/sales/:sale_id/guarantors", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.LinkGuarantor))
+	router.DELETE("/api/sales/:id/guarantors/:guarantor_id", authMiddleware.RequirePermission(constants.PermissionSales)(guarantorHandler.UnlinkGuarantor))
+
+	// Credit routes - Reglas de crédito por estado y límites por cliente que se controlan al crear una venta;
+	// los límites y las autorizaciones fuera de regla quedan para los encargados (permiso de dashboard)
+	router.GET("/api/credit-policies", authMiddleware.RequirePermission(constants.PermissionSales|constants.PermissionUsers)(creditHandler.GetPolicies))
+	router.PUT("/api/credit-policies/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(creditHandler.UpdatePolicy))
+	router.GET("/api/credit-overrides", authMiddleware.RequirePermission(constants.PermissionDashboard|constants.PermissionUsers)(creditHandler.GetOverrides))
+	router.GET("/api/clients/:id/credit", authMiddleware.RequirePermission(constants.PermissionClients|constants.PermissionSales)(creditHandler.GetClientCredit))
+	router.PUT("/api/clients/:id/credit-limits", authMiddleware.RequirePermission(constants.PermissionClients)(creditHandler.UpdateClientLimits))
+
+	// Interaction routes - Gestiones de cobranza y promesas de pago por cliente; los cobradores
+	// solo ven y cargan las de sus clientes asignados
+	clientFollowUp := constants.PermissionClients | collectorAccess
+	router.GET("/api/clients/:id/interactions", authMiddleware.RequirePermission(clientFollowUp)(interactionHandler.GetInteractions))
+	router.POST("/api/clients/:id/interactions", authMiddleware.RequirePermission(clientFollowUp)(interactionHandler.CreateInteraction))
+	router.GET("/api/clients/:id/promises", authMiddleware.RequirePermission(clientFollowUp)(interactionHandler.GetPromises))
+	router.POST("/api/clients/:id/promises", authMiddleware.RequirePermission(clientFollowUp)(interactionHandler.CreatePromise))
+	router.GET("/api/promises-due", authMiddleware.RequirePermission(collectorAccess)(interactionHandler.GetPromisesDue))
+
+	// Attachment routes - Archivos de clientes (permiso de clientes), ventas y pagos (permiso de ventas);
+	// las rutas por ID controlan el permiso según a quién pertenece el adjunto
+	router.GET("/api/clients/:id/attachments", authMiddleware.RequirePermission(constants.PermissionClients)(attachmentHandler.GetClientAttachments))
+	router.POST("/api/clients/:id/attachments", authMiddleware.RequirePermission(constants.PermissionClients)(attachmentHandler.UploadClientAttachment))
+	router.GET("/api/sales/:id/attachments", authMiddleware.RequirePermission(constants.PermissionSales)(attachmentHandler.GetSaleAttachments))
+	router.POST("/api/sales/:sale_id/attachments", authMiddleware.RequirePermission(constants.PermissionSales)(attachmentHandler.UploadSaleAttachment))
+	router.GET("/api/payments/:id/attachments", authMiddleware.RequirePermission(constants.PermissionSales)(attachmentHandler.GetPaymentAttachments))
+	router.POST("/api/payments/:id/attachments", authMiddleware.RequirePermission(constants.PermissionSales)(attachmentHandler.UploadPaymentAttachment))
+	router.GET("/api/attachments/:id", authMiddleware.RequirePermission(constants.PermissionClients|constants.PermissionSales)(attachmentHandler.GetAttachment))
+	router.GET("/api/attachments/:id/file", authMiddleware.RequirePermission(constants.PermissionClients|constants.PermissionSales)(attachmentHandler.Download))
+	router.GET("/api/attachments/:id/thumbnail", authMiddleware.RequirePermission(constants.PermissionClients|constants.PermissionSales)(attachmentHandler.Thumbnail))
+	router.DELETE("/api/attachments/:id", authMiddleware.RequirePermission(constants.PermissionClients|constants.PermissionSales)(attachmentHandler.DeleteAttachment))
+
 	// User routes - Requiere permiso de usuarios (solo admin)
 	router.POST("/api/users", authMiddleware.RequirePermission(constants.PermissionUsers)(userHandler.CreateUser))
 	router.GET("/api/users", authMiddleware.RequirePermission(constants.PermissionUsers)(userHandler.GetUsers))
@@ -298,6 +680,24 @@ func main() {
 	router.PUT("/api/users/:id/password", authMiddleware.RequirePermission(constants.PermissionUsers)(userHandler.UpdateUserPassword))
 	router.DELETE("/api/users/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(userHandler.DeleteUser))
 
+	// API key routes - Requiere permiso de usuarios (solo admin)
+	router.POST("/api/api-keys", authMiddleware.RequirePermission(constants.PermissionUsers)(apiKeyHandler.CreateAPIKey))
+	router.GET("/api/api-keys", authMiddleware.RequirePermission(constants.PermissionUsers)(apiKeyHandler.GetAPIKeys))
+	router.GET("/api/api-keys/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(apiKeyHandler.GetAPIKeyByID))
+	router.DELETE("/api/api-keys/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(apiKeyHandler.RevokeAPIKey))
+
+	// Audit log - Requiere permiso de usuarios (solo admin)
+	router.GET("/api/audit-log", authMiddleware.RequirePermission(constants.PermissionUsers)(auditHandler.Get))
+
+	// Webhook routes - Requiere permiso de usuarios (solo admin)
+	router.POST("/api/webhooks", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.CreateWebhook))
+	router.GET("/api/webhooks", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.GetWebhooks))
+	router.GET("/api/webhooks/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.GetWebhookByID))
+	router.PUT("/api/webhooks/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.UpdateWebhook))
+	router.DELETE("/api/webhooks/:id", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.DeleteWebhook))
+	router.GET("/api/webhooks/:id/deliveries", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.GetDeliveries))
+	router.POST("/api/webhook-deliveries/:id/redeliver", authMiddleware.RequirePermission(constants.PermissionUsers)(webhookHandler.Redeliver))
+
 	// Sale routes - Requiere permiso de ventas
 	router.POST("/api/sales", authMiddleware.RequirePermission(constants.PermissionSales)(saleHandler.CreateSale))
 	router.GET("/api/sales/:id", authMiddleware.RequirePermission(constants.PermissionSales)(saleHandler.GetByID))
@@ -323,38 +723,64 @@ func main() {
 	router.GET("/api/charts/collections/daily", authMiddleware.RequirePermission(constants.PermissionDashboard)(chartHandler.GetDailyCollections))
 
 	// Payment routes - Requiere permiso de ventas (los pagos están asociados a ventas)
-	router.POST("/api/payments", authMiddleware.RequirePermission(constants.PermissionSales)(paymentHandler.CreatePayment))
+	router.POST("/api/payments", authMiddleware.RequirePermission(constants.PermissionSales|constants.PermissionCollector)(paymentHandler.CreatePayment))
 	router.DELETE("/api/payments/:id", authMiddleware.RequirePermission(constants.PermissionSales)(paymentHandler.DeletePayment))
+	router.POST("/api/payments/:id/send-receipt", authMiddleware.RequirePermission(constants.PermissionSales)(receiptHandler.SendReceipt))
+	router.GET("/api/payments/:id/receipts", authMiddleware.RequirePermission(constants.PermissionSales)(receiptHandler.GetPaymentReceipts))
+	router.POST("/api/receipts/:id/retry", authMiddleware.RequirePermission(constants.PermissionSales)(receiptHandler.RetryReceipt))
 
 	// PDF routes - Requiere permiso de ventas para generar PDFs
 	router.POST("/api/pdf/generate-receipt", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GeneratePaymentReceipt))
 	router.GET("/api/pdf/venta/:id", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GenerateSaleSheet))
+	router.GET("/api/pdf/venta/:id/pagare", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GeneratePagare))
+	router.GET("/api/pdf/venta/:id/contrato", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GenerateContract))
+	router.GET("/api/pdf/venta/:id/tarjeta", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GenerateCollectionCard))
+	router.GET("/api/pdf/tarjetas-cobro", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GenerateCollectionCards))
 	router.GET("/api/pdf/libro-ventas", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GenerateSalesBook))
+	router.GET("/api/pdf/ticket/:id", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.GeneratePaymentTicket))
+	router.POST("/api/pdf/ticket/:id/print", authMiddleware.RequirePermission(constants.PermissionSales)(pdfHandler.PrintPaymentTicket))
+
+	// Report jobs - reportes largos en segundo plano (POST devuelve el job, luego se consulta y descarga)
+	router.POST("/api/reports/jobs", authMiddleware.RequirePermission(constants.PermissionSales)(reportHandler.CreateJob))
+	router.GET("/api/reports/jobs/:id", authMiddleware.RequirePermission(constants.PermissionSales)(reportHandler.GetJob))
+	router.GET("/api/reports/jobs/:id/download", authMiddleware.RequirePermission(constants.PermissionSales)(reportHandler.DownloadJob))
 
 	// Quota routes - Requiere permiso de ventas (las cuotas están asociadas a ventas)
 	router.PUT("/api/quotas/:id", authMiddleware.RequirePermission(constants.PermissionSales)(quotaHandler.UpdateQuota))
 
+	// Backup routes - Requiere permiso de usuarios (solo admin)
+	router.GET("/api/backups", authMiddleware.RequirePermission(constants.PermissionUsers)(backupHandler.GetBackups))
+	router.POST("/api/backups", authMiddleware.RequirePermission(constants.PermissionUsers)(backupHandler.CreateBackup))
+	router.GET("/api/backups/:name/download", authMiddleware.RequirePermission(constants.PermissionUsers)(backupHandler.DownloadBackup))
+	router.POST("/api/backups/:name/restore", authMiddleware.RequirePermission(constants.PermissionUsers)(backupHandler.RestoreBackup))
+
 	// Worker routes - Requiere permiso de usuarios (solo admin)
 	router.POST("/api/worker/update-states", authMiddleware.RequirePermission(constants.PermissionUsers)(workerHandler.RunStateUpdate))
 
 	// Configurar servidor de archivos estáticos
-	staticDir := os.Getenv("STATIC_DIR")
-	if staticDir == "" {
-		staticDir = "./static" // Directorio por defecto
-	}
+	staticDir := cfg.Server.StaticDir
 
 	// Crear un mux que maneje tanto API como archivos estáticos
 	mux := http.NewServeMux()
 
 	// Registrar rutas de API
-	mux.Handle("/api/", enableCORS(router))
+	mux.Handle("/api/", enableCORS(router, cfg.AllowedOrigin()))
+	mux.Handle("/healthz", router)
+	mux.Handle("/readyz", router)
+
+	// Métricas de Prometheus (runtime, HTTP, PDF, worker y negocio)
+	if cfg.Metrics.Enabled {
+		mux.Handle("/metrics", metrics.Handler(&metrics.BusinessCollector{
+			Queries: sqlc.New(dbConnection),
+		}, cfg.Metrics.Token))
+	}
 
 	// Servir archivos estáticos del frontend
 	if _, err := os.Stat(staticDir); err == nil {
-		fmt.Printf("📁 Serving static files from: %s\n", staticDir)
+		slog.Info("serving static files", "dir", staticDir)
 		mux.Handle("/", serveStaticFiles(staticDir))
 	} else {
-		fmt.Printf("⚠️  Static directory not found: %s. API-only mode.\n", staticDir)
+		slog.Warn("static directory not found, API-only mode", "dir", staticDir)
 		mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.Path == "/" {
 				w.Header().Set("Content-Type", "application/json")
@@ -367,25 +793,108 @@ func main() {
 	}
 
 	// Iniciar el worker en una goroutine separada
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
 
+	workers.Add(1)
 	go func() {
-		workerSvc.RunStateUpdateWorker(ctx)
+		defer workers.Done()
+		workerSvc.RunStateUpdateWorker(workerCtx)
 	}()
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	if cfg.Backup.Enabled {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			backupSvc.RunScheduler(workerCtx)
+		}()
 	}
 
-	fmt.Printf("🚀 Starting GoStore server on port %s\n", port)
-	if _, err := os.Stat(staticDir); err == nil {
-		fmt.Printf("🌐 Frontend available at: http://localhost:%s\n", port)
-		fmt.Printf("🔗 API available at: http://localhost:%s/api\n", port)
-	} else {
-		fmt.Printf("🔗 API available at: http://localhost:%s/api\n", port)
+	if cfg.Reminders.Enabled {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			reminderSvc.RunWorker(workerCtx)
+		}()
+	}
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		webhookSvc.RunWorker(workerCtx)
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		reportSvc.RunWorker(workerCtx)
+	}()
+
+	// Sin email configurado no hay nada que enviar ni reintentar
+	if receiptSvc.Notifier != nil {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			receiptSvc.RunWorker(workerCtx)
+		}()
+	}
+
+	port := cfg.Server.Port
+
+	slog.Info("starting GoStore server", "port", port, "environment", cfg.Environment)
+
+	server := &http.Server{
+		Addr:    ":" + port,
+		Handler: middleware.Metrics(router, middleware.RequestLogger(middleware.Audit(router, &auditSvc, mux))),
+	}
+	// Los streams SSE no terminan solos: se cortan al empezar el apagado
+	server.RegisterOnShutdown(liveEvents.Close)
+
+	// Esperar SIGINT/SIGTERM (systemctl stop/restart) para apagar ordenadamente
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("server error", "error", err)
+		}
+	case <-signalCtx.Done():
+		slog.Info("shutdown signal received, draining requests")
+	}
+
+	// 1. Dejar de aceptar conexiones y esperar los requests en curso (p. ej. un pago a mitad de transacción)
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), time.Duration(cfg.Server.ShutdownTimeout))
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("HTTP server did not drain in time", "error", err)
+	}
+
+	// 2. Detener los workers y esperar a que terminen la pasada en curso.
+	// Tienen su propio plazo: el de Shutdown puede haberse consumido drenando requests
+	stopWorker()
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	workersTimeout := time.NewTimer(time.Duration(cfg.Server.ShutdownTimeout))
+	defer workersTimeout.Stop()
+	select {
+	case <-workersDone:
+	case <-workersTimeout.C:
+		slog.Warn("workers did not stop before the shutdown timeout")
+	}
+
+	// 3. Cerrar la base de datos
+	if err := dbConnection.Close(); err != nil {
+		slog.Error("error closing database", "error", err)
 	}
 
-	log.Fatal(http.ListenAndServe(":"+port, mux))
+	slog.Info("GoStore server stopped")
 }
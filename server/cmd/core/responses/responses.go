@@ -30,9 +30,14 @@ func Err(w http.ResponseWriter, err error) {
 }
 
 var ErrCodeMapping map[string]int = map[string]int{
-	domain.ErrCodeDuplicateKey:  http.StatusConflict,
-	domain.ErrCodeNotFound:      http.StatusNotFound,
-	domain.ErrCodeInvalidParams: http.StatusBadRequest,
+	domain.ErrCodeCreditLimitExceeded:  http.StatusUnprocessableEntity,
+	domain.ErrCodeDuplicateKey:         http.StatusConflict,
+	domain.ErrCodeFileTooLarge:         http.StatusRequestEntityTooLarge,
+	domain.ErrCodeForbidden:            http.StatusForbidden,
+	domain.ErrCodeNotFound:             http.StatusNotFound,
+	domain.ErrCodeInvalidParams:        http.StatusBadRequest,
+	domain.ErrCodeTooManyRequests:      http.StatusTooManyRequests,
+	domain.ErrCodeUnsupportedMediaType: http.StatusUnsupportedMediaType,
 }
 
 func Ok(w http.ResponseWriter, data any) {
@@ -40,6 +45,14 @@ func Ok(w http.ResponseWriter, data any) {
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(data)
 }
+
+// Accepted responde 202 para operaciones que siguen en segundo plano
+func Accepted(w http.ResponseWriter, data any) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(data)
+}
+
 func Created(w http.ResponseWriter, data any) {
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/repositories/db"
+	backupSvc "github.com/benitez96/gostore/internal/services/backup"
+)
+
+func runBackup(configPath string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("falta el subcomando: backup list | create | download <nombre> [destino] | restore <nombre>")
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	dbConnection, err := db.Connect(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer dbConnection.Close()
+
+	service := &backupSvc.Service{
+		DB:         dbConnection,
+		Dir:        cfg.Backup.Dir,
+		KeepDaily:  cfg.Backup.KeepDaily,
+		KeepWeekly: cfg.Backup.KeepWeekly,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	switch args[0] {
+	case "list":
+		backups, err := service.List()
+		if err != nil {
+			return err
+		}
+		if len(backups) == 0 {
+			fmt.Printf("No hay backups en %s\n", cfg.Backup.Dir)
+			return nil
+		}
+		for _, b := range backups {
+			fmt.Printf("%-40s %10.1f KB  %s\n", b.Name, float64(b.Size)/1024, b.CreatedAt.Format("02/01/2006 15:04:05"))
+		}
+		return nil
+
+	case "create":
+		b, err := service.Create(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Backup creado: %s\n", filepath.Join(cfg.Backup.Dir, b.Name))
+		return nil
+
+	case "download":
+		if len(args) < 2 {
+			return fmt.Errorf("uso: backup download <nombre> [destino]")
+		}
+		dest := args[1]
+		if len(args) > 2 {
+			dest = args[2]
+		}
+		return copyBackup(service, args[1], dest)
+
+	case "restore":
+		if len(args) < 2 {
+			return fmt.Errorf("uso: backup restore <nombre>")
+		}
+		if err := service.Restore(ctx, args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Base restaurada desde %s (el estado anterior quedó como backup prerestore)\n", args[1])
+		return nil
+
+	default:
+		return fmt.Errorf("subcomando desconocido: backup %s", args[0])
+	}
+}
+
+// copyBackup copia un backup fuera del directorio de backups (p. ej. a un disco externo)
+func copyBackup(service *backupSvc.Service, name, dest string) error {
+	src, err := service.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		dest = filepath.Join(dest, name)
+	}
+
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if err != nil {
+		return fmt.Errorf("error creando %s: %w", dest, err)
+	}
+
+	if _, err := io.Copy(out, src); err != nil {
+		out.Close()
+		os.Remove(dest)
+		return fmt.Errorf("error copiando backup: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("error copiando backup: %w", err)
+	}
+
+	fmt.Printf("✅ Backup copiado a %s\n", dest)
+	return nil
+}
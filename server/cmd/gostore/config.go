@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/benitez96/gostore/internal/config"
+)
+
+func runConfig(configPath string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("falta el subcomando: config print | config validate")
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "print":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(cfg.Redacted())
+	case "validate":
+		for _, warning := range cfg.Warnings() {
+			fmt.Println("⚠️  " + warning)
+		}
+		fmt.Println("✅ Configuración válida")
+		return nil
+	default:
+		return fmt.Errorf("subcomando desconocido: config %s", args[0])
+	}
+}
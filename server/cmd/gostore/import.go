@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	clientRepository "github.com/benitez96/gostore/internal/repositories/client"
+	"github.com/benitez96/gostore/internal/repositories/db"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	importerRepository "github.com/benitez96/gostore/internal/repositories/importer"
+	noteRepository "github.com/benitez96/gostore/internal/repositories/note"
+	paymentRepository "github.com/benitez96/gostore/internal/repositories/payment"
+	quotaRepository "github.com/benitez96/gostore/internal/repositories/quota"
+	saleRepository "github.com/benitez96/gostore/internal/repositories/sale"
+	importerSvc "github.com/benitez96/gostore/internal/services/importer"
+	stateUpdaterSvc "github.com/benitez96/gostore/internal/services/state-updater"
+)
+
+// runImport: gostore import clients|products|sales [-dry-run] [-format csv|xlsx] [-map campo=Columna,...] <archivo>
+func runImport(configPath string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("falta la entidad: import clients | import products | import sales")
+	}
+	entity := args[0]
+
+	flags := flag.NewFlagSet("import "+entity, flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "Valida y muestra la vista previa sin importar")
+	format := flags.String("format", "", "Formato del archivo: csv o xlsx (por defecto según la extensión)")
+	mapping := flags.String("map", "", "Mapeo de columnas, p. ej. dni=Documento,name=Nombre")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return fmt.Errorf("uso: gostore import %s [-dry-run] [-format csv|xlsx] [-map campo=Columna,...] <archivo>", entity)
+	}
+	path := flags.Arg(0)
+
+	opts := &dto.ImportOptions{
+		Format: *format,
+		DryRun: *dryRun,
+	}
+	if opts.Format == "" {
+		opts.Format = importerSvc.FormatFromFilename(path)
+	}
+	if *mapping != "" {
+		opts.Mapping = make(map[string]string)
+		for _, pair := range strings.Split(*mapping, ",") {
+			field, column, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("mapeo inválido %q, se espera campo=Columna", pair)
+			}
+			opts.Mapping[strings.TrimSpace(field)] = strings.TrimSpace(column)
+		}
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	dbConnection, err := db.Connect(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer dbConnection.Close()
+
+	queries := sqlc.New(dbConnection)
+	noteRepo := &noteRepository.Repository{Queries: queries}
+	service := &importerSvc.Service{
+		Repo: &importerRepository.Repository{
+			Queries: queries,
+			DB:      dbConnection,
+		},
+		StateUpdater: &stateUpdaterSvc.Service{
+			QuotaRepo:   &quotaRepository.Repository{Queries: queries, DB: dbConnection},
+			SaleRepo:    &saleRepository.Repository{Queries: queries, DB: dbConnection, NoteRepo: noteRepo},
+			ClientRepo:  &clientRepository.Repository{Queries: queries},
+			PaymentRepo: &paymentRepository.Repository{Queries: queries, DB: dbConnection},
+		},
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	var result *domain.ImportResult
+	switch entity {
+	case domain.ImportEntityClients:
+		result, err = service.ImportClients(ctx, file, opts)
+	case domain.ImportEntityProducts:
+		result, err = service.ImportProducts(ctx, file, opts)
+	case domain.ImportEntitySales:
+		result, err = service.ImportSales(ctx, file, opts)
+	default:
+		return fmt.Errorf("entidad desconocida: %s (clients | products | sales)", entity)
+	}
+	if err != nil {
+		return err
+	}
+
+	return printImportResult(result)
+}
+
+func printImportResult(result *domain.ImportResult) error {
+	fmt.Printf("Columnas: ")
+	columns, _ := json.Marshal(result.Columns)
+	fmt.Println(string(columns))
+	fmt.Printf("Filas: %d, válidas: %d, con errores: %d\n", result.TotalRows, result.ValidRows, result.TotalRows-result.ValidRows)
+
+	for _, e := range result.Errors {
+		if e.Field != "" {
+			fmt.Printf("  fila %d [%s]: %s\n", e.Row, e.Field, e.Message)
+		} else {
+			fmt.Printf("  fila %d: %s\n", e.Row, e.Message)
+		}
+	}
+
+	switch {
+	case len(result.Errors) > 0:
+		return fmt.Errorf("no se importó nada: corregí los errores y volvé a intentar")
+	case result.DryRun:
+		fmt.Println("✅ Dry-run: el archivo es válido, no se importó nada")
+	default:
+		fmt.Printf("✅ Importados: %d\n", result.Imported)
+	}
+	return nil
+}
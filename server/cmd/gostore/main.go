@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/benitez96/gostore/internal/config"
+)
+
+// gostore es la herramienta de línea de comandos para administrar una instalación.
+// Uso: gostore [-config archivo.json] <comando> [argumentos]
+func main() {
+	configPath := flag.String("config", "", "Ruta al archivo de configuración JSON (por defecto $"+config.ConfigPathEnv+")")
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch args[0] {
+	case "config":
+		err = runConfig(*configPath, args[1:])
+	case "backup":
+		err = runBackup(*configPath, args[1:])
+	case "import":
+		err = runImport(*configPath, args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "comando desconocido: %s\n\n", args[0])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Uso: gostore [-config archivo.json] <comando> [argumentos]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Comandos:")
+	fmt.Fprintln(os.Stderr, "  config print                         Muestra la configuración efectiva (sin secretos)")
+	fmt.Fprintln(os.Stderr, "  config validate                      Valida la configuración y termina")
+	fmt.Fprintln(os.Stderr, "  backup list                          Lista los backups disponibles")
+	fmt.Fprintln(os.Stderr, "  backup create                        Crea un backup verificado")
+	fmt.Fprintln(os.Stderr, "  backup download <nombre> [destino]   Copia un backup a otra ubicación")
+	fmt.Fprintln(os.Stderr, "  backup restore <nombre>              Restaura la base desde un backup")
+	fmt.Fprintln(os.Stderr, "  import clients|products|sales <arch> Importa desde CSV/XLSX (-dry-run, -format, -map)")
+	fmt.Fprintln(os.Stderr, "")
+	flag.PrintDefaults()
+}
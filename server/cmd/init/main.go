@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 
 	_ "github.com/mattn/go-sqlite3"
 
+	"github.com/benitez96/gostore/internal/config"
 	"github.com/benitez96/gostore/internal/dto"
 	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
 	userRepository "github.com/benitez96/gostore/internal/repositories/user"
@@ -24,8 +26,6 @@ import (
 )
 
 const (
-	databasePath      = "data/database.db"
-	migrationsPath    = "internal/repositories/db/migrations"
 	minPasswordLength = 6
 )
 
@@ -33,14 +33,23 @@ func main() {
 	fmt.Println("🚀 Inicializando GoStore...")
 	fmt.Println("===============================")
 
+	configPath := flag.String("config", "", "Ruta al archivo de configuración JSON (por defecto $"+config.ConfigPathEnv+")")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Printf("❌ Error cargando configuración: %v\n", err)
+		os.Exit(1)
+	}
+
 	// 1. Crear directorio de datos si no existe
-	if err := createDataDirectory(); err != nil {
+	if err := createDataDirectory(cfg.Database.Path); err != nil {
 		fmt.Printf("❌ Error creando directorio de datos: %v\n", err)
 		os.Exit(1)
 	}
 
 	// 2. Conectar a la base de datos
-	db, err := connectDatabase()
+	db, err := connectDatabase(cfg.Database.Path)
 	if err != nil {
 		fmt.Printf("❌ Error conectando a la base de datos: %v\n", err)
 		os.Exit(1)
@@ -48,7 +57,7 @@ func main() {
 	defer db.Close()
 
 	// 3. Ejecutar migraciones
-	if err := runMigrations(db); err != nil {
+	if err := runMigrations(db, cfg.Database.MigrationsDir); err != nil {
 		fmt.Printf("❌ Error ejecutando migraciones: %v\n", err)
 		os.Exit(1)
 	}
@@ -64,7 +73,7 @@ func main() {
 }
 
 // createDataDirectory crea el directorio 'data' si no existe
-func createDataDirectory() error {
+func createDataDirectory(databasePath string) error {
 	dataDir := filepath.Dir(databasePath)
 	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
 		fmt.Printf("📁 Creando directorio de datos: %s\n", dataDir)
@@ -75,7 +84,7 @@ func createDataDirectory() error {
 }
 
 // connectDatabase conecta a la base de datos SQLite
-func connectDatabase() (*sql.DB, error) {
+func connectDatabase(databasePath string) (*sql.DB, error) {
 	fmt.Printf("🔗 Conectando a la base de datos: %s\n", databasePath)
 	db, err := sql.Open("sqlite3", databasePath+"?_foreign_keys=on")
 	if err != nil {
@@ -92,7 +101,7 @@ func connectDatabase() (*sql.DB, error) {
 }
 
 // runMigrations ejecuta todas las migraciones usando goose
-func runMigrations(db *sql.DB) error {
+func runMigrations(db *sql.DB, migrationsPath string) error {
 	fmt.Println("\n🔄 Ejecutando migraciones...")
 
 	// Configurar goose
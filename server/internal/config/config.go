@@ -0,0 +1,333 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
+
+	// DefaultJWTSecret es la clave de desarrollo; Validate la rechaza en producción
+	DefaultJWTSecret = "your-secret-key-change-this-in-production"
+
+	// LegacyDatabasePath es la ruta fija que usaba el servidor antes de database.path,
+	// relativa al directorio de trabajo (se corría desde cmd/api)
+	LegacyDatabasePath = "../../data/database.db"
+
+	// Backends para generar PDFs
+	PDFRendererWkhtmltopdf = "wkhtmltopdf"
+	PDFRendererNative      = "native"
+
+	// ConfigPathEnv indica el archivo de configuración cuando no se pasa -config
+	ConfigPathEnv = "GOSTORE_CONFIG"
+)
+
+// Config agrupa toda la configuración de GoStore. Se arma con Default, se
+// sobreescribe con el archivo JSON (si hay) y luego con variables de entorno.
+type Config struct {
+	Environment string            `json:"environment"`
+	Server      ServerConfig      `json:"server"`
+	Database    DatabaseConfig    `json:"database"`
+	Auth        AuthConfig        `json:"auth"`
+	PDF         PDFConfig         `json:"pdf"`
+	Worker      WorkerConfig      `json:"worker"`
+	Metrics     MetricsConfig     `json:"metrics"`
+	Backup      BackupConfig      `json:"backup"`
+	Notify      NotifyConfig      `json:"notify"`
+	Reminders   RemindersConfig   `json:"reminders"`
+	Receipts    ReceiptsConfig    `json:"receipts"`
+	Webhooks    WebhooksConfig    `json:"webhooks"`
+	Inventory   InventoryConfig   `json:"inventory"`
+	Reports     ReportsConfig     `json:"reports"`
+	Attachments AttachmentsConfig `json:"attachments"`
+}
+
+type ServerConfig struct {
+	Port            string   `json:"port"`
+	StaticDir       string   `json:"static_dir"`
+	FrontendURL     string   `json:"frontend_url"`
+	ShutdownTimeout Duration `json:"shutdown_timeout"`
+}
+
+type DatabaseConfig struct {
+	Path          string `json:"path"`
+	MigrationsDir string `json:"migrations_dir"`
+}
+
+type AuthConfig struct {
+	JWTSecret string `json:"jwt_secret"`
+}
+
+type PDFConfig struct {
+	Renderer     string `json:"renderer"` // "wkhtmltopdf" (docker) o "native" (Go puro)
+	TempDir      string `json:"temp_dir"`
+	DockerImage  string `json:"docker_image"`
+	TemplatesDir string `json:"templates_dir"` // Templates HTML/CSS que reemplazan a los embebidos (mismos nombres de archivo)
+}
+
+type WorkerConfig struct {
+	StateUpdateInterval Duration `json:"state_update_interval"`
+}
+
+// MetricsConfig controla /metrics, que se sirve en el mismo puerto que la API.
+// Viene desactivado: al activarlo conviene definir un token.
+type MetricsConfig struct {
+	Enabled bool   `json:"enabled"`
+	Token   string `json:"token"` // Si se define, /metrics exige "Authorization: Bearer <token>"
+}
+
+type BackupConfig struct {
+	Enabled    bool     `json:"enabled"`
+	Dir        string   `json:"dir"` // Por defecto <directorio de la base>/backups
+	Interval   Duration `json:"interval"`
+	KeepDaily  int      `json:"keep_daily"`
+	KeepWeekly int      `json:"keep_weekly"`
+}
+
+// NotifyConfig configura los canales de envío de mensajes a clientes
+type NotifyConfig struct {
+	SMTP    SMTPConfig    `json:"smtp"`
+	Gateway GatewayConfig `json:"gateway"`
+	// LogFile reemplaza los envíos reales: los mensajes se agregan a este archivo (pruebas locales)
+	LogFile string `json:"log_file"`
+}
+
+// SMTPConfig habilita el canal "email" cuando Host está definido
+type SMTPConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"` // 465 usa TLS directo; el resto STARTTLS si el servidor lo ofrece
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+// GatewayConfig habilita un canal de WhatsApp/SMS a través de un gateway HTTP genérico
+type GatewayConfig struct {
+	URL     string `json:"url"`
+	Token   string `json:"token"`   // Se envía como "Authorization: Bearer <token>"
+	Channel string `json:"channel"` // "whatsapp" o "sms"
+}
+
+// RemindersConfig configura los recordatorios de vencimiento de cuotas
+type RemindersConfig struct {
+	Enabled          bool              `json:"enabled"`
+	ScanInterval     Duration          `json:"scan_interval"`     // Cada cuánto se buscan cuotas a recordar
+	DispatchInterval Duration          `json:"dispatch_interval"` // Cada cuánto se envían los pendientes
+	DaysBefore       []int             `json:"days_before"`       // Días antes del vencimiento
+	DaysAfter        []int             `json:"days_after"`        // Días de atraso
+	MaxAttempts      int               `json:"max_attempts"`
+	Templates        ReminderTemplates `json:"templates"`
+}
+
+// ReminderTemplates son plantillas text/template; vacías usan las de fábrica
+type ReminderTemplates struct {
+	UpcomingSubject string `json:"upcoming_subject"`
+	UpcomingBody    string `json:"upcoming_body"`
+	OverdueSubject  string `json:"overdue_subject"`
+	OverdueBody     string `json:"overdue_body"`
+}
+
+// ReceiptsConfig configura el envío por email de los comprobantes de pago
+type ReceiptsConfig struct {
+	AutoSend         bool     `json:"auto_send"`         // Enviar el comprobante al registrar cada pago (se puede pisar por pedido)
+	DispatchInterval Duration `json:"dispatch_interval"` // Cada cuánto se envían los pendientes y reintentos
+	MaxAttempts      int      `json:"max_attempts"`
+}
+
+// WebhooksConfig configura el envío de eventos a las suscripciones de webhooks
+type WebhooksConfig struct {
+	DispatchInterval Duration `json:"dispatch_interval"` // Cada cuánto se envían los pendientes y reintentos
+	Timeout          Duration `json:"timeout"`           // Tiempo máximo de respuesta del receptor
+	MaxAttempts      int      `json:"max_attempts"`
+}
+
+// ReportsConfig configura la generación de reportes PDF en segundo plano
+type ReportsConfig struct {
+	Dir           string   `json:"dir"`            // Por defecto <directorio de la base>/reports
+	MaxConcurrent int      `json:"max_concurrent"` // Reportes generándose a la vez; el resto espera en cola
+	MaxQueued     int      `json:"max_queued"`     // Reportes en espera antes de rechazar nuevos pedidos
+	ResultTTL     Duration `json:"result_ttl"`     // Cuánto tiempo queda disponible el PDF generado
+}
+
+// AttachmentsConfig configura los archivos adjuntos de clientes, ventas y pagos
+type AttachmentsConfig struct {
+	Dir           string `json:"dir"`            // Por defecto <directorio de la base>/attachments
+	MaxSizeMB     int    `json:"max_size_mb"`    // Tamaño máximo de cada archivo
+	ThumbnailSize int    `json:"thumbnail_size"` // Lado mayor de las miniaturas de imágenes, en píxeles
+}
+
+type InventoryConfig struct {
+	LowStockThreshold int `json:"low_stock_threshold"` // product.low_stock se emite al llegar a este stock
+}
+
+// Default devuelve la configuración usada cuando no hay archivo ni variables de entorno
+func Default() *Config {
+	return &Config{
+		Environment: EnvDevelopment,
+		Server: ServerConfig{
+			Port:      "8080",
+			StaticDir: "./static",
+			// Menor que TimeoutStopSec=30 del servicio systemd
+			ShutdownTimeout: Duration(20 * time.Second),
+		},
+		Database: DatabaseConfig{
+			Path:          "data/database.db",
+			MigrationsDir: "internal/repositories/db/migrations",
+		},
+		Auth: AuthConfig{
+			JWTSecret: DefaultJWTSecret,
+		},
+		PDF: PDFConfig{
+			Renderer:    PDFRendererWkhtmltopdf,
+			TempDir:     os.TempDir(),
+			DockerImage: "gostore-wkhtmltopdf",
+		},
+		Worker: WorkerConfig{
+			StateUpdateInterval: Duration(24 * time.Hour),
+		},
+		Metrics: MetricsConfig{
+			Enabled: false,
+		},
+		Backup: BackupConfig{
+			Enabled:    true,
+			Interval:   Duration(24 * time.Hour),
+			KeepDaily:  7,
+			KeepWeekly: 4,
+		},
+		Notify: NotifyConfig{
+			SMTP: SMTPConfig{
+				Port: 587,
+			},
+			Gateway: GatewayConfig{
+				Channel: "whatsapp",
+			},
+		},
+		Reminders: RemindersConfig{
+			ScanInterval:     Duration(time.Hour),
+			DispatchInterval: Duration(time.Minute),
+			DaysBefore:       []int{3},
+			DaysAfter:        []int{1, 7, 15},
+			MaxAttempts:      5,
+		},
+		Receipts: ReceiptsConfig{
+			DispatchInterval: Duration(30 * time.Second),
+			MaxAttempts:      5,
+		},
+		Webhooks: WebhooksConfig{
+			DispatchInterval: Duration(10 * time.Second),
+			Timeout:          Duration(10 * time.Second),
+			MaxAttempts:      8,
+		},
+		Inventory: InventoryConfig{
+			LowStockThreshold: 2,
+		},
+		Reports: ReportsConfig{
+			MaxConcurrent: 2,
+			MaxQueued:     10,
+			ResultTTL:     Duration(time.Hour),
+		},
+		Attachments: AttachmentsConfig{
+			MaxSizeMB:     10,
+			ThumbnailSize: 256,
+		},
+	}
+}
+
+// Load arma la configuración efectiva: defaults, archivo (opcional) y entorno.
+// Si path está vacío se usa GOSTORE_CONFIG; si tampoco está, solo defaults y entorno.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv(ConfigPathEnv)
+	}
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Backup.Dir == "" {
+		cfg.Backup.Dir = filepath.Join(filepath.Dir(cfg.Database.Path), "backups")
+	}
+	if cfg.Reports.Dir == "" {
+		cfg.Reports.Dir = filepath.Join(filepath.Dir(cfg.Database.Path), "reports")
+	}
+	if cfg.Attachments.Dir == "" {
+		cfg.Attachments.Dir = filepath.Join(filepath.Dir(cfg.Database.Path), "attachments")
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(content, c); err != nil {
+		return fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// IsProduction indica si se está corriendo en producción
+func (c *Config) IsProduction() bool {
+	return c.Environment == EnvProduction
+}
+
+// AllowedOrigin devuelve el origen para CORS
+func (c *Config) AllowedOrigin() string {
+	if c.Server.FrontendURL != "" {
+		return c.Server.FrontendURL
+	}
+	// En producción el frontend se sirve desde el mismo servidor
+	if c.IsProduction() {
+		return "*"
+	}
+	return "http://localhost:5173"
+}
+
+// Redacted devuelve una copia sin secretos, apta para imprimir o loguear
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	if redacted.Auth.JWTSecret != "" {
+		redacted.Auth.JWTSecret = "********"
+	}
+	if redacted.Metrics.Token != "" {
+		redacted.Metrics.Token = "********"
+	}
+	if redacted.Notify.SMTP.Password != "" {
+		redacted.Notify.SMTP.Password = "********"
+	}
+	if redacted.Notify.Gateway.Token != "" {
+		redacted.Notify.Gateway.Token = "********"
+	}
+	return &redacted
+}
+
+// HasNotifier indica si hay al menos un canal de envío configurado
+func (c *NotifyConfig) HasNotifier() bool {
+	return c.SMTP.Host != "" || c.Gateway.URL != "" || c.LogFile != ""
+}
+
+// HasEmail indica si el canal de email está configurado (SMTP o archivo)
+func (c *NotifyConfig) HasEmail() bool {
+	return c.SMTP.Host != "" || c.LogFile != ""
+}
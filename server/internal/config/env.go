@@ -0,0 +1,143 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// applyEnv sobreescribe la configuración con las variables de entorno definidas.
+// Se mantienen los nombres que ya usaban gostore.env y los scripts de instalación.
+// Los valores que no se pueden interpretar se devuelven todos juntos como error.
+func (c *Config) applyEnv() error {
+	var e envReader
+	e.setString(&c.Environment, "ENVIRONMENT")
+	e.setString(&c.Server.Port, "PORT")
+	e.setString(&c.Server.StaticDir, "STATIC_DIR")
+	e.setString(&c.Server.FrontendURL, "FRONTEND_URL")
+	e.setDuration(&c.Server.ShutdownTimeout, "SHUTDOWN_TIMEOUT")
+	e.setString(&c.Database.Path, "DB_PATH")
+	e.setString(&c.Database.MigrationsDir, "MIGRATIONS_DIR")
+	e.setString(&c.Auth.JWTSecret, "JWT_SECRET_KEY")
+	e.setString(&c.PDF.Renderer, "PDF_RENDERER")
+	e.setString(&c.PDF.TempDir, "PDF_TEMP_DIR")
+	e.setString(&c.PDF.DockerImage, "PDF_DOCKER_IMAGE")
+	e.setString(&c.PDF.TemplatesDir, "PDF_TEMPLATES_DIR")
+	e.setDuration(&c.Worker.StateUpdateInterval, "STATE_UPDATE_INTERVAL")
+	e.setBool(&c.Metrics.Enabled, "METRICS_ENABLED")
+	e.setString(&c.Metrics.Token, "METRICS_TOKEN")
+	e.setBool(&c.Backup.Enabled, "BACKUP_ENABLED")
+	e.setString(&c.Backup.Dir, "BACKUP_DIR")
+	e.setDuration(&c.Backup.Interval, "BACKUP_INTERVAL")
+	e.setInt(&c.Backup.KeepDaily, "BACKUP_KEEP_DAILY")
+	e.setInt(&c.Backup.KeepWeekly, "BACKUP_KEEP_WEEKLY")
+	e.setString(&c.Notify.SMTP.Host, "SMTP_HOST")
+	e.setInt(&c.Notify.SMTP.Port, "SMTP_PORT")
+	e.setString(&c.Notify.SMTP.Username, "SMTP_USERNAME")
+	e.setString(&c.Notify.SMTP.Password, "SMTP_PASSWORD")
+	e.setString(&c.Notify.SMTP.From, "SMTP_FROM")
+	e.setString(&c.Notify.Gateway.URL, "NOTIFY_GATEWAY_URL")
+	e.setString(&c.Notify.Gateway.Token, "NOTIFY_GATEWAY_TOKEN")
+	e.setString(&c.Notify.Gateway.Channel, "NOTIFY_GATEWAY_CHANNEL")
+	e.setString(&c.Notify.LogFile, "NOTIFY_LOG_FILE")
+	e.setBool(&c.Reminders.Enabled, "REMINDERS_ENABLED")
+	e.setDuration(&c.Reminders.ScanInterval, "REMINDERS_SCAN_INTERVAL")
+	e.setDuration(&c.Reminders.DispatchInterval, "REMINDERS_DISPATCH_INTERVAL")
+	e.setInts(&c.Reminders.DaysBefore, "REMINDERS_DAYS_BEFORE")
+	e.setInts(&c.Reminders.DaysAfter, "REMINDERS_DAYS_AFTER")
+	e.setInt(&c.Reminders.MaxAttempts, "REMINDERS_MAX_ATTEMPTS")
+	e.setBool(&c.Receipts.AutoSend, "RECEIPTS_AUTO_SEND")
+	e.setDuration(&c.Receipts.DispatchInterval, "RECEIPTS_DISPATCH_INTERVAL")
+	e.setInt(&c.Receipts.MaxAttempts, "RECEIPTS_MAX_ATTEMPTS")
+	e.setDuration(&c.Webhooks.DispatchInterval, "WEBHOOKS_DISPATCH_INTERVAL")
+	e.setDuration(&c.Webhooks.Timeout, "WEBHOOKS_TIMEOUT")
+	e.setInt(&c.Webhooks.MaxAttempts, "WEBHOOKS_MAX_ATTEMPTS")
+	e.setInt(&c.Inventory.LowStockThreshold, "INVENTORY_LOW_STOCK_THRESHOLD")
+	e.setString(&c.Reports.Dir, "REPORTS_DIR")
+	e.setInt(&c.Reports.MaxConcurrent, "REPORTS_MAX_CONCURRENT")
+	e.setInt(&c.Reports.MaxQueued, "REPORTS_MAX_QUEUED")
+	e.setDuration(&c.Reports.ResultTTL, "REPORTS_RESULT_TTL")
+	e.setString(&c.Attachments.Dir, "ATTACHMENTS_DIR")
+	e.setInt(&c.Attachments.MaxSizeMB, "ATTACHMENTS_MAX_SIZE_MB")
+	e.setInt(&c.Attachments.ThumbnailSize, "ATTACHMENTS_THUMBNAIL_SIZE")
+
+	if len(e.problems) > 0 {
+		return errors.New("invalid environment: " + strings.Join(e.problems, "; "))
+	}
+	return nil
+}
+
+// envReader acumula los valores inválidos en lugar de cortar en el primero
+type envReader struct {
+	problems []string
+}
+
+func (e *envReader) invalid(key, value string, err error) {
+	e.problems = append(e.problems, fmt.Sprintf("%s=%q: %v", key, value, err))
+}
+
+func (e *envReader) setString(dst *string, key string) {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		*dst = value
+	}
+}
+
+func (e *envReader) setDuration(dst *Duration, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		e.invalid(key, value, err)
+		return
+	}
+	*dst = Duration(d)
+}
+
+func (e *envReader) setBool(dst *bool, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		e.invalid(key, value, err)
+		return
+	}
+	*dst = b
+}
+
+func (e *envReader) setInt(dst *int, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		e.invalid(key, value, err)
+		return
+	}
+	*dst = n
+}
+
+// setInts lee una lista separada por comas ("1,7,15")
+func (e *envReader) setInts(dst *[]int, key string) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return
+	}
+	var values []int
+	for _, part := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			e.invalid(key, value, err)
+			return
+		}
+		values = append(values, n)
+	}
+	*dst = values
+}
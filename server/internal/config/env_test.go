@@ -0,0 +1,68 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyEnv(t *testing.T) {
+	t.Setenv("PORT", "9090")
+	t.Setenv("STATIC_DIR", "") // Vacía: se mantiene el valor por defecto
+	t.Setenv("SHUTDOWN_TIMEOUT", "45s")
+	t.Setenv("METRICS_ENABLED", "true")
+	t.Setenv("BACKUP_KEEP_DAILY", "10")
+	t.Setenv("REMINDERS_DAYS_AFTER", "1, 5,30")
+
+	c := Default()
+	if err := c.applyEnv(); err != nil {
+		t.Fatalf("applyEnv: %v", err)
+	}
+
+	if c.Server.Port != "9090" {
+		t.Errorf("port = %q, want 9090", c.Server.Port)
+	}
+	if c.Server.StaticDir != Default().Server.StaticDir {
+		t.Errorf("empty STATIC_DIR replaced the default with %q", c.Server.StaticDir)
+	}
+	if time.Duration(c.Server.ShutdownTimeout) != 45*time.Second {
+		t.Errorf("shutdown timeout = %v, want 45s", time.Duration(c.Server.ShutdownTimeout))
+	}
+	if !c.Metrics.Enabled {
+		t.Error("metrics were not enabled")
+	}
+	if c.Backup.KeepDaily != 10 {
+		t.Errorf("keep daily = %d, want 10", c.Backup.KeepDaily)
+	}
+	if want := []int{1, 5, 30}; !slices.Equal(c.Reminders.DaysAfter, want) {
+		t.Errorf("days after = %v, want %v", c.Reminders.DaysAfter, want)
+	}
+}
+
+func TestApplyEnvReportsEveryInvalidValue(t *testing.T) {
+	t.Setenv("SHUTDOWN_TIMEOUT", "20")
+	t.Setenv("METRICS_ENABLED", "si")
+	t.Setenv("BACKUP_KEEP_DAILY", "siete")
+	t.Setenv("REMINDERS_DAYS_BEFORE", "3,x")
+	t.Setenv("PORT", "9090")
+
+	c := Default()
+	err := c.applyEnv()
+	if err == nil {
+		t.Fatal("invalid values were accepted")
+	}
+	for _, key := range []string{"SHUTDOWN_TIMEOUT", "METRICS_ENABLED", "BACKUP_KEEP_DAILY", "REMINDERS_DAYS_BEFORE"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("error %q does not mention %s", err, key)
+		}
+	}
+	if strings.Contains(err.Error(), "PORT") {
+		t.Errorf("error %q mentions a valid variable", err)
+	}
+
+	// Un valor inválido no pisa el anterior
+	if c.Backup.KeepDaily != Default().Backup.KeepDaily {
+		t.Errorf("keep daily = %d, want the default", c.Backup.KeepDaily)
+	}
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Validate verifica la configuración al arrancar. Devuelve todos los problemas juntos.
+func (c *Config) Validate() error {
+	var problems []string
+
+	switch c.Environment {
+	case EnvDevelopment, EnvProduction:
+	default:
+		problems = append(problems, fmt.Sprintf("environment must be %q or %q, got %q", EnvDevelopment, EnvProduction, c.Environment))
+	}
+
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		problems = append(problems, fmt.Sprintf("server.port must be a number between 1 and 65535, got %q", c.Server.Port))
+	}
+
+	if c.Server.ShutdownTimeout <= 0 {
+		problems = append(problems, "server.shutdown_timeout must be positive")
+	}
+
+	if c.Database.Path == "" {
+		problems = append(problems, "database.path cannot be empty")
+	} else if legacy := legacyDatabase(c.Database.Path); legacy != "" {
+		// Sin esto se abriría una base nueva y vacía en lugar de la existente
+		problems = append(problems, fmt.Sprintf(
+			"database.path %q does not exist but the database at the previous default location %q does: set DB_PATH to it or move the file",
+			c.Database.Path, legacy))
+	}
+
+	if c.Auth.JWTSecret == "" {
+		problems = append(problems, "auth.jwt_secret cannot be empty")
+	} else if c.Auth.JWTSecret == DefaultJWTSecret && c.IsProduction() {
+		problems = append(problems, "auth.jwt_secret must be changed in production (set JWT_SECRET_KEY)")
+	}
+
+	switch c.PDF.Renderer {
+	case PDFRendererWkhtmltopdf:
+		if c.PDF.TempDir == "" {
+			problems = append(problems, "pdf.temp_dir cannot be empty")
+		}
+		if c.PDF.TemplatesDir != "" {
+			if info, err := os.Stat(c.PDF.TemplatesDir); err != nil || !info.IsDir() {
+				problems = append(problems, fmt.Sprintf("pdf.templates_dir %q is not a readable directory", c.PDF.TemplatesDir))
+			}
+		}
+	case PDFRendererNative:
+	default:
+		problems = append(problems, fmt.Sprintf("pdf.renderer must be %q or %q, got %q", PDFRendererWkhtmltopdf, PDFRendererNative, c.PDF.Renderer))
+	}
+
+	if time.Duration(c.Worker.StateUpdateInterval) < time.Minute {
+		problems = append(problems, "worker.state_update_interval must be at least 1m")
+	}
+
+	if c.Backup.Enabled {
+		if time.Duration(c.Backup.Interval) < time.Minute {
+			problems = append(problems, "backup.interval must be at least 1m")
+		}
+		if c.Backup.KeepDaily < 1 {
+			problems = append(problems, "backup.keep_daily must be at least 1")
+		}
+		if c.Backup.KeepWeekly < 0 {
+			problems = append(problems, "backup.keep_weekly cannot be negative")
+		}
+	}
+
+	if c.Notify.SMTP.Host != "" {
+		if c.Notify.SMTP.Port < 1 || c.Notify.SMTP.Port > 65535 {
+			problems = append(problems, "notify.smtp.port must be between 1 and 65535")
+		}
+		if c.Notify.SMTP.From == "" {
+			problems = append(problems, "notify.smtp.from is required when notify.smtp.host is set")
+		}
+	}
+
+	if c.Notify.Gateway.URL != "" {
+		if _, err := url.ParseRequestURI(c.Notify.Gateway.URL); err != nil {
+			problems = append(problems, fmt.Sprintf("notify.gateway.url is invalid: %v", err))
+		}
+		switch c.Notify.Gateway.Channel {
+		case "whatsapp", "sms":
+		default:
+			problems = append(problems, fmt.Sprintf("notify.gateway.channel must be \"whatsapp\" or \"sms\", got %q", c.Notify.Gateway.Channel))
+		}
+	}
+
+	if c.Reminders.Enabled {
+		if !c.Notify.HasNotifier() {
+			problems = append(problems, "reminders need a notifier: set notify.smtp.host, notify.gateway.url or notify.log_file")
+		}
+		if time.Duration(c.Reminders.ScanInterval) < time.Minute {
+			problems = append(problems, "reminders.scan_interval must be at least 1m")
+		}
+		if time.Duration(c.Reminders.DispatchInterval) < 10*time.Second {
+			problems = append(problems, "reminders.dispatch_interval must be at least 10s")
+		}
+		for _, days := range append(append([]int{}, c.Reminders.DaysBefore...), c.Reminders.DaysAfter...) {
+			if days < 1 {
+				problems = append(problems, "reminders.days_before and reminders.days_after must be positive")
+				break
+			}
+		}
+		if c.Reminders.MaxAttempts < 1 {
+			problems = append(problems, "reminders.max_attempts must be at least 1")
+		}
+	}
+
+	if c.Receipts.AutoSend && !c.Notify.HasEmail() {
+		problems = append(problems, "receipts.auto_send needs email: set notify.smtp.host or notify.log_file")
+	}
+	if time.Duration(c.Receipts.DispatchInterval) < 10*time.Second {
+		problems = append(problems, "receipts.dispatch_interval must be at least 10s")
+	}
+	if c.Receipts.MaxAttempts < 1 {
+		problems = append(problems, "receipts.max_attempts must be at least 1")
+	}
+
+	if time.Duration(c.Webhooks.DispatchInterval) < time.Second {
+		problems = append(problems, "webhooks.dispatch_interval must be at least 1s")
+	}
+	if time.Duration(c.Webhooks.Timeout) < time.Second {
+		problems = append(problems, "webhooks.timeout must be at least 1s")
+	}
+	if c.Webhooks.MaxAttempts < 1 {
+		problems = append(problems, "webhooks.max_attempts must be at least 1")
+	}
+	if c.Inventory.LowStockThreshold < 0 {
+		problems = append(problems, "inventory.low_stock_threshold cannot be negative")
+	}
+
+	if c.Reports.MaxConcurrent < 1 {
+		problems = append(problems, "reports.max_concurrent must be at least 1")
+	}
+	if c.Reports.MaxQueued < 0 {
+		problems = append(problems, "reports.max_queued cannot be negative")
+	}
+	if time.Duration(c.Reports.ResultTTL) < time.Minute {
+		problems = append(problems, "reports.result_ttl must be at least 1m")
+	}
+
+	if c.Attachments.MaxSizeMB < 1 || c.Attachments.MaxSizeMB > 100 {
+		problems = append(problems, "attachments.max_size_mb must be between 1 and 100")
+	}
+	if c.Attachments.ThumbnailSize < 32 || c.Attachments.ThumbnailSize > 1024 {
+		problems = append(problems, "attachments.thumbnail_size must be between 32 and 1024")
+	}
+
+	templates := []struct{ name, text string }{
+		{"upcoming_subject", c.Reminders.Templates.UpcomingSubject},
+		{"upcoming_body", c.Reminders.Templates.UpcomingBody},
+		{"overdue_subject", c.Reminders.Templates.OverdueSubject},
+		{"overdue_body", c.Reminders.Templates.OverdueBody},
+	}
+	for _, t := range templates {
+		if _, err := template.New(t.name).Parse(t.text); err != nil {
+			problems = append(problems, fmt.Sprintf("reminders.templates.%s is invalid: %v", t.name, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid configuration: " + strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// Warnings devuelve lo que es válido pero conviene revisar; se muestra al arrancar y en config validate
+func (c *Config) Warnings() []string {
+	var warnings []string
+
+	if c.Auth.JWTSecret == DefaultJWTSecret {
+		warnings = append(warnings, "using the default JWT secret key: set JWT_SECRET_KEY for production")
+	}
+	if c.Metrics.Enabled && c.Metrics.Token == "" {
+		warnings = append(warnings, "metrics are enabled without metrics.token: /metrics is public on the API port (set METRICS_TOKEN)")
+	}
+
+	return warnings
+}
+
+// legacyDatabase devuelve la ruta absoluta de la base en LegacyDatabasePath si path no existe
+// pero esa sí: es una instalación que todavía no configuró database.path
+func legacyDatabase(path string) string {
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return ""
+	}
+	if _, err := os.Stat(LegacyDatabasePath); err != nil {
+		return ""
+	}
+	legacy, err := filepath.Abs(LegacyDatabasePath)
+	if err != nil {
+		return LegacyDatabasePath
+	}
+	return legacy
+}
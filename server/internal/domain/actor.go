@@ -0,0 +1,22 @@
+package domain
+
+import "fmt"
+
+const (
+	ActorTypeUser   = "user"
+	ActorTypeAPIKey = "api_key"
+)
+
+// Actor identifica quién realiza una operación autenticada: un usuario
+// logueado con JWT o una integración que usa una API key.
+type Actor struct {
+	Type        string `json:"type"`
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Permissions int64  `json:"permissions"`
+}
+
+// String devuelve una representación estable del actor para logs, p. ej. "api_key:3(kiosco)"
+func (a Actor) String() string {
+	return fmt.Sprintf("%s:%d(%s)", a.Type, a.ID, a.Name)
+}
@@ -7,11 +7,16 @@ import (
 )
 
 const (
-	ErrCodeDuplicateKey        = "duplicate_key"
-	ErrCodeInternalServerError = "internal_server_error"
-	ErrCodeInvalidParams       = "invalid_params"
-	ErrCodeNotFound            = "not_found"
-	ErrCodeTimeout             = "timeout"
+	ErrCodeCreditLimitExceeded  = "credit_limit_exceeded"
+	ErrCodeDuplicateKey         = "duplicate_key"
+	ErrCodeFileTooLarge         = "file_too_large"
+	ErrCodeForbidden            = "forbidden"
+	ErrCodeInternalServerError  = "internal_server_error"
+	ErrCodeInvalidParams        = "invalid_params"
+	ErrCodeNotFound             = "not_found"
+	ErrCodeTimeout              = "timeout"
+	ErrCodeTooManyRequests      = "too_many_requests"
+	ErrCodeUnsupportedMediaType = "unsupported_media_type"
 )
 
 var (
@@ -23,8 +28,9 @@ var (
 
 // AppError is a custom error type that implements the error interface
 type AppError struct {
-	Code string `json:"code"`
-	Msg  string `json:"msg"`
+	Code      string `json:"code"`
+	Msg       string `json:"msg"`
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // NewAppError creates a new AppError with the given code and message.
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Dueños posibles de un adjunto
+const (
+	AttachmentOwnerClient  = "client"
+	AttachmentOwnerSale    = "sale"
+	AttachmentOwnerPayment = "payment"
+)
+
+// FileAttachment es un archivo adjunto a un cliente, una venta o un pago: una foto del DNI,
+// un contrato firmado o el comprobante de una transferencia
+type FileAttachment struct {
+	ID           int64     `json:"id"`
+	OwnerType    string    `json:"owner_type"`
+	OwnerID      int64     `json:"owner_id"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	HasThumbnail bool      `json:"has_thumbnail"`
+	UserID       *int64    `json:"user_id"`
+	Author       string    `json:"author"`
+	CreatedAt    time.Time `json:"created_at"`
+	// Claves del contenido y la miniatura en el almacenamiento de archivos
+	StorageKey   string `json:"-"`
+	ThumbnailKey string `json:"-"`
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// AuditEntry es un request autenticado que modificó (o intentó modificar) datos
+type AuditEntry struct {
+	ID        int64     `json:"id"`
+	ActorType string    `json:"actor_type"`
+	ActorID   int64     `json:"actor_id"`
+	ActorName string    `json:"actor_name"`
+	Method    string    `json:"method"`
+	Route     string    `json:"route"` // Patrón de la ruta, p. ej. /api/clients/:id
+	Path      string    `json:"path"`
+	Status    int       `json:"status"`
+	RequestID string    `json:"request_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "time"
+
+type Backup struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
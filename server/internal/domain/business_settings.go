@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// BusinessSettings son los datos del comercio que se imprimen en los PDFs
+type BusinessSettings struct {
+	Name      string    `json:"name"`
+	LegalName string    `json:"legal_name"` // Razón social
+	CUIT      string    `json:"cuit"`       // Formato XX-XXXXXXXX-X
+	Address   string    `json:"address"`
+	Phone     string    `json:"phone"`
+	Footer    string    `json:"footer"` // Texto al pie de comprobantes y fichas
+	HasLogo   bool      `json:"has_logo"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	TicketWidth   int    `json:"ticket_width"`   // Ancho del papel de la ticketera en mm (58 u 80)
+	TicketPrinter string `json:"ticket_printer"` // host:puerto de la ticketera de red; vacío si no hay
+
+	ContractTerms string `json:"contract_terms"` // Condiciones de los contratos de venta; vacío usa las de por defecto
+
+	Logo     []byte `json:"-"`
+	LogoMime string `json:"-"`
+}
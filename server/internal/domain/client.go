@@ -6,8 +6,17 @@ type ClientSummary struct {
 	Lastname	  string 	`json:"lastname"`
 	Dni					string	`json:"dni"`
 	State			  *State 	`json:"state"`
+	RiskScore   int 		`json:"risk_score"`
+	RiskLevel   string 	`json:"risk_level"`
 }
 
+// Orden del listado de clientes
+const (
+	ClientSortName     = "name"        // Apellido y nombre (por defecto)
+	ClientSortRisk     = "risk_score"  // Puntaje de riesgo de menor a mayor
+	ClientSortRiskDesc = "-risk_score" // Puntaje de riesgo de mayor a menor
+)
+
 type Client struct {
 	ID          any 						`json:"id"`
 	Name        string 					`json:"name"`
@@ -17,5 +26,10 @@ type Client struct {
 	Email       string 					`json:"email"`
 	Phone       string 					`json:"phone"`
 	Address     string 					`json:"address"`
+	RemindersOptOut bool 				`json:"reminders_opt_out"`
+	CollectorID *int64 					`json:"collector_id"`
+	Zone        string 					`json:"zone"`
+	RiskScore   int 						`json:"risk_score"`
+	RiskLevel   string 					`json:"risk_level"`
 	Sales				[]*SaleSummary 	`json:"sales"`
 }
@@ -0,0 +1,92 @@
+package domain
+
+import "time"
+
+// Collector es un usuario con el permiso de cobrador
+type Collector struct {
+	ID             int64   `json:"id"`
+	Username       string  `json:"username"`
+	FirstName      string  `json:"firstName"`
+	LastName       string  `json:"lastName"`
+	IsActive       bool    `json:"is_active"`
+	CommissionRate float64 `json:"commission_rate"` // Porcentaje sobre lo cobrado, p. ej. 5 = 5%
+	ClientsCount   int64   `json:"clients_count"`
+}
+
+// FullName devuelve nombre y apellido, o el usuario si no los cargó
+func (c *Collector) FullName() string {
+	name := c.FirstName
+	if c.LastName != "" {
+		if name != "" {
+			name += " "
+		}
+		name += c.LastName
+	}
+	if name == "" {
+		return c.Username
+	}
+	return name
+}
+
+// CollectorClient es un cliente asignado a un cobrador
+type CollectorClient struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Lastname string `json:"lastname"`
+	Dni      string `json:"dni"`
+	Phone    string `json:"phone"`
+	Address  string `json:"address"`
+	Zone     string `json:"zone"`
+	State    *State `json:"state"`
+}
+
+// RouteQuota es una cuota vencida o que vence en el día de la hoja de ruta
+type RouteQuota struct {
+	QuotaID         int64     `json:"quota_id"`
+	Number          int64     `json:"number"`
+	SaleID          int64     `json:"sale_id"`
+	SaleDescription string    `json:"sale_description"`
+	DueDate         time.Time `json:"due_date"`
+	Amount          float64   `json:"amount"`
+	PaidAmount      float64   `json:"paid_amount"`
+	Balance         float64   `json:"balance"`      // Lo que falta pagar de la cuota
+	DaysOverdue     int       `json:"days_overdue"` // 0 si vence en el día
+}
+
+// RouteStop es un cliente a visitar con sus cuotas a cobrar
+type RouteStop struct {
+	ClientID int64         `json:"client_id"`
+	Name     string        `json:"name"`
+	Lastname string        `json:"lastname"`
+	Dni      string        `json:"dni"`
+	Phone    string        `json:"phone"`
+	Address  string        `json:"address"`
+	Zone     string        `json:"zone"`
+	StateID  int           `json:"state"`
+	Quotas   []*RouteQuota `json:"quotas"`
+	Total    float64       `json:"total"`
+	// Guarantors son los garantes de sus ventas, solo si el cliente está suspendido
+	Guarantors []*Guarantor `json:"guarantors,omitempty"`
+}
+
+// RouteSheet es la hoja de ruta diaria de un cobrador, ordenada por zona y cliente
+type RouteSheet struct {
+	Collector     *Collector   `json:"collector"`
+	Date          time.Time    `json:"date"`
+	Stops         []*RouteStop `json:"stops"`
+	OverdueCount  int          `json:"overdue_count"`
+	DueTodayCount int          `json:"due_today_count"`
+	Total         float64      `json:"total"`
+}
+
+// CollectorSummary es lo cobrado por un cobrador en un período [From, To) y su comisión
+type CollectorSummary struct {
+	Collector      *Collector `json:"collector"`
+	From           time.Time  `json:"from"`
+	To             time.Time  `json:"to"`
+	PaymentsCount  int64      `json:"payments_count"`
+	ClientsCount   int64      `json:"clients_count"`
+	Total          float64    `json:"total"`
+	CommissionRate float64    `json:"commission_rate"`
+	Commission     float64    `json:"commission"`
+}
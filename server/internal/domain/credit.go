@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+// CreditPolicy son las reglas de crédito de los clientes en un estado
+type CreditPolicy struct {
+	StateID          int       `json:"state_id"`
+	StateDescription string    `json:"state_description"`
+	AllowSales       bool      `json:"allow_sales"`      // false bloquea las ventas nuevas
+	MaxBalance       *float64  `json:"max_balance"`      // nil: sin límite
+	MaxActiveSales   *int64    `json:"max_active_sales"` // nil: sin límite
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// CreditLimits son los límites propios de un cliente; nil usa el de la política de su estado
+type CreditLimits struct {
+	MaxBalance     *float64 `json:"max_balance"`
+	MaxActiveSales *int64   `json:"max_active_sales"`
+}
+
+// CreditStatus es la deuda actual de un cliente y sus límites propios
+type CreditStatus struct {
+	ClientID    int64
+	StateID     int
+	Balance     float64 // Saldo impago de sus cuotas
+	ActiveSales int64   // Ventas sin terminar de pagar
+	Limits      CreditLimits
+}
+
+// CreditCheck es el resultado de evaluar una venta nueva contra las reglas de crédito
+type CreditCheck struct {
+	ClientID       int64    `json:"client_id"`
+	StateID        int      `json:"state"`
+	Balance        float64  `json:"balance"`
+	ActiveSales    int64    `json:"active_sales"`
+	Amount         float64  `json:"amount"`           // Monto financiado de la venta evaluada
+	AllowSales     bool     `json:"allow_sales"`      // Según la política del estado
+	MaxBalance     *float64 `json:"max_balance"`      // Límite efectivo: el del cliente o el de la política
+	MaxActiveSales *int64   `json:"max_active_sales"` // Límite efectivo: el del cliente o el de la política
+	Violations     []string `json:"violations"`
+}
+
+// Allowed indica si la venta cumple todas las reglas
+func (c *CreditCheck) Allowed() bool {
+	return len(c.Violations) == 0
+}
+
+// CreditOverride registra una venta que un encargado autorizó saltando las reglas de crédito
+type CreditOverride struct {
+	ID             int64     `json:"id"`
+	SaleID         *int64    `json:"sale_id"`
+	ClientID       int64     `json:"client_id"`
+	ClientName     string    `json:"client_name,omitempty"`
+	ClientLastname string    `json:"client_lastname,omitempty"`
+	UserID         *int64    `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	Reason         string    `json:"reason"`
+	Violations     []string  `json:"violations"`
+	Amount         float64   `json:"amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	EventPaymentCreated     = "payment.created"
+	EventPaymentDeleted     = "payment.deleted"
+	EventSaleCreated        = "sale.created"
+	EventSaleDeleted        = "sale.deleted"
+	EventClientDeleted      = "client.deleted"
+	EventClientStateChanged = "client.state_changed"
+	EventProductLowStock    = "product.low_stock"
+)
+
+// EventTypes son los eventos que se publican en el bus (y a los que se puede suscribir un webhook)
+var EventTypes = []string{
+	EventPaymentCreated,
+	EventPaymentDeleted,
+	EventSaleCreated,
+	EventSaleDeleted,
+	EventClientDeleted,
+	EventClientStateChanged,
+	EventProductLowStock,
+}
+
+// Event es un hecho de negocio que ya ocurrió (se publica después de confirmar el cambio)
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// NewEvent arma un evento con ID aleatorio, que los consumidores pueden usar para deduplicar
+func NewEvent(eventType string, data any) Event {
+	id := make([]byte, 16)
+	rand.Read(id)
+
+	return Event{
+		ID:         "evt_" + hex.EncodeToString(id),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+// PaymentEventData es el contenido de payment.created y payment.deleted
+type PaymentEventData struct {
+	ID       int64      `json:"id"`
+	Amount   float64    `json:"amount"`
+	Date     *time.Time `json:"date"`
+	QuotaID  int64      `json:"quota_id"`
+	SaleID   int64      `json:"sale_id"`
+	ClientID int64      `json:"client_id"`
+}
+
+// SaleEventData es el contenido de sale.created
+type SaleEventData struct {
+	ID         int64     `json:"id"`
+	ClientID   int64     `json:"client_id"`
+	Amount     float64   `json:"amount"`
+	Date       time.Time `json:"date"`
+	Quotas     int       `json:"quotas"`
+	QuotaPrice float64   `json:"quota_price"`
+}
+
+// SaleDeletedData es el contenido de sale.deleted; sus cuotas y pagos se borran con ella
+type SaleDeletedData struct {
+	ID       int64 `json:"id"`
+	ClientID int64 `json:"client_id"`
+}
+
+// ClientDeletedData es el contenido de client.deleted; sus ventas y pagos se borran con él
+type ClientDeletedData struct {
+	ClientID int64 `json:"client_id"`
+}
+
+// ClientStateChangedData es el contenido de client.state_changed
+type ClientStateChangedData struct {
+	ClientID        int64 `json:"client_id"`
+	PreviousStateID int   `json:"previous_state_id"`
+	StateID         int   `json:"state_id"`
+}
+
+// ProductLowStockData es el contenido de product.low_stock
+type ProductLowStockData struct {
+	ProductID int64  `json:"product_id"`
+	Name      string `json:"name"`
+	Stock     int    `json:"stock"`
+	Threshold int    `json:"threshold"`
+}
+
+// CrossedLowStock indica si el stock acaba de llegar al umbral (antes estaba por encima)
+func CrossedLowStock(before, after, threshold int) bool {
+	return before > threshold && after <= threshold
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+const (
+	ExportEntityClients  = "clients"
+	ExportEntitySales    = "sales"
+	ExportEntityQuotas   = "quotas"
+	ExportEntityPayments = "payments"
+)
+
+// Filas de exportación: datos planos listos para una planilla, ya con el cliente y el estado resueltos
+
+type ClientExport struct {
+	ID        int64
+	Name      string
+	Lastname  string
+	Dni       string
+	Email     string
+	Phone     string
+	Address   string
+	State     string
+	CreatedAt *time.Time
+}
+
+type SaleExport struct {
+	ID             int64
+	Date           time.Time
+	ClientDni      string
+	ClientName     string
+	ClientLastname string
+	Products       string
+	Amount         float64
+	PaidAmount     float64
+	QuotaCount     int64
+	IsPaid         bool
+	State          string
+}
+
+type QuotaExport struct {
+	ID             int64
+	SaleID         int64
+	Number         int64
+	ClientDni      string
+	ClientName     string
+	ClientLastname string
+	Amount         float64
+	DueDate        time.Time
+	PaidAmount     float64
+	IsPaid         bool
+	State          string
+}
+
+type PaymentExport struct {
+	ID             int64
+	Date           time.Time
+	Amount         float64
+	SaleID         int64
+	QuotaNumber    int64
+	ClientDni      string
+	ClientName     string
+	ClientLastname string
+}
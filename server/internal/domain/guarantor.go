@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Guarantor es el garante de una o varias ventas
+type Guarantor struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Lastname     string    `json:"lastname"`
+	Dni          string    `json:"dni"`
+	Phone        string    `json:"phone"`
+	Address      string    `json:"address"`
+	Relationship string    `json:"relationship"` // Vínculo con el cliente
+	SaleIDs      []int64   `json:"sale_ids,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// GuarantorFollowUp es una venta con cuotas vencidas de un cliente suspendido,
+// con los garantes a los que cobranzas puede contactar
+type GuarantorFollowUp struct {
+	ClientID        int64        `json:"client_id"`
+	ClientName      string       `json:"client_name"`
+	ClientLastname  string       `json:"client_lastname"`
+	ClientDni       string       `json:"client_dni"`
+	ClientPhone     string       `json:"client_phone"`
+	ClientAddress   string       `json:"client_address"`
+	SaleID          int64        `json:"sale_id"`
+	SaleDescription string       `json:"sale_description"`
+	OverdueQuotas   int64        `json:"overdue_quotas"`
+	OverdueAmount   float64      `json:"overdue_amount"`
+	Guarantors      []*Guarantor `json:"guarantors"`
+}
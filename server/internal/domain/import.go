@@ -0,0 +1,34 @@
+package domain
+
+const (
+	ImportEntityClients  = "clients"
+	ImportEntityProducts = "products"
+	ImportEntitySales    = "sales"
+)
+
+// ImportRowError describe un problema en una fila del archivo (Row es el número de fila en la planilla, con encabezado = 1)
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportResult resume una importación. Si hay errores no se importa nada.
+type ImportResult struct {
+	Entity    string            `json:"entity"`
+	DryRun    bool              `json:"dry_run"`
+	TotalRows int               `json:"total_rows"`
+	ValidRows int               `json:"valid_rows"`
+	Imported  int               `json:"imported"`
+	Columns   map[string]string `json:"columns"`
+	Errors    []ImportRowError  `json:"errors"`
+	Preview   any               `json:"preview,omitempty"`
+}
+
+// ImportedSale es una venta histórica (de planillas) con su plan de cuotas y los pagos ya realizados.
+// Ref identifica la venta en el sistema anterior y evita importarla dos veces.
+type ImportedSale struct {
+	Ref       string `json:"ref"`
+	ClientDNI string `json:"client_dni"`
+	Sale
+}
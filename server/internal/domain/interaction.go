@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+// Tipos de gestión de cobranza
+const (
+	InteractionCall     = "call"
+	InteractionVisit    = "visit"
+	InteractionWhatsApp = "whatsapp"
+)
+
+// Resultados de una gestión de cobranza
+const (
+	OutcomeContacted    = "contacted"
+	OutcomeNoAnswer     = "no_answer"
+	OutcomePromise      = "promise_to_pay"
+	OutcomeRefused      = "refused"
+	OutcomeWrongContact = "wrong_contact"
+	OutcomeOther        = "other"
+)
+
+// Estados de una promesa de pago
+const (
+	PromisePending = "pending"
+	PromiseKept    = "kept"
+	PromiseBroken  = "broken"
+)
+
+// ClientInteraction es una gestión de cobranza con un cliente: una llamada, una visita o un mensaje
+type ClientInteraction struct {
+	ID        int64           `json:"id"`
+	ClientID  int64           `json:"client_id"`
+	Type      string          `json:"type"`
+	Outcome   string          `json:"outcome"`
+	Notes     string          `json:"notes"`
+	UserID    *int64          `json:"user_id"`
+	Author    string          `json:"author"`
+	Promise   *PaymentPromise `json:"promise,omitempty"` // Promesa de pago obtenida en la gestión
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// PaymentPromise es el compromiso de un cliente de pagar un monto hasta una fecha.
+// Se cumple cuando los pagos registrados después de la promesa y fechados hasta ese día
+// alcanzan el monto, y se rompe si termina el día sin cubrirlo
+type PaymentPromise struct {
+	ID            int64      `json:"id"`
+	ClientID      int64      `json:"client_id"`
+	InteractionID *int64     `json:"interaction_id"`
+	PromisedDate  time.Time  `json:"promised_date"`
+	Amount        float64    `json:"amount"`
+	Status        string     `json:"status"`
+	PaidAmount    float64    `json:"paid_amount"` // Pagado a cuenta de la promesa
+	ResolvedAt    *time.Time `json:"resolved_at"`
+	Notes         string     `json:"notes"`
+	UserID        *int64     `json:"user_id"`
+	Author        string     `json:"author"`
+	CreatedAt     time.Time  `json:"created_at"`
+	// Datos del cliente, solo en el listado de promesas a seguir
+	ClientName     string `json:"client_name,omitempty"`
+	ClientLastname string `json:"client_lastname,omitempty"`
+	ClientPhone    string `json:"client_phone,omitempty"`
+	ClientAddress  string `json:"client_address,omitempty"`
+}
+
+// PromiseToResolve es una promesa (de cualquier estado) con los datos para repartirle los pagos del cliente
+type PromiseToResolve struct {
+	ID           int64
+	ClientID     int64
+	Amount       float64
+	PromisedDate time.Time
+	Status       string
+	PaidAmount   float64 // Lo guardado en la promesa
+	CreatedAt    time.Time
+}
+
+// PromisePayment es un pago que puede cubrir promesas de su cliente
+type PromisePayment struct {
+	ID        int64
+	ClientID  int64
+	Amount    float64
+	Date      time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,23 @@
+package domain
+
+// Canales por los que se le puede escribir a un cliente
+const (
+	ChannelEmail    = "email"
+	ChannelWhatsApp = "whatsapp"
+	ChannelSMS      = "sms"
+)
+
+// Message es un mensaje a un cliente. Subject y Attachments solo se usan en email.
+type Message struct {
+	Channel     string
+	To          string
+	Subject     string
+	Body        string
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
@@ -7,4 +7,6 @@ type Payment struct {
 	Amount  float64    `json:"amount"`
 	Date    *time.Time `json:"date"`
 	QuotaID int64      `json:"quota_id,omitempty"`
+	// CollectorID es el cobrador que registró el pago, si lo hubo
+	CollectorID *int64 `json:"collector_id,omitempty"`
 }
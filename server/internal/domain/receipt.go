@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+const (
+	ReceiptDeliveryStatusPending = "pending"
+	ReceiptDeliveryStatusSent    = "sent"
+	ReceiptDeliveryStatusFailed  = "failed"
+)
+
+// ReceiptDelivery es un envío por email del comprobante de un pago
+type ReceiptDelivery struct {
+	ID            int64      `json:"id"`
+	PaymentID     int64      `json:"payment_id"`
+	ClientID      int64      `json:"client_id"`
+	Recipient     string     `json:"recipient"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ReceiptTarget reúne el pago y el cliente al que se le envía el comprobante
+type ReceiptTarget struct {
+	PaymentID       int64
+	Amount          float64
+	Date            time.Time
+	QuotaNumber     int64
+	SaleDescription string
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	Email           string
+}
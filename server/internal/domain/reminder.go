@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+const (
+	ReminderStatusPending   = "pending"
+	ReminderStatusSent      = "sent"
+	ReminderStatusFailed    = "failed"
+	ReminderStatusCancelled = "cancelled" // La cuota se pagó o el cliente pidió no recibir recordatorios antes del envío
+)
+
+// Reminder es un recordatorio de vencimiento en el outbox.
+// OffsetDays es negativo para "vence en N días" y positivo para "N días de atraso".
+type Reminder struct {
+	ID            int64      `json:"id"`
+	QuotaID       int64      `json:"quota_id"`
+	ClientID      int64      `json:"client_id"`
+	Channel       string     `json:"channel"`
+	OffsetDays    int        `json:"offset_days"`
+	Recipient     string     `json:"recipient"`
+	Subject       string     `json:"subject,omitempty"`
+	Body          string     `json:"body"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ReminderTarget reúne la cuota, la venta y el cliente a los que se refiere un recordatorio
+type ReminderTarget struct {
+	QuotaID         int64
+	QuotaNumber     int64
+	Amount          float64
+	PaidAmount      float64
+	DueDate         time.Time
+	IsPaid          bool
+	SaleID          int64
+	SaleDescription string
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	Email           string
+	Phone           string
+	OptOut          bool
+}
+
+// Recipient devuelve a dónde escribirle al cliente según el canal ("" si no tiene el dato)
+func (t *ReminderTarget) Recipient(channel string) string {
+	if channel == ChannelEmail {
+		return t.Email
+	}
+	return t.Phone
+}
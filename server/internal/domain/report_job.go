@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+const (
+	ReportJobStatusQueued  = "queued"
+	ReportJobStatusRunning = "running"
+	ReportJobStatusDone    = "done"
+	ReportJobStatusFailed  = "failed"
+)
+
+const (
+	ReportTypeSalesBook = "sales_book"
+)
+
+// ReportTypes son los reportes que se pueden generar en segundo plano
+var ReportTypes = []string{ReportTypeSalesBook}
+
+// ReportJob es la generación en segundo plano de un reporte PDF
+type ReportJob struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Status      string     `json:"status"`
+	Processed   int        `json:"processed"` // Ventas procesadas
+	Total       int        `json:"total"`     // Ventas a procesar (0 mientras no se conoce)
+	Errors      int        `json:"errors"`    // Ventas que no se pudieron incluir
+	Error       string     `json:"error,omitempty"`
+	RequestedBy string     `json:"requested_by"`
+	Size        int        `json:"size,omitempty"` // Bytes del PDF generado
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+	ExpiresAt   *time.Time `json:"expires_at"` // Después de esta fecha el PDF se borra
+}
+
+// ReportProgress es el avance informado durante la generación de un reporte
+type ReportProgress struct {
+	Processed int
+	Total     int
+	Errors    int
+}
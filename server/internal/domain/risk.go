@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Niveles del puntaje de riesgo (0 a 100, más alto es más riesgoso)
+const (
+	RiskLevelLow    = "low"
+	RiskLevelMedium = "medium"
+	RiskLevelHigh   = "high"
+
+	RiskMediumScore = 30 // Desde este puntaje el riesgo es medio
+	RiskHighScore   = 60 // Desde este puntaje el cliente es riesgoso y se avisa al venderle
+)
+
+// Peso de cada componente en el puntaje; suman 1
+const (
+	riskWeightDaysLate    = 0.30
+	riskWeightLateQuotas  = 0.25
+	riskWeightStates      = 0.25
+	riskWeightOutstanding = 0.20
+
+	riskMaxDaysLate    = 60.0 // Atraso promedio que ya cuenta como el máximo
+	riskMaxStateVisits = 6.0  // Pasos a Warning (1) o Suspended (2) que ya cuentan como el máximo
+)
+
+// RiskLevel devuelve el nivel de un puntaje
+func RiskLevel(score int) string {
+	switch {
+	case score >= RiskHighScore:
+		return RiskLevelHigh
+	case score >= RiskMediumScore:
+		return RiskLevelMedium
+	}
+	return RiskLevelLow
+}
+
+// RiskStats es el historial de pagos de un cliente con el que se calcula su puntaje
+type RiskStats struct {
+	DueQuotas      int     // Cuotas pagadas o ya vencidas
+	OnTimeQuotas   int     // Pagadas a más tardar el día del vencimiento
+	TotalDaysLate  float64 // Suma de los días de atraso de las cuotas vencidas
+	WarningCount   int64   // Veces que pasó a Warning
+	SuspendedCount int64   // Veces que pasó a Suspended
+	Balance        float64 // Saldo impago
+	TotalBought    float64 // Suma de todas sus cuotas
+}
+
+// AddQuota suma una cuota al historial. daysLatePaid son los días entre el vencimiento y el último pago
+// de una cuota pagada; las impagas cuentan el atraso hasta now.
+func (s *RiskStats) AddQuota(amount, paidAmount float64, dueDate time.Time, isPaid bool, daysLatePaid float64, now time.Time) {
+	s.TotalBought += amount
+	if !isPaid {
+		s.Balance += math.Max(amount-paidAmount, 0)
+	}
+
+	daysLate := 0.0
+	switch {
+	case isPaid:
+		daysLate = math.Floor(daysLatePaid)
+	case dueDate.Before(now):
+		daysLate = math.Floor(now.Sub(dueDate).Hours() / 24)
+	default:
+		return // Todavía no venció
+	}
+
+	s.DueQuotas++
+	if daysLate <= 0 {
+		s.OnTimeQuotas++
+		return
+	}
+	s.TotalDaysLate += daysLate
+}
+
+// AverageDaysLate es el atraso promedio por cuota vencida
+func (s RiskStats) AverageDaysLate() float64 {
+	if s.DueQuotas == 0 {
+		return 0
+	}
+	return s.TotalDaysLate / float64(s.DueQuotas)
+}
+
+// OnTimeShare es la proporción de cuotas vencidas pagadas a tiempo; sin cuotas vencidas es 1
+func (s RiskStats) OnTimeShare() float64 {
+	if s.DueQuotas == 0 {
+		return 1
+	}
+	return float64(s.OnTimeQuotas) / float64(s.DueQuotas)
+}
+
+// OutstandingShare es el saldo impago sobre el total comprado
+func (s RiskStats) OutstandingShare() float64 {
+	if s.TotalBought <= 0 {
+		return 0
+	}
+	return math.Min(s.Balance/s.TotalBought, 1)
+}
+
+// Score combina el atraso promedio, las cuotas pagadas fuera de término, los pasos a Warning/Suspended
+// y el saldo pendiente en un puntaje de 0 a 100
+func (s RiskStats) Score() int {
+	daysLate := math.Min(s.AverageDaysLate()/riskMaxDaysLate, 1)
+	states := math.Min(float64(s.WarningCount+2*s.SuspendedCount)/riskMaxStateVisits, 1)
+
+	score := riskWeightDaysLate*daysLate +
+		riskWeightLateQuotas*(1-s.OnTimeShare()) +
+		riskWeightStates*states +
+		riskWeightOutstanding*s.OutstandingShare()
+
+	return int(math.Round(score * 100))
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "testing"
+
+func TestRiskStatsScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats RiskStats
+		want  int
+	}{
+		{
+			name:  "sin historial",
+			stats: RiskStats{},
+			want:  0,
+		},
+		{
+			name:  "todo pagado a tiempo",
+			stats: RiskStats{DueQuotas: 6, OnTimeQuotas: 6, TotalBought: 6000},
+			want:  0,
+		},
+		{
+			name: "historial mixto",
+			// atraso 30/60, mitad fuera de término, 3/6 pasos de estado, 25% impago
+			stats: RiskStats{DueQuotas: 4, OnTimeQuotas: 2, TotalDaysLate: 120, WarningCount: 1, SuspendedCount: 1, Balance: 250, TotalBought: 1000},
+			want:  45,
+		},
+		{
+			name:  "los pasos de estado tienen tope",
+			stats: RiskStats{WarningCount: 100},
+			want:  25,
+		},
+		{
+			name:  "peor caso",
+			stats: RiskStats{DueQuotas: 10, TotalDaysLate: 6000, SuspendedCount: 3, Balance: 1000, TotalBought: 1000},
+			want:  100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.Score(); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
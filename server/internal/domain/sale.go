@@ -20,4 +20,5 @@ type Sale struct {
 	Products    []*SaleProduct `json:"products"`
 	Quotas      []*Quota       `json:"quotas"`
 	Notes       []*Note        `json:"notes"`
+	Guarantors  []*Guarantor   `json:"guarantors"`
 }
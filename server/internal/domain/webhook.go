@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookAllEvents suscribe a todos los eventos, incluidos los que se agreguen más adelante
+const WebhookAllEvents = "*"
+
+// WebhookSubscription es un endpoint externo que recibe los eventos elegidos.
+// Secret firma cada entrega (HMAC-SHA256) para que el receptor pueda verificarla.
+type WebhookSubscription struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"secret"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Matches indica si la suscripción recibe ese tipo de evento
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	return slices.Contains(s.Events, WebhookAllEvents) || slices.Contains(s.Events, eventType)
+}
+
+// WebhookDelivery es el envío de un evento a una suscripción, con el resultado del último intento
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	SubscriptionID int64           `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus *int            `json:"response_status"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
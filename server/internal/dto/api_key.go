@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name"`
+	Permissions int64      `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateAPIKeyResponse incluye la key en texto plano: es la única vez que se muestra
+type CreateAPIKeyResponse struct {
+	APIKey *domain.APIKey `json:"api_key"`
+	Key    string         `json:"key"`
+}
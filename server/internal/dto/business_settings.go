@@ -0,0 +1,16 @@
+package dto
+
+// UpdateBusinessSettingsRequest reemplaza los datos del comercio (el logo se sube aparte)
+type UpdateBusinessSettingsRequest struct {
+	Name      string `json:"name"`
+	LegalName string `json:"legal_name"`
+	CUIT      string `json:"cuit"`
+	Address   string `json:"address"`
+	Phone     string `json:"phone"`
+	Footer    string `json:"footer"`
+
+	TicketWidth   int    `json:"ticket_width"` // 0 usa 80 mm
+	TicketPrinter string `json:"ticket_printer"`
+
+	ContractTerms string `json:"contract_terms"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type UpdateCollectorRequest struct {
+	CommissionRate *float64 `json:"commission_rate"`
+}
+
+// AssignCollectorRequest asigna un cliente a un cobrador y una zona; collector_id null lo desasigna
+type AssignCollectorRequest struct {
+	CollectorID *int64 `json:"collector_id"`
+	Zone        string `json:"zone"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+// UpdateCreditPolicyRequest cambia las reglas de crédito de un estado; null quita el límite
+type UpdateCreditPolicyRequest struct {
+	AllowSales     bool     `json:"allow_sales"`
+	MaxBalance     *float64 `json:"max_balance"`
+	MaxActiveSales *int64   `json:"max_active_sales"`
+}
+
+// UpdateCreditLimitsRequest cambia los límites propios de un cliente; null usa los de la política de su estado
+type UpdateCreditLimitsRequest struct {
+	MaxBalance     *float64 `json:"max_balance"`
+	MaxActiveSales *int64   `json:"max_active_sales"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+// StreamTicketResponse es el ticket para abrir GET /api/events?ticket=...
+type StreamTicketResponse struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// ExportFilter son los mismos filtros que GET /api/clients, más un rango de fechas para pagos
+type ExportFilter struct {
+	Search   string
+	StateIDs []int64
+	// From y To son inclusive; si faltan no se limita el rango
+	From *time.Time
+	To   *time.Time
+}
@@ -0,0 +1,14 @@
+package dto
+
+type GuarantorRequest struct {
+	Name         string `json:"name"`
+	Lastname     string `json:"lastname"`
+	Dni          string `json:"dni"`
+	Phone        string `json:"phone"`
+	Address      string `json:"address"`
+	Relationship string `json:"relationship"`
+}
+
+type LinkGuarantorRequest struct {
+	GuarantorID int64 `json:"guarantor_id"`
+}
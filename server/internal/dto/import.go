@@ -0,0 +1,9 @@
+package dto
+
+type ImportOptions struct {
+	// Format es "csv" o "xlsx"
+	Format string `json:"format"`
+	// Mapping asocia un campo (p. ej. "dni") con el encabezado de la columna en el archivo
+	Mapping map[string]string `json:"mapping"`
+	DryRun  bool              `json:"dry_run"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// PaymentPromiseRequest es una promesa de pago; promised_date va como YYYY-MM-DD
+type PaymentPromiseRequest struct {
+	PromisedDate string  `json:"promised_date"`
+	Amount       float64 `json:"amount"`
+	Notes        string  `json:"notes"`
+}
+
+// CreateInteractionRequest registra una gestión de cobranza; con outcome promise_to_pay
+// se puede mandar la promesa obtenida en el mismo pedido
+type CreateInteractionRequest struct {
+	Type    string                 `json:"type"`
+	Outcome string                 `json:"outcome"`
+	Notes   string                 `json:"notes"`
+	Promise *PaymentPromiseRequest `json:"promise,omitempty"`
+}
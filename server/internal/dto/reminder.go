@@ -0,0 +1,10 @@
+package dto
+
+type UpdateRemindersOptOutRequest struct {
+	OptOut *bool `json:"opt_out"`
+}
+
+type RunRemindersResponse struct {
+	Enqueued int `json:"enqueued"`
+	Sent     int `json:"sent"`
+}
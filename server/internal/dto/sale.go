@@ -13,6 +13,14 @@ type CreateSaleDto struct {
 	Quotas     int           `json:"quotas"`
 	QuotaPrice float64       `json:"quota_price"`
 	Products   []*ProductDto `json:"products"`
+	// Motivo con el que un encargado autoriza la venta aunque no cumpla las reglas de crédito
+	OverrideReason string `json:"override_reason,omitempty"`
+}
+
+// CreateSaleResponse es la respuesta al crear una venta; warnings avisa de clientes riesgosos sin bloquear la venta
+type CreateSaleResponse struct {
+	ID       int64    `json:"id"`
+	Warnings []string `json:"warnings"`
 }
 
 type ProductDto struct {
@@ -36,15 +44,16 @@ type CreateSaleRequest struct {
 }
 
 type SaleResponse struct {
-	ID          any            `json:"id"`
-	Description string         `json:"description"`
-	Amount      float64        `json:"amount"`
-	IsPaid      bool           `json:"is_paid"`
-	Date        *string        `json:"date"`
-	StateID     int            `json:"state"`
-	Products    []*SaleProduct `json:"products"`
-	Quotas      []*Quota       `json:"quotas"`
-	Notes       []*Note        `json:"notes"`
+	ID          any                 `json:"id"`
+	Description string              `json:"description"`
+	Amount      float64             `json:"amount"`
+	IsPaid      bool                `json:"is_paid"`
+	Date        *string             `json:"date"`
+	StateID     int                 `json:"state"`
+	Products    []*SaleProduct      `json:"products"`
+	Quotas      []*Quota            `json:"quotas"`
+	Notes       []*Note             `json:"notes"`
+	Guarantors  []*domain.Guarantor `json:"guarantors"`
 }
 
 type SaleProduct struct {
@@ -141,6 +150,11 @@ func ToSaleResponse(sale *domain.Sale) *SaleResponse {
 		}
 	}
 
+	guarantors := sale.Guarantors
+	if guarantors == nil {
+		guarantors = []*domain.Guarantor{}
+	}
+
 	return &SaleResponse{
 		ID:          sale.ID,
 		Description: sale.Description,
@@ -151,5 +165,6 @@ func ToSaleResponse(sale *domain.Sale) *SaleResponse {
 		Products:    products,
 		Quotas:      quotas,
 		Notes:       notes,
+		Guarantors:  guarantors,
 	}
 }
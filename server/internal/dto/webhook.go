@@ -0,0 +1,19 @@
+package dto
+
+// CreateWebhookRequest da de alta una suscripción; sin secret se genera uno
+type CreateWebhookRequest struct {
+	URL         string   `json:"url"`
+	Secret      string   `json:"secret,omitempty"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// UpdateWebhookRequest modifica solo los campos presentes
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url,omitempty"`
+	Secret      *string  `json:"secret,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+}
@@ -0,0 +1,139 @@
+// Package escpos arma comandos ESC/POS para impresoras térmicas de tickets
+// y los envía a impresoras de red (puerto RAW, normalmente 9100).
+package escpos
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	esc = 0x1b
+	gs  = 0x1d
+)
+
+type Align byte
+
+const (
+	AlignLeft   Align = 0
+	AlignCenter Align = 1
+	AlignRight  Align = 2
+)
+
+// codePage850 es el número de tabla PC850 (Latin-1 multilingüe) en ESC t
+const codePage850 = 2
+
+// Builder acumula los comandos de un ticket. El texto se convierte a PC850
+// para que salgan bien los acentos y la ñ.
+type Builder struct {
+	buf bytes.Buffer
+}
+
+// NewBuilder inicializa la impresora y selecciona la tabla de caracteres
+func NewBuilder() *Builder {
+	b := &Builder{}
+	b.buf.Write([]byte{esc, '@'})
+	b.buf.Write([]byte{esc, 't', codePage850})
+	return b
+}
+
+func (b *Builder) Align(align Align) *Builder {
+	b.buf.Write([]byte{esc, 'a', byte(align)})
+	return b
+}
+
+func (b *Builder) Bold(on bool) *Builder {
+	b.buf.Write([]byte{esc, 'E', boolByte(on)})
+	return b
+}
+
+// Large activa el doble de ancho y de alto
+func (b *Builder) Large(on bool) *Builder {
+	size := byte(0x00)
+	if on {
+		size = 0x11
+	}
+	b.buf.Write([]byte{gs, '!', size})
+	return b
+}
+
+// Text escribe el texto sin salto de línea
+func (b *Builder) Text(text string) *Builder {
+	for _, r := range text {
+		if c, ok := charmap.CodePage850.EncodeRune(r); ok {
+			b.buf.WriteByte(c)
+		} else {
+			b.buf.WriteByte('?')
+		}
+	}
+	return b
+}
+
+// Line escribe el texto y un salto de línea
+func (b *Builder) Line(text string) *Builder {
+	return b.Text(text).Feed(1)
+}
+
+func (b *Builder) Feed(lines int) *Builder {
+	b.buf.Write([]byte{esc, 'd', byte(lines)})
+	return b
+}
+
+// QR imprime un código QR (modelo 2, corrección M) con el tamaño de módulo indicado (1-16)
+func (b *Builder) QR(data string, moduleSize int) *Builder {
+	if moduleSize < 1 || moduleSize > 16 {
+		moduleSize = 6
+	}
+	payload := []byte(data)
+	storeLen := len(payload) + 3
+
+	b.buf.Write([]byte{gs, '(', 'k', 4, 0, 0x31, 0x41, 0x32, 0x00})                                 // Modelo 2
+	b.buf.Write([]byte{gs, '(', 'k', 3, 0, 0x31, 0x43, byte(moduleSize)})                           // Tamaño del módulo
+	b.buf.Write([]byte{gs, '(', 'k', 3, 0, 0x31, 0x45, 0x31})                                       // Corrección de errores M
+	b.buf.Write([]byte{gs, '(', 'k', byte(storeLen % 256), byte(storeLen / 256), 0x31, 0x50, 0x30}) // Guardar datos
+	b.buf.Write(payload)
+	b.buf.Write([]byte{gs, '(', 'k', 3, 0, 0x31, 0x51, 0x30}) // Imprimir
+	return b
+}
+
+// Cut avanza el papel y hace un corte parcial
+func (b *Builder) Cut() *Builder {
+	b.buf.Write([]byte{gs, 'V', 66, 3})
+	return b
+}
+
+func (b *Builder) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// Print envía los comandos a una impresora de red. Sin puerto en address se usa 9100.
+func Print(ctx context.Context, address string, data []byte) error {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "9100")
+	}
+
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("error connecting to printer %s: %w", address, err)
+	}
+	defer conn.Close()
+
+	conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if _, err := conn.Write(data); err != nil {
+		return fmt.Errorf("error sending ticket to printer %s: %w", address, err)
+	}
+	return nil
+}
+
+func boolByte(on bool) byte {
+	if on {
+		return 1
+	}
+	return 0
+}
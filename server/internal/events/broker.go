@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/constants"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// subscriptionBuffer es cuántos eventos puede tener pendientes una conexión;
+// si un cliente no los consume se lo desconecta para que reconecte y recargue.
+const subscriptionBuffer = 64
+
+// eventPermissions indica qué permisos alcanzan para ver cada tipo de evento
+// (cualquiera de ellos). Los eventos que no están acá no se transmiten.
+var eventPermissions = map[string]int64{
+	domain.EventPaymentCreated:     constants.PermissionSales | constants.PermissionDashboard,
+	domain.EventPaymentDeleted:     constants.PermissionSales | constants.PermissionDashboard,
+	domain.EventSaleCreated:        constants.PermissionSales | constants.PermissionDashboard,
+	domain.EventSaleDeleted:        constants.PermissionSales | constants.PermissionDashboard,
+	domain.EventClientDeleted:      constants.PermissionClients | constants.PermissionDashboard,
+	domain.EventClientStateChanged: constants.PermissionClients | constants.PermissionDashboard,
+	domain.EventProductLowStock:    constants.PermissionProducts,
+}
+
+// CanSee indica si alguien con esos permisos puede recibir el tipo de evento
+func CanSee(permissions int64, eventType string) bool {
+	required, ok := eventPermissions[eventType]
+	return ok && permissions&required != 0
+}
+
+// Subscription es una conexión en vivo (p. ej. un stream SSE).
+// Events se cierra cuando el broker la da de baja.
+type Subscription struct {
+	Events      chan domain.Event
+	permissions int64
+	types       []string
+}
+
+func (s *Subscription) wants(eventType string) bool {
+	if !CanSee(s.permissions, eventType) {
+		return false
+	}
+	return len(s.types) == 0 || slices.Contains(s.types, eventType)
+}
+
+// Broker reparte los eventos del bus entre las conexiones abiertas,
+// filtrando por los permisos de cada una.
+type Broker struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+}
+
+// Subscribe abre una suscripción; types vacío significa todos los eventos permitidos.
+// Devuelve nil si el broker ya se cerró.
+func (b *Broker) Subscribe(permissions int64, types []string) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	if b.subscriptions == nil {
+		b.subscriptions = make(map[*Subscription]struct{})
+	}
+
+	sub := &Subscription{
+		Events:      make(chan domain.Event, subscriptionBuffer),
+		permissions: permissions,
+		types:       types,
+	}
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe da de baja la suscripción; se puede llamar más de una vez
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+// Handle se suscribe al bus. Nunca bloquea: a una conexión que no da abasto se la corta.
+func (b *Broker) Handle(ctx context.Context, event domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscriptions {
+		if !sub.wants(event.Type) {
+			continue
+		}
+		select {
+		case sub.Events <- event:
+		default:
+			logger.FromContext(ctx).Warn("live event subscriber too slow, disconnecting", "event", event.Type)
+			b.remove(sub)
+		}
+	}
+}
+
+// Close corta todas las conexiones (al apagar el servidor, para que Shutdown no las espere)
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscriptions {
+		b.remove(sub)
+	}
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subscriptions[sub]; ok {
+		delete(b.subscriptions, sub)
+		close(sub.Events)
+	}
+}
@@ -0,0 +1,53 @@
+// Package events implementa un bus de eventos en memoria: los servicios
+// publican hechos de negocio y los consumidores (webhooks, SSE) se suscriben.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// Make sure Bus implements ports.EventPublisher
+// at compile time
+var _ ports.EventPublisher = &Bus{}
+
+// Handler recibe cada evento publicado. Corre en la goroutine de quien publica,
+// así que no debe bloquear: lo que sea lento se encola.
+type Handler func(ctx context.Context, event domain.Event)
+
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+// Subscribe registra un handler para todos los eventos
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Publish entrega el evento a todos los handlers. Un handler que entra en pánico
+// se loguea y no afecta a los demás ni a quien publica.
+func (b *Bus) Publish(ctx context.Context, event domain.Event) {
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, handler, event)
+	}
+}
+
+func (b *Bus) dispatch(ctx context.Context, handler Handler, event domain.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.FromContext(ctx).Error("event handler panicked", "event", event.Type, "panic", r)
+		}
+	}()
+	handler(ctx, event)
+}
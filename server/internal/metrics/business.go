@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+var (
+	overdueQuotasDesc = prometheus.NewDesc(
+		"gostore_overdue_quotas",
+		"Cantidad de cuotas impagas con vencimiento anterior a hoy.",
+		nil, nil)
+	overdueAmountDesc = prometheus.NewDesc(
+		"gostore_overdue_quotas_amount",
+		"Saldo pendiente de las cuotas vencidas (monto menos pagos parciales).",
+		nil, nil)
+	collectedTodayDesc = prometheus.NewDesc(
+		"gostore_collected_today_amount",
+		"Total cobrado en el día (hora local).",
+		nil, nil)
+)
+
+// BusinessCollector calcula las métricas de negocio contra la base en cada scrape,
+// así nunca quedan desactualizadas respecto a lo que muestra el dashboard.
+type BusinessCollector struct {
+	Queries *sqlc.Queries
+	Timeout time.Duration
+}
+
+var _ prometheus.Collector = &BusinessCollector{}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- overdueQuotasDesc
+	ch <- overdueAmountDesc
+	ch <- collectedTodayDesc
+}
+
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	overdue, err := c.Queries.GetOverdueQuotasSummary(ctx)
+	if err != nil {
+		slog.Error("error collecting overdue quotas metric", "error", err)
+		ch <- prometheus.NewInvalidMetric(overdueQuotasDesc, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(overdueQuotasDesc, prometheus.GaugeValue, float64(overdue.OverdueCount))
+		ch <- prometheus.MustNewConstMetric(overdueAmountDesc, prometheus.GaugeValue, overdue.OverdueAmount)
+	}
+
+	collected, err := c.Queries.GetCollectedToday(ctx)
+	if err != nil {
+		slog.Error("error collecting collected today metric", "error", err)
+		ch <- prometheus.NewInvalidMetric(collectedTodayDesc, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(collectedTodayDesc, prometheus.GaugeValue, collected)
+	}
+}
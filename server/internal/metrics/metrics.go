@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Métricas técnicas. Se registran en el registry por defecto de Prometheus,
+// que ya incluye las métricas del runtime de Go y del proceso.
+var (
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gostore_http_request_duration_seconds",
+		Help:    "Duración de los requests HTTP por ruta, método y status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	PDFConversionDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gostore_pdf_conversion_duration_seconds",
+		Help:    "Duración de la generación de PDFs, con wkhtmltopdf o con el renderer nativo.",
+		Buckets: []float64{0.25, 0.5, 1, 2, 5, 10, 30, 60},
+	})
+
+	PDFConversionFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "gostore_pdf_conversion_failures_total",
+		Help: "Cantidad de PDFs que no se pudieron generar.",
+	})
+
+	StateWorkerRunDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gostore_state_worker_run_duration_seconds",
+		Help:    "Duración de cada pasada del worker de actualización de estados.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 15, 60},
+	})
+
+	StateWorkerRunFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "gostore_state_worker_run_failures_total",
+		Help: "Cantidad de pasadas del worker de estados que terminaron con error.",
+	})
+
+	StateWorkerUpdates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gostore_state_worker_updates_total",
+		Help: "Cantidad de estados actualizados por el worker, por entidad.",
+	}, []string{"entity"})
+)
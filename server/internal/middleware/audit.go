@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// unauditedPaths usan POST pero no modifican datos
+var unauditedPaths = map[string]bool{
+	"/api/events/ticket": true,
+}
+
+// Audit registra en el log de auditoría cada request a la API que no es de lectura, con el actor
+// autenticado, la ruta y el status. Los requests sin actor (login, rechazados por falta de
+// credenciales) no se registran. Tiene que ir dentro de RequestLogger, que comparte el actor.
+func Audit(router *httprouter.Router, service ports.AuditService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, "/api/") || unauditedPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		state, ok := r.Context().Value(requestStateKey).(*requestState)
+		if !ok || state.actor == nil {
+			return
+		}
+
+		// El cliente puede haber cortado la conexión: el registro se guarda igual
+		ctx := context.WithoutCancel(r.Context())
+		entry := &domain.AuditEntry{
+			ActorType: state.actor.Type,
+			ActorID:   state.actor.ID,
+			ActorName: state.actor.Name,
+			Method:    r.Method,
+			Route:     routeLabel(router, r),
+			Path:      r.URL.Path,
+			Status:    rec.status(),
+			RequestID: logger.RequestID(ctx),
+		}
+		if err := service.Record(ctx, entry); err != nil {
+			logger.FromContext(ctx).Error("audit entry not recorded", "method", entry.Method, "route", entry.Route, "error", err)
+		}
+	})
+}
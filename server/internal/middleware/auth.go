@@ -5,30 +5,52 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
 	"github.com/benitez96/gostore/internal/services/jwt"
+	"github.com/benitez96/gostore/internal/shared/auth"
 	"github.com/julienschmidt/httprouter"
 )
 
 // AuthContextKey es la key para almacenar los claims en el contexto
 type AuthContextKey string
 
-const UserClaimsKey AuthContextKey = "user_claims"
+const (
+	UserClaimsKey AuthContextKey = "user_claims"
+)
+
+// APIKeyHeader es el header alternativo para enviar una API key
+const APIKeyHeader = "X-API-Key"
 
-// AuthMiddleware es el middleware de autenticación JWT
+// AuthMiddleware es el middleware de autenticación (JWT o API key)
 type AuthMiddleware struct {
-	jwtService *jwt.Service
+	jwtService    *jwt.Service
+	apiKeyService ports.APIKeyService
 }
 
 // NewAuthMiddleware crea una nueva instancia del middleware
-func NewAuthMiddleware(jwtService *jwt.Service) *AuthMiddleware {
+func NewAuthMiddleware(jwtService *jwt.Service, apiKeyService ports.APIKeyService) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtService: jwtService,
+		jwtService:    jwtService,
+		apiKeyService: apiKeyService,
 	}
 }
 
-// RequireAuth middleware que requiere autenticación JWT
+// RequireAuth middleware que requiere autenticación.
+// Acepta "Authorization: Bearer <jwt>", "Authorization: ApiKey <key>" o el header X-API-Key.
 func (m *AuthMiddleware) RequireAuth(next httprouter.Handle) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		// Las integraciones pueden mandar la key en su propio header
+		if rawKey := r.Header.Get(APIKeyHeader); rawKey != "" {
+			ctx, ok := m.authenticateAPIKey(r.Context(), rawKey)
+			if !ok {
+				http.Error(w, "Invalid or expired api key", http.StatusUnauthorized)
+				return
+			}
+			next(w, r.WithContext(ctx), ps)
+			return
+		}
+
 		// Obtener el header Authorization
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
@@ -36,13 +58,23 @@ func (m *AuthMiddleware) RequireAuth(next httprouter.Handle) httprouter.Handle {
 			return
 		}
 
-		// Verificar que tenga el formato "Bearer <token>"
+		// Verificar que tenga el formato "Bearer <token>" o "ApiKey <key>"
 		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
+		if len(parts) != 2 || (parts[0] != "Bearer" && parts[0] != "ApiKey") {
 			http.Error(w, "Invalid authorization header format", http.StatusUnauthorized)
 			return
 		}
 
+		if parts[0] == "ApiKey" {
+			ctx, ok := m.authenticateAPIKey(r.Context(), parts[1])
+			if !ok {
+				http.Error(w, "Invalid or expired api key", http.StatusUnauthorized)
+				return
+			}
+			next(w, r.WithContext(ctx), ps)
+			return
+		}
+
 		tokenString := parts[1]
 
 		// Validar el token
@@ -52,9 +84,8 @@ func (m *AuthMiddleware) RequireAuth(next httprouter.Handle) httprouter.Handle {
 			return
 		}
 
-		// Agregar claims al contexto
-		ctx := context.WithValue(r.Context(), UserClaimsKey, claims)
-		r = r.WithContext(ctx)
+		// Agregar claims y actor al contexto
+		r = r.WithContext(withClaims(r.Context(), claims))
 
 		// Continuar con el siguiente handler
 		next(w, r, ps)
@@ -70,8 +101,7 @@ func (m *AuthMiddleware) OptionalAuth(next httprouter.Handle) httprouter.Handle
 			if len(parts) == 2 && parts[0] == "Bearer" {
 				tokenString := parts[1]
 				if claims, err := m.jwtService.ValidateToken(tokenString); err == nil {
-					ctx := context.WithValue(r.Context(), UserClaimsKey, claims)
-					r = r.WithContext(ctx)
+					r = r.WithContext(withClaims(r.Context(), claims))
 				}
 			}
 		}
@@ -84,15 +114,15 @@ func (m *AuthMiddleware) OptionalAuth(next httprouter.Handle) httprouter.Handle
 func (m *AuthMiddleware) RequirePermission(permission int64) func(httprouter.Handle) httprouter.Handle {
 	return func(next httprouter.Handle) httprouter.Handle {
 		return m.RequireAuth(func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-			// Obtener claims del contexto
-			claims, ok := r.Context().Value(UserClaimsKey).(*jwt.Claims)
+			// Obtener el actor del contexto (usuario o API key)
+			actor, ok := GetActor(r)
 			if !ok {
 				http.Error(w, "Invalid user context", http.StatusInternalServerError)
 				return
 			}
 
 			// Verificar permisos (usando bitwise AND)
-			if claims.Permissions&permission == 0 {
+			if actor.Permissions&permission == 0 {
 				http.Error(w, "Insufficient permissions", http.StatusForbidden)
 				return
 			}
@@ -107,3 +137,42 @@ func GetUserClaims(r *http.Request) (*jwt.Claims, bool) {
 	claims, ok := r.Context().Value(UserClaimsKey).(*jwt.Claims)
 	return claims, ok
 }
+
+// GetActor extrae el actor autenticado (usuario o API key) del contexto
+func GetActor(r *http.Request) (*domain.Actor, bool) {
+	return auth.ActorFromContext(r.Context())
+}
+
+func withClaims(ctx context.Context, claims *jwt.Claims) context.Context {
+	return withActor(context.WithValue(ctx, UserClaimsKey, claims), &domain.Actor{
+		Type:        domain.ActorTypeUser,
+		ID:          claims.UserID,
+		Name:        claims.Username,
+		Permissions: claims.Permissions,
+	})
+}
+
+func withActor(ctx context.Context, actor *domain.Actor) context.Context {
+	setRequestActor(ctx, actor)
+	return auth.WithActor(ctx, actor)
+}
+
+// authenticateAPIKey valida la key y devuelve un contexto con el actor correspondiente.
+// Las API keys no tienen claims JWT: los handlers que necesitan un usuario deben usar GetUserClaims.
+func (m *AuthMiddleware) authenticateAPIKey(ctx context.Context, rawKey string) (context.Context, bool) {
+	if m.apiKeyService == nil {
+		return ctx, false
+	}
+
+	key, err := m.apiKeyService.Authenticate(ctx, rawKey)
+	if err != nil {
+		return ctx, false
+	}
+
+	return withActor(ctx, &domain.Actor{
+		Type:        domain.ActorTypeAPIKey,
+		ID:          key.ID,
+		Name:        key.Name,
+		Permissions: key.Permissions,
+	}), true
+}
@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// RequestIDHeader se acepta del cliente (o proxy) y se devuelve siempre en la respuesta
+const RequestIDHeader = "X-Request-ID"
+
+const requestStateKey AuthContextKey = "request_state"
+
+// requestState es compartido entre RequestLogger y los middlewares internos:
+// la autenticación guarda acá el actor para que el log final incluya el usuario.
+type requestState struct {
+	actor *domain.Actor
+}
+
+// RequestLogger asigna un ID a cada request, loguea method/path/status/latencia/usuario
+// en JSON y convierte cualquier panic en un 500 con el ID del request.
+func RequestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" || len(requestID) > 64 {
+			requestID = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		state := &requestState{}
+		ctx := logger.WithRequestID(r.Context(), requestID)
+		ctx = context.WithValue(ctx, requestStateKey, state)
+		r = r.WithContext(ctx)
+
+		rec := &statusRecorder{ResponseWriter: w}
+
+		defer func() {
+			if p := recover(); p != nil {
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+				logger.FromContext(ctx).Error("panic recovered",
+					"panic", p,
+					"stack", string(debug.Stack()),
+				)
+				if !rec.wroteHeader {
+					rec.Header().Set("Content-Type", "application/json")
+					rec.WriteHeader(http.StatusInternalServerError)
+					json.NewEncoder(rec).Encode(domain.AppError{
+						Code:      domain.ErrCodeInternalServerError,
+						Msg:       "Server Error",
+						RequestID: requestID,
+					})
+				}
+			}
+
+			attrs := []any{
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rec.status(),
+				"latency_ms", time.Since(start).Milliseconds(),
+				"bytes", rec.bytes,
+			}
+			if state.actor != nil {
+				attrs = append(attrs, "actor_type", state.actor.Type, "user_id", state.actor.ID)
+			}
+
+			level := slog.LevelInfo
+			if rec.status() >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.FromContext(ctx).Log(ctx, level, "http request", attrs...)
+		}()
+
+		next.ServeHTTP(rec, r)
+	})
+}
+
+// setRequestActor registra el actor autenticado para el log del request
+func setRequestActor(ctx context.Context, actor *domain.Actor) {
+	if state, ok := ctx.Value(requestStateKey).(*requestState); ok {
+		state.actor = actor
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder captura el status y los bytes escritos por el handler
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	bytes       int
+	wroteHeader bool
+}
+
+func (rec *statusRecorder) WriteHeader(code int) {
+	if !rec.wroteHeader {
+		rec.code = code
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if !rec.wroteHeader {
+		rec.WriteHeader(http.StatusOK)
+	}
+	n, err := rec.ResponseWriter.Write(b)
+	rec.bytes += n
+	return n, err
+}
+
+// Flush permite que respuestas en streaming sigan funcionando detrás del logger
+func (rec *statusRecorder) Flush() {
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap expone el writer original para http.ResponseController
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+func (rec *statusRecorder) status() int {
+	if !rec.wroteHeader {
+		return http.StatusOK
+	}
+	return rec.code
+}
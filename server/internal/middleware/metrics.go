@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/benitez96/gostore/internal/metrics"
+)
+
+// Metrics registra la duración y el status de cada request en Prometheus.
+// La ruta se etiqueta con el patrón de httprouter (/api/clients/:id) para no
+// generar una serie por cada ID; lo que no es API se agrupa como "static".
+func Metrics(router *httprouter.Router, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+
+		next.ServeHTTP(rec, r)
+
+		metrics.HTTPRequestDuration.
+			WithLabelValues(r.Method, routeLabel(router, r), strconv.Itoa(rec.status())).
+			Observe(time.Since(start).Seconds())
+	})
+}
+
+func routeLabel(router *httprouter.Router, r *http.Request) string {
+	path := r.URL.Path
+	if path == "/metrics" {
+		return path
+	}
+	if !strings.HasPrefix(path, "/api/") && path != "/healthz" && path != "/readyz" {
+		return "static"
+	}
+
+	handle, params, _ := router.Lookup(r.Method, path)
+	if handle == nil {
+		return "unmatched"
+	}
+
+	// Reemplazar los valores de los parámetros por su nombre, en orden
+	segments := strings.Split(path, "/")
+	next := 0
+	for i, segment := range segments {
+		if next < len(params) && segment == params[next].Value {
+			segments[i] = ":" + params[next].Key
+			next++
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/julienschmidt/httprouter"
+)
+
+// streamTicketTTL es cuánto dura un ticket sin usar: alcanza para abrir el stream enseguida
+const streamTicketTTL = 30 * time.Second
+
+// StreamTickets emite tickets de un solo uso para abrir streams desde el navegador.
+// EventSource no puede mandar headers, y un JWT o una API key en la URL quedan en los
+// logs de los proxies y en el historial; el ticket solo sirve una vez y por unos segundos.
+type StreamTickets struct {
+	mu      sync.Mutex
+	tickets map[string]streamTicket
+}
+
+type streamTicket struct {
+	actor     *domain.Actor
+	expiresAt time.Time
+}
+
+// Issue emite un ticket para el actor autenticado
+func (t *StreamTickets) Issue(actor *domain.Actor) (string, time.Time, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+	ticket := hex.EncodeToString(b)
+	now := time.Now()
+	expiresAt := now.Add(streamTicketTTL)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.tickets == nil {
+		t.tickets = make(map[string]streamTicket)
+	}
+	// Los tickets que nadie usó se limpian al emitir uno nuevo
+	for key, pending := range t.tickets {
+		if now.After(pending.expiresAt) {
+			delete(t.tickets, key)
+		}
+	}
+	t.tickets[ticket] = streamTicket{actor: actor, expiresAt: expiresAt}
+
+	return ticket, expiresAt, nil
+}
+
+// redeem consume el ticket: un segundo intento con el mismo ticket falla
+func (t *StreamTickets) redeem(ticket string) (*domain.Actor, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	pending, ok := t.tickets[ticket]
+	if !ok {
+		return nil, false
+	}
+	delete(t.tickets, ticket)
+
+	if time.Now().After(pending.expiresAt) {
+		return nil, false
+	}
+	return pending.actor, true
+}
+
+// RequireStreamTicket autentica con ?ticket=<ticket> emitido por StreamTickets.Issue.
+// Sin ticket se comporta como RequireAuth, para clientes que sí pueden mandar headers.
+func (m *AuthMiddleware) RequireStreamTicket(tickets *StreamTickets, next httprouter.Handle) httprouter.Handle {
+	requireAuth := m.RequireAuth(next)
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ticket := r.URL.Query().Get("ticket")
+		if ticket == "" {
+			requireAuth(w, r, ps)
+			return
+		}
+
+		actor, ok := tickets.redeem(ticket)
+		if !ok {
+			http.Error(w, "Invalid or expired ticket", http.StatusUnauthorized)
+			return
+		}
+
+		next(w, r.WithContext(withActor(r.Context(), actor)), ps)
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func TestStreamTickets(t *testing.T) {
+	var tickets StreamTickets
+	actor := &domain.Actor{Type: domain.ActorTypeUser, ID: 7, Name: "ana"}
+
+	ticket, expiresAt, err := tickets.Issue(actor)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	if ttl := time.Until(expiresAt); ttl <= 0 || ttl > streamTicketTTL {
+		t.Errorf("ticket expires in %v, want at most %v", ttl, streamTicketTTL)
+	}
+
+	if got, ok := tickets.redeem(ticket); !ok || got != actor {
+		t.Fatalf("first redeem = (%v, %v), want the issuing actor", got, ok)
+	}
+	if _, ok := tickets.redeem(ticket); ok {
+		t.Error("ticket was accepted twice")
+	}
+	if _, ok := tickets.redeem("inventado"); ok {
+		t.Error("unknown ticket was accepted")
+	}
+
+	tickets.tickets["vencido"] = streamTicket{actor: actor, expiresAt: time.Now().Add(-time.Second)}
+	if _, ok := tickets.redeem("vencido"); ok {
+		t.Error("expired ticket was accepted")
+	}
+}
@@ -0,0 +1,63 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure File implements ports.Notifier
+// at compile time
+var _ ports.Notifier = &File{}
+
+// File no envía nada: agrega cada mensaje como una línea JSON al archivo.
+// Sirve para probar plantillas y recordatorios en local sin escribirle a clientes reales.
+type File struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+type fileEntry struct {
+	Time        time.Time `json:"time"`
+	Channel     string    `json:"channel"`
+	To          string    `json:"to"`
+	Subject     string    `json:"subject,omitempty"`
+	Body        string    `json:"body"`
+	Attachments []string  `json:"attachments,omitempty"`
+}
+
+func (f *File) Send(ctx context.Context, msg *domain.Message) error {
+	entry := fileEntry{
+		Time:    time.Now(),
+		Channel: msg.Channel,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+	}
+	for _, a := range msg.Attachments {
+		entry.Attachments = append(entry.Attachments, a.Filename)
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
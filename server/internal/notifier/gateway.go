@@ -0,0 +1,72 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Gateway implements ports.Notifier
+// at compile time
+var _ ports.Notifier = &Gateway{}
+
+// Gateway envía WhatsApp/SMS a través de un proveedor HTTP genérico.
+// Hace POST de {"channel", "to", "subject", "body"} en JSON y espera una respuesta 2xx.
+type Gateway struct {
+	URL    string
+	Token  string
+	Client *http.Client
+}
+
+type gatewayPayload struct {
+	Channel string `json:"channel"`
+	To      string `json:"to"`
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body"`
+}
+
+func (g *Gateway) Send(ctx context.Context, msg *domain.Message) error {
+	payload, err := json.Marshal(gatewayPayload{
+		Channel: msg.Channel,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	client := g.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("gateway responded %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Package notifier implementa ports.Notifier: SMTP para email, un gateway HTTP
+// genérico para WhatsApp/SMS y un notifier a archivo para pruebas locales.
+package notifier
+
+import (
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// FromConfig devuelve los notifiers configurados indexados por canal.
+// Con log_file todos los canales se escriben al archivo en lugar de enviarse.
+func FromConfig(cfg config.NotifyConfig) map[string]ports.Notifier {
+	notifiers := make(map[string]ports.Notifier)
+
+	if cfg.LogFile != "" {
+		file := &File{Path: cfg.LogFile}
+		notifiers[domain.ChannelEmail] = file
+		notifiers[gatewayChannel(cfg.Gateway)] = file
+		return notifiers
+	}
+
+	if cfg.SMTP.Host != "" {
+		notifiers[domain.ChannelEmail] = &SMTP{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		}
+	}
+
+	if cfg.Gateway.URL != "" {
+		notifiers[gatewayChannel(cfg.Gateway)] = &Gateway{
+			URL:   cfg.Gateway.URL,
+			Token: cfg.Gateway.Token,
+		}
+	}
+
+	return notifiers
+}
+
+func gatewayChannel(cfg config.GatewayConfig) string {
+	if cfg.Channel == "" {
+		return domain.ChannelWhatsApp
+	}
+	return cfg.Channel
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, name, prefix, keyHash string, permissions int64, expiresAt time.Time, createdBy *int64) (*domain.APIKey, error)
+	GetAll(ctx context.Context) ([]*domain.APIKey, error)
+	GetByID(ctx context.Context, id int64) (*domain.APIKey, error)
+	GetByPrefix(ctx context.Context, prefix string) (*domain.APIKeyWithHash, error)
+	Revoke(ctx context.Context, id int64) error
+	UpdateLastUsed(ctx context.Context, id int64) error
+}
+
+type APIKeyService interface {
+	Create(ctx context.Context, req *dto.CreateAPIKeyRequest, createdBy *int64) (*dto.CreateAPIKeyResponse, error)
+	GetAll(ctx context.Context) ([]*domain.APIKey, error)
+	GetByID(ctx context.Context, id int64) (*domain.APIKey, error)
+	Revoke(ctx context.Context, id int64) error
+	Authenticate(ctx context.Context, rawKey string) (*domain.APIKey, error)
+}
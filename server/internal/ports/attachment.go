@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// FileStorage guarda el contenido de los archivos por clave (una ruta relativa como "client/3/ab12.jpg")
+type FileStorage interface {
+	Save(ctx context.Context, key string, content io.Reader) error
+	// Open devuelve domain.ErrNotFound si la clave no existe
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete no falla si la clave ya no existe
+	Delete(ctx context.Context, key string) error
+}
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *domain.FileAttachment) error
+	GetByID(ctx context.Context, id int64) (*domain.FileAttachment, error)
+	GetByOwner(ctx context.Context, ownerType string, ownerID int64) ([]*domain.FileAttachment, error)
+	Delete(ctx context.Context, id int64) error
+	// GetOrphans devuelve los adjuntos cuyo cliente, venta o pago ya no existe
+	GetOrphans(ctx context.Context) ([]*domain.FileAttachment, error)
+	OwnerExists(ctx context.Context, ownerType string, ownerID int64) (bool, error)
+}
+
+type AttachmentService interface {
+	// Upload guarda el archivo adjunto al dueño; el tipo se detecta por el contenido
+	Upload(ctx context.Context, ownerType string, ownerID int64, fileName string, content []byte) (*domain.FileAttachment, error)
+	GetByID(ctx context.Context, id int64) (*domain.FileAttachment, error)
+	GetByOwner(ctx context.Context, ownerType string, ownerID int64) ([]*domain.FileAttachment, error)
+	// Open devuelve el adjunto con su contenido, o su miniatura con thumbnail
+	Open(ctx context.Context, id int64, thumbnail bool) (*domain.FileAttachment, io.ReadCloser, error)
+	Delete(ctx context.Context, id int64) error
+	// RemoveOrphans borra los adjuntos, con sus archivos, de los clientes, ventas y pagos borrados
+	RemoveOrphans(ctx context.Context) (int, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type AuditRepository interface {
+	Create(ctx context.Context, entry *domain.AuditEntry) error
+	// Get lista los registros más recientes primero; actorType vacío y actorID 0 traen los de todos
+	Get(ctx context.Context, actorType string, actorID int64, limit, offset int) ([]*domain.AuditEntry, error)
+}
+
+type AuditService interface {
+	Record(ctx context.Context, entry *domain.AuditEntry) error
+	Get(ctx context.Context, actorType string, actorID int64, limit, offset int) ([]*domain.AuditEntry, error)
+}
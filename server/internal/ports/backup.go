@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"os"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type BackupService interface {
+	Create(ctx context.Context) (*domain.Backup, error)
+	List() ([]*domain.Backup, error)
+	Open(name string) (*os.File, error)
+	Restore(ctx context.Context, name string) error
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+type BusinessSettingsRepository interface {
+	Get(ctx context.Context) (*domain.BusinessSettings, error)
+	Update(ctx context.Context, settings *domain.BusinessSettings) error
+	// UpdateLogo guarda la imagen del logo; con logo nil se quita
+	UpdateLogo(ctx context.Context, logo []byte, mime string) error
+}
+
+type BusinessSettingsService interface {
+	Get(ctx context.Context) (*domain.BusinessSettings, error)
+	Update(ctx context.Context, req dto.UpdateBusinessSettingsRequest) (*domain.BusinessSettings, error)
+	SetLogo(ctx context.Context, logo []byte) (*domain.BusinessSettings, error)
+	DeleteLogo(ctx context.Context) (*domain.BusinessSettings, error)
+}
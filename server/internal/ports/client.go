@@ -10,7 +10,7 @@ type ClientService interface {
 	Create(client *domain.Client) error
 	Get(id string) (client *domain.Client, err error)
 	Update(id string, updateRequest *dto.UpdateClientRequest) error
-	GetAll(search string, limit, offset int, stateIds []int64) (clients *domain.Paginated[*domain.ClientSummary], err error)
+	GetAll(search string, limit, offset int, stateIds []int64, sort string) (clients *domain.Paginated[*domain.ClientSummary], err error)
 	Delete(id string) (err error)
 }
 
@@ -18,7 +18,7 @@ type ClientService interface {
 type ClientRepository interface {
 	Insert(c *domain.Client) error
 	Count(search string, stateIds []int64) (count int, err error)
-	GetAll(search string, limit, offset int, stateIds []int64) (clients []*domain.ClientSummary, err error)
+	GetAll(search string, limit, offset int, stateIds []int64, sort string) (clients []*domain.ClientSummary, err error)
 	Update(c *domain.Client) (err error)
 	Get(id string) (client *domain.Client, err error)
 	Delete(id string) (err error)
@@ -0,0 +1,41 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+type CollectorRepository interface {
+	GetAll(ctx context.Context) ([]*domain.Collector, error)
+	// GetByID devuelve domain.ErrNotFound si el usuario no existe o no es cobrador
+	GetByID(ctx context.Context, id int64) (*domain.Collector, error)
+	UpdateCommission(ctx context.Context, id int64, rate float64) error
+	AssignClient(ctx context.Context, clientID int64, collectorID *int64, zone string) error
+	GetClients(ctx context.Context, collectorID int64, zone string) ([]*domain.CollectorClient, error)
+	// GetRouteQuotas devuelve las cuotas impagas de sus clientes que vencen antes de before
+	GetRouteQuotas(ctx context.Context, collectorID int64, before time.Time) ([]*domain.RouteStop, error)
+	GetPaymentTotals(ctx context.Context, collectorID int64, from, to time.Time) (*domain.CollectorSummary, error)
+	// GetQuotaCollectorID devuelve el cobrador asignado al cliente de la cuota, nil si no tiene
+	GetQuotaCollectorID(ctx context.Context, quotaID int64) (*int64, error)
+	// GetPendingSaleIDs devuelve las ventas impagas de los clientes del cobrador y de la zona, ordenadas
+	// por cliente; collectorID 0 o zone vacía no filtran
+	GetPendingSaleIDs(ctx context.Context, collectorID int64, zone string) ([]int64, error)
+}
+
+type CollectorService interface {
+	GetAll(ctx context.Context) ([]*domain.Collector, error)
+	GetByID(ctx context.Context, id int64) (*domain.Collector, error)
+	Update(ctx context.Context, id int64, req dto.UpdateCollectorRequest) (*domain.Collector, error)
+	AssignClient(ctx context.Context, clientID int64, req dto.AssignCollectorRequest) error
+	GetClients(ctx context.Context, collectorID int64, zone string) ([]*domain.CollectorClient, error)
+	// RouteSheet arma la hoja de ruta del día: cuotas vencidas y que vencen ese día
+	RouteSheet(ctx context.Context, collectorID int64, date time.Time) (*domain.RouteSheet, error)
+	Summary(ctx context.Context, collectorID int64, from, to time.Time) (*domain.CollectorSummary, error)
+	// AttributePayment asigna el pago al cobrador que lo registra y valida que pueda cobrarlo
+	AttributePayment(ctx context.Context, payment *domain.Payment) error
+	// PendingSaleIDs devuelve las ventas impagas de un cobrador, de una zona o de ambos (al menos uno)
+	PendingSaleIDs(ctx context.Context, collectorID int64, zone string) ([]int64, error)
+}
@@ -0,0 +1,40 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type CreditRepository interface {
+	// GetStatus devuelve el saldo, las ventas activas y los límites propios del cliente
+	GetStatus(ctx context.Context, clientID int64) (*domain.CreditStatus, error)
+	UpdateClientLimits(ctx context.Context, clientID int64, limits domain.CreditLimits) error
+	GetPolicies(ctx context.Context) ([]*domain.CreditPolicy, error)
+	GetPolicy(ctx context.Context, stateID int) (*domain.CreditPolicy, error)
+	SavePolicy(ctx context.Context, policy *domain.CreditPolicy) error
+	// GetOverrides lista las autorizaciones más recientes primero; clientID 0 trae las de todos
+	GetOverrides(ctx context.Context, clientID int64, limit, offset int) ([]*domain.CreditOverride, error)
+}
+
+type CreditService interface {
+	// Check evalúa una venta nueva de amount para el cliente sin bloquearla
+	Check(ctx context.Context, clientID int64, amount float64) (*domain.CreditCheck, error)
+	// Authorize devuelve un AppError si la venta no cumple las reglas y no hay un motivo de autorización válido.
+	// Si la acepta devuelve el control a repetir dentro de la transacción que crea la venta.
+	Authorize(ctx context.Context, clientID int64, amount float64, overrideReason string) (SaleCreditGuard, error)
+	GetPolicies(ctx context.Context) ([]*domain.CreditPolicy, error)
+	UpdatePolicy(ctx context.Context, policy *domain.CreditPolicy) error
+	UpdateClientLimits(ctx context.Context, clientID int64, limits domain.CreditLimits) error
+	GetOverrides(ctx context.Context, clientID int64, limit, offset int) ([]*domain.CreditOverride, error)
+}
+
+// SaleCreditGuard repite el control de crédito dentro de la transacción que crea la venta,
+// así dos ventas simultáneas del mismo cliente no pueden pasar juntas los límites
+type SaleCreditGuard interface {
+	// Recheck evalúa la venta con el saldo y la política leídos en la transacción;
+	// policy es nil si el estado del cliente no tiene política cargada
+	Recheck(status *domain.CreditStatus, policy *domain.CreditPolicy) error
+	// Override devuelve la autorización de un encargado que se guarda con la venta, o nil si no hizo falta
+	Override() *domain.CreditOverride
+}
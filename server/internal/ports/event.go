@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// EventPublisher publica eventos de negocio una vez confirmados en la base
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event)
+}
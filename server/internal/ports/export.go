@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+// ExportRepository lee por lotes: devuelve hasta limit filas con id mayor a afterID, ordenadas por id
+type ExportRepository interface {
+	ExportClients(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.ClientExport, error)
+	ExportSales(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.SaleExport, error)
+	ExportQuotas(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.QuotaExport, error)
+	ExportPayments(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.PaymentExport, error)
+}
+
+// ExportService escribe la planilla en w a medida que lee de la base
+type ExportService interface {
+	ExportClients(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error
+	ExportSales(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error
+	ExportQuotas(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error
+	ExportPayments(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error
+}
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type GuarantorRepository interface {
+	Create(ctx context.Context, guarantor *domain.Guarantor) error
+	Update(ctx context.Context, guarantor *domain.Guarantor) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (*domain.Guarantor, error)
+	GetAll(ctx context.Context, search string, limit, offset int) ([]*domain.Guarantor, error)
+	// GetBySaleIDs devuelve los garantes de cada venta, en el orden en que se vincularon
+	GetBySaleIDs(ctx context.Context, saleIDs []int64) (map[int64][]*domain.Guarantor, error)
+	Link(ctx context.Context, saleID, guarantorID int64) error
+	Unlink(ctx context.Context, saleID, guarantorID int64) error
+	// GetFollowUps devuelve las ventas con garante y cuotas vencidas antes de before de los clientes en stateID
+	GetFollowUps(ctx context.Context, stateID int, before time.Time) ([]*domain.GuarantorFollowUp, error)
+}
+
+type GuarantorService interface {
+	Create(ctx context.Context, guarantor *domain.Guarantor) error
+	Update(ctx context.Context, guarantor *domain.Guarantor) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (*domain.Guarantor, error)
+	GetAll(ctx context.Context, search string, limit, offset int) ([]*domain.Guarantor, error)
+	GetBySaleID(ctx context.Context, saleID int64) ([]*domain.Guarantor, error)
+	Link(ctx context.Context, saleID, guarantorID int64) error
+	Unlink(ctx context.Context, saleID, guarantorID int64) error
+	// FollowUps lista las ventas de clientes suspendidos con cuotas vencidas para contactar a los garantes
+	FollowUps(ctx context.Context) ([]*domain.GuarantorFollowUp, error)
+}
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+type ImportRepository interface {
+	GetClientDNIs(ctx context.Context) ([]string, error)
+	InsertClients(ctx context.Context, clients []*domain.Client) error
+	InsertProducts(ctx context.Context, products []*domain.Product) error
+	GetClientIDsByDNI(ctx context.Context) (map[string]int64, error)
+	GetSaleLegacyRefs(ctx context.Context) ([]string, error)
+	InsertSales(ctx context.Context, sales []*domain.ImportedSale) ([]int64, error)
+}
+
+type ImportService interface {
+	ImportClients(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error)
+	ImportProducts(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error)
+	ImportSales(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error)
+}
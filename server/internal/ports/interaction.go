@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type InteractionRepository interface {
+	// Create guarda la gestión y, si trae, su promesa de pago en la misma transacción
+	Create(ctx context.Context, interaction *domain.ClientInteraction) error
+	GetByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.ClientInteraction, error)
+	CreatePromise(ctx context.Context, promise *domain.PaymentPromise) error
+	GetPromisesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.PaymentPromise, error)
+	// GetPromisesToResolve devuelve todas las promesas de un cliente, o de todos los que tienen
+	// alguna pendiente con clientID 0, ordenadas por cliente y fecha prometida
+	GetPromisesToResolve(ctx context.Context, clientID int64) ([]*domain.PromiseToResolve, error)
+	// GetPaymentsForPromises devuelve los pagos de esos mismos clientes hechos desde su primera
+	// promesa, ordenados por cliente y fecha
+	GetPaymentsForPromises(ctx context.Context, clientID int64) ([]*domain.PromisePayment, error)
+	UpdatePromiseStatus(ctx context.Context, id int64, status string, paidAmount float64, resolvedAt *time.Time) error
+	// GetPromisesDue devuelve las promesas pendientes con fecha prometida en [from, to)
+	GetPromisesDue(ctx context.Context, from, to time.Time) ([]*domain.PaymentPromise, error)
+}
+
+type InteractionService interface {
+	Create(ctx context.Context, interaction *domain.ClientInteraction) error
+	GetByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.ClientInteraction, error)
+	CreatePromise(ctx context.Context, promise *domain.PaymentPromise) error
+	GetPromisesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.PaymentPromise, error)
+	// ResolvePromises reparte los pagos de un cliente (de todos con clientID 0) entre sus promesas
+	// y marca como cumplidas o rotas las pendientes; devuelve cuántas cambiaron de estado
+	ResolvePromises(ctx context.Context, clientID int64) (int, error)
+	// PromisesDue lista las promesas pendientes que vencen el día date para hacerles seguimiento
+	PromisesDue(ctx context.Context, date time.Time) ([]*domain.PaymentPromise, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// Notifier envía mensajes a clientes por un canal (email, WhatsApp, SMS...)
+type Notifier interface {
+	Send(ctx context.Context, msg *domain.Message) error
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"context"
+
 	"github.com/benitez96/gostore/internal/domain"
 )
 
@@ -12,7 +14,7 @@ type PaymentRepository interface {
 }
 
 type PaymentService interface {
-	Create(payment *domain.Payment) error
-	Delete(paymentID string) error
+	Create(ctx context.Context, payment *domain.Payment) error
+	Delete(ctx context.Context, paymentID string) error
 	GetByID(paymentID string) (*domain.Payment, error)
 }
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type ReceiptRepository interface {
+	GetTarget(ctx context.Context, paymentID int64) (*domain.ReceiptTarget, error)
+	Create(ctx context.Context, delivery *domain.ReceiptDelivery) error
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.ReceiptDelivery, error)
+	MarkSent(ctx context.Context, id int64, sentAt time.Time) error
+	UpdateAttempt(ctx context.Context, delivery *domain.ReceiptDelivery) error
+	GetByID(ctx context.Context, id int64) (*domain.ReceiptDelivery, error)
+	GetByPaymentID(ctx context.Context, paymentID int64) ([]*domain.ReceiptDelivery, error)
+	Retry(ctx context.Context, id int64, at time.Time) error
+}
+
+type ReceiptService interface {
+	// Send genera el comprobante y lo envía en el momento; si falla queda en cola para reintentar
+	Send(ctx context.Context, paymentID int64) (*domain.ReceiptDelivery, error)
+	// Enqueue deja el envío en cola para el worker (usado al registrar un pago)
+	Enqueue(ctx context.Context, paymentID int64) (*domain.ReceiptDelivery, error)
+	// Dispatch envía los comprobantes pendientes; devuelve cuántos se enviaron
+	Dispatch(ctx context.Context) (int, error)
+	GetByPaymentID(ctx context.Context, paymentID int64) ([]*domain.ReceiptDelivery, error)
+	Retry(ctx context.Context, id int64) (*domain.ReceiptDelivery, error)
+}
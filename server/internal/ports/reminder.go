@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type ReminderRepository interface {
+	GetUnpaidQuotas(ctx context.Context) ([]*domain.Quota, error)
+	GetTarget(ctx context.Context, quotaID int64) (*domain.ReminderTarget, error)
+	// Enqueue devuelve false si ese recordatorio ya estaba en el outbox
+	Enqueue(ctx context.Context, reminder *domain.Reminder) (bool, error)
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error)
+	MarkSent(ctx context.Context, id int64, sentAt time.Time) error
+	UpdateAttempt(ctx context.Context, reminder *domain.Reminder) error
+	GetByID(ctx context.Context, id int64) (*domain.Reminder, error)
+	GetByQuotaID(ctx context.Context, quotaID int64) ([]*domain.Reminder, error)
+	GetAll(ctx context.Context, status string, limit, offset int) ([]*domain.Reminder, error)
+	Retry(ctx context.Context, id int64, at time.Time) error
+	SetClientOptOut(ctx context.Context, clientID int64, optOut bool) error
+}
+
+type ReminderService interface {
+	// Scan encola los recordatorios que corresponden hoy; devuelve cuántos encoló
+	Scan(ctx context.Context) (int, error)
+	// Dispatch envía los recordatorios pendientes; devuelve cuántos se enviaron
+	Dispatch(ctx context.Context) (int, error)
+	GetAll(ctx context.Context, status string, limit, offset int) ([]*domain.Reminder, error)
+	GetByQuotaID(ctx context.Context, quotaID int64) ([]*domain.Reminder, error)
+	Retry(ctx context.Context, id int64) (*domain.Reminder, error)
+	SetClientOptOut(ctx context.Context, clientID int64, optOut bool) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// ReportGenerator genera los reportes PDF que se pueden pedir en segundo plano
+type ReportGenerator interface {
+	GenerateSalesBookWithProgress(ctx context.Context, progress func(domain.ReportProgress)) ([]byte, error)
+}
+
+type ReportJobService interface {
+	// Submit encola la generación de un reporte y devuelve el job sin esperar a que termine
+	Submit(ctx context.Context, reportType, requestedBy string) (*domain.ReportJob, error)
+	Get(ctx context.Context, id string) (*domain.ReportJob, error)
+	// Open abre el PDF de un job terminado; el llamador debe cerrarlo
+	Open(ctx context.Context, id string) (io.ReadCloser, *domain.ReportJob, error)
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"context"
+
 	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/dto"
 )
@@ -17,7 +19,7 @@ type PendingSale struct {
 }
 
 type SaleService interface {
-	Create(dto *dto.CreateSaleDto) (int64, error)
+	Create(ctx context.Context, dto *dto.CreateSaleDto) (*dto.CreateSaleResponse, error)
 	GetByID(id string) (sale *domain.Sale, err error)
 	GetByClientID(id string) (sale []*domain.SaleSummary, err error)
 	GetPendingSalesOrderedByClient() ([]*PendingSale, error)
@@ -25,7 +27,9 @@ type SaleService interface {
 }
 
 type SaleRepository interface {
-	CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto) (int64, error)
+	// CreateSaleWithProductsAndQuotas crea la venta en una transacción; si credit no es nil
+	// vuelve a controlar el crédito y guarda la autorización en esa misma transacción
+	CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto, credit SaleCreditGuard) (int64, error)
 	GetByID(id string) (sale *domain.Sale, err error)
 	GetByClientID(id string) (sale []*domain.SaleSummary, err error)
 	GetPendingSalesOrderedByClient() ([]*PendingSale, error)
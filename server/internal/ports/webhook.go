@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	GetSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	GetActiveSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	GetSubscriptionByID(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id int64) error
+	Enqueue(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, delivery *domain.WebhookDelivery) error
+	UpdateAttempt(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetDeliveryByID(ctx context.Context, id int64) (*domain.WebhookDelivery, error)
+	GetDeliveries(ctx context.Context, subscriptionID int64, status string, limit, offset int) ([]*domain.WebhookDelivery, error)
+}
+
+type WebhookService interface {
+	Create(ctx context.Context, req *dto.CreateWebhookRequest) (*domain.WebhookSubscription, error)
+	GetAll(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	GetByID(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+	Update(ctx context.Context, id int64, req *dto.UpdateWebhookRequest) (*domain.WebhookSubscription, error)
+	Delete(ctx context.Context, id int64) error
+	// HandleEvent encola el evento para cada suscripción activa interesada
+	HandleEvent(ctx context.Context, event domain.Event)
+	// Dispatch envía las entregas pendientes; devuelve cuántas se entregaron
+	Dispatch(ctx context.Context) (int, error)
+	GetDeliveries(ctx context.Context, subscriptionID int64, status string, limit, offset int) ([]*domain.WebhookDelivery, error)
+	// Redeliver vuelve a encolar el mismo payload como una entrega nueva
+	Redeliver(ctx context.Context, deliveryID int64) (*domain.WebhookDelivery, error)
+}
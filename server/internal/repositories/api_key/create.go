@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, name, prefix, keyHash string, permissions int64, expiresAt time.Time, createdBy *int64) (*domain.APIKey, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	var creator sql.NullInt64
+	if createdBy != nil {
+		creator = sql.NullInt64{Int64: *createdBy, Valid: true}
+	}
+
+	row, err := r.Queries.InsertAPIKey(ctx, sqlc.InsertAPIKeyParams{
+		Name:        name,
+		KeyPrefix:   prefix,
+		KeyHash:     keyHash,
+		Permissions: permissions,
+		ExpiresAt:   expiresAt,
+		CreatedBy:   creator,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomain(row), nil
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetAll(ctx context.Context) ([]*domain.APIKey, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	keys := make([]*domain.APIKey, len(rows))
+	for i, row := range rows {
+		keys[i] = toDomain(row)
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.APIKey, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomain(row), nil
+}
+
+func (r *Repository) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKeyWithHash, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return &domain.APIKeyWithHash{
+		APIKey:  *toDomain(row),
+		KeyHash: row.KeyHash,
+	}, nil
+}
+
+func toDomain(row sqlc.ApiKey) *domain.APIKey {
+	key := &domain.APIKey{
+		ID:          row.ID,
+		Name:        row.Name,
+		Prefix:      row.KeyPrefix,
+		Permissions: row.Permissions,
+		ExpiresAt:   row.ExpiresAt,
+		LastUsedAt:  parseNullTime(row.LastUsedAt),
+		RevokedAt:   parseNullTime(row.RevokedAt),
+		CreatedAt:   row.CreatedAt,
+	}
+	if row.CreatedBy.Valid {
+		createdBy := row.CreatedBy.Int64
+		key.CreatedBy = &createdBy
+	}
+	return key
+}
+
+func parseNullTime(nt sql.NullTime) *time.Time {
+	if nt.Valid {
+		return &nt.Time
+	}
+	return nil
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return domain.ErrTimeout
+	}
+	return err
+}
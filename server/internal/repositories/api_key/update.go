@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Revoke(ctx context.Context, id int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	if err := r.Queries.RevokeAPIKey(ctx, id); err != nil {
+		return manageError(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateLastUsed(ctx context.Context, id int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	if err := r.Queries.UpdateAPIKeyLastUsed(ctx, id); err != nil {
+		return manageError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, attachment *domain.FileAttachment) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.CreateAttachment(ctx, sqlc.CreateAttachmentParams{
+		OwnerType:    attachment.OwnerType,
+		OwnerID:      attachment.OwnerID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		StorageKey:   attachment.StorageKey,
+		ThumbnailKey: utils.ParseToSqlNullString(attachment.ThumbnailKey),
+		UserID:       utils.ParseToSqlNullInt64(attachment.UserID),
+		Author:       attachment.Author,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*attachment = *toDomain(row)
+	return nil
+}
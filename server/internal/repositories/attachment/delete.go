@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.DeleteAttachment(ctx, id)
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
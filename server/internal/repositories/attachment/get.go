@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.FileAttachment, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetAttachmentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+	return toDomain(row), nil
+}
+
+func (r *Repository) GetByOwner(ctx context.Context, ownerType string, ownerID int64) ([]*domain.FileAttachment, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetAttachmentsByOwner(ctx, sqlc.GetAttachmentsByOwnerParams{
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+	return toDomainList(rows), nil
+}
+
+func (r *Repository) GetOrphans(ctx context.Context) ([]*domain.FileAttachment, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetOrphanAttachments(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+	return toDomainList(rows), nil
+}
+
+func (r *Repository) OwnerExists(ctx context.Context, ownerType string, ownerID int64) (bool, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	var exists func(context.Context, int64) (int64, error)
+	switch ownerType {
+	case domain.AttachmentOwnerClient:
+		exists = r.Queries.ClientExists
+	case domain.AttachmentOwnerSale:
+		exists = r.Queries.SaleExists
+	case domain.AttachmentOwnerPayment:
+		exists = r.Queries.PaymentExists
+	default:
+		return false, fmt.Errorf("unknown attachment owner type %q", ownerType)
+	}
+
+	found, err := exists(ctx, ownerID)
+	if err != nil {
+		return false, manageError(err)
+	}
+	return found == 1, nil
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// Make sure Repository implements ports.AttachmentRepository
+// at compile time
+var _ ports.AttachmentRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+func manageError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return domain.ErrTimeout
+	case strings.Contains(err.Error(), "UNIQUE constraint failed"):
+		return fmt.Errorf("%w: %s", domain.ErrDuplicateKey, err.Error())
+	case strings.Contains(err.Error(), "FOREIGN KEY constraint failed"):
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+func toDomain(row sqlc.Attachment) *domain.FileAttachment {
+	return &domain.FileAttachment{
+		ID:           row.ID,
+		OwnerType:    row.OwnerType,
+		OwnerID:      row.OwnerID,
+		FileName:     row.FileName,
+		ContentType:  row.ContentType,
+		Size:         row.Size,
+		HasThumbnail: row.ThumbnailKey.Valid,
+		UserID:       utils.ParseToInt64Ptr(row.UserID),
+		Author:       row.Author,
+		CreatedAt:    row.CreatedAt,
+		StorageKey:   row.StorageKey,
+		ThumbnailKey: utils.ParseToEmptyString(row.ThumbnailKey),
+	}
+}
+
+func toDomainList(rows []sqlc.Attachment) []*domain.FileAttachment {
+	attachments := make([]*domain.FileAttachment, len(rows))
+	for i, row := range rows {
+		attachments[i] = toDomain(row)
+	}
+	return attachments
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, entry *domain.AuditEntry) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.CreateAuditEntry(ctx, sqlc.CreateAuditEntryParams{
+		ActorType: entry.ActorType,
+		ActorID:   entry.ActorID,
+		ActorName: entry.ActorName,
+		Method:    entry.Method,
+		Route:     entry.Route,
+		Path:      entry.Path,
+		Status:    int64(entry.Status),
+		RequestID: entry.RequestID,
+	})
+	return manageError(err)
+}
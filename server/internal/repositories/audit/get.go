@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Get(ctx context.Context, actorType string, actorID int64, limit, offset int) ([]*domain.AuditEntry, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetAuditEntries(ctx, sqlc.GetAuditEntriesParams{
+		ActorType: actorType,
+		ActorID:   actorID,
+		Limit:     int64(limit),
+		Offset:    int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	entries := make([]*domain.AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &domain.AuditEntry{
+			ID:        row.ID,
+			ActorType: row.ActorType,
+			ActorID:   row.ActorID,
+			ActorName: row.ActorName,
+			Method:    row.Method,
+			Route:     row.Route,
+			Path:      row.Path,
+			Status:    int(row.Status),
+			RequestID: row.RequestID,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.AuditRepository
+// at compile time
+var _ ports.AuditRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// Make sure Repository implements ports.BusinessSettingsRepository
+// at compile time
+var _ ports.BusinessSettingsRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+func (r *Repository) Get(ctx context.Context) (*domain.BusinessSettings, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetBusinessSettings(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return &domain.BusinessSettings{
+		Name:      row.Name,
+		LegalName: row.LegalName,
+		CUIT:      row.Cuit,
+		Address:   row.Address,
+		Phone:     row.Phone,
+		Footer:    row.Footer,
+		HasLogo:   len(row.Logo) > 0,
+		UpdatedAt: row.UpdatedAt,
+		Logo:      row.Logo,
+		LogoMime:  utils.ParseToEmptyString(row.LogoMime),
+
+		TicketWidth:   int(row.TicketWidth),
+		TicketPrinter: row.TicketPrinter,
+
+		ContractTerms: row.ContractTerms,
+	}, nil
+}
+
+func (r *Repository) Update(ctx context.Context, settings *domain.BusinessSettings) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpdateBusinessSettings(ctx, sqlc.UpdateBusinessSettingsParams{
+		Name:      settings.Name,
+		LegalName: settings.LegalName,
+		Cuit:      settings.CUIT,
+		Address:   settings.Address,
+		Phone:     settings.Phone,
+		Footer:    settings.Footer,
+
+		TicketWidth:   int64(settings.TicketWidth),
+		TicketPrinter: settings.TicketPrinter,
+
+		ContractTerms: settings.ContractTerms,
+	})
+	return manageError(err)
+}
+
+func (r *Repository) UpdateLogo(ctx context.Context, logo []byte, mime string) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpdateBusinessLogo(ctx, sqlc.UpdateBusinessLogoParams{
+		Logo:     logo,
+		LogoMime: utils.ParseToSqlNullString(mime),
+	})
+	return manageError(err)
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/benitez96/gostore/internal/repositories/utils"
 )
 
-func (r *Repository) GetAll(search string, limit, offset int, stateIds []int64) ([]*domain.ClientSummary, error) {
+func (r *Repository) GetAll(search string, limit, offset int, stateIds []int64, sort string) ([]*domain.ClientSummary, error) {
 	ctx, cancel := utils.GetContext()
 	defer cancel()
 
@@ -19,11 +19,21 @@ func (r *Repository) GetAll(search string, limit, offset int, stateIds []int64)
 		filterFlag = "filter"
 	}
 
+	// Orden por riesgo: 1 de mayor a menor, -1 de menor a mayor, 0 solo por nombre
+	riskOrder := int64(0)
+	switch sort {
+	case domain.ClientSortRiskDesc:
+		riskOrder = 1
+	case domain.ClientSortRisk:
+		riskOrder = -1
+	}
+
 	rows, err := r.Queries.GetClients(ctx, sqlc.GetClientsParams{
+		Column1:  riskOrder,
 		Name:     startsWith(search),
 		Lastname: startsWith(search),
 		Dni:      startsWith(search),
-		Column4:  filterFlag,
+		Column5:  filterFlag,
 		StateIds: stateIds,
 		Limit:    int64(limit),
 		Offset:   int64(offset),
@@ -44,6 +54,8 @@ func (r *Repository) GetAll(search string, limit, offset int, stateIds []int64)
 				ID:          result.Stateid,
 				Description: result.Statedescription,
 			},
+			RiskScore: int(result.RiskScore),
+			RiskLevel: domain.RiskLevel(int(result.RiskScore)),
 		}
 	}
 
@@ -74,9 +86,14 @@ func (r *Repository) Get(id string) (*domain.Client, error) {
 			ID:          res.StateID,
 			Description: res.StateDescription,
 		},
-		Email:   utils.ParseToEmptyString(res.Email),
-		Phone:   utils.ParseToEmptyString(res.Phone),
-		Address: utils.ParseToEmptyString(res.Address),
+		Email:           utils.ParseToEmptyString(res.Email),
+		Phone:           utils.ParseToEmptyString(res.Phone),
+		Address:         utils.ParseToEmptyString(res.Address),
+		RemindersOptOut: res.RemindersOptOut,
+		CollectorID:     utils.ParseToInt64Ptr(res.CollectorID),
+		Zone:            res.Zone,
+		RiskScore:       int(res.RiskScore),
+		RiskLevel:       domain.RiskLevel(int(res.RiskScore)),
 	}
 
 	return client, nil
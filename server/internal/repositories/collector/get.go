@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+func (r *Repository) GetAll(ctx context.Context) ([]*domain.Collector, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetCollectors(ctx, constants.PermissionCollector)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	collectors := make([]*domain.Collector, len(rows))
+	for i, row := range rows {
+		collectors[i] = toDomain(sqlc.GetCollectorByIDRow(row))
+	}
+
+	return collectors, nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Collector, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetCollectorByID(ctx, sqlc.GetCollectorByIDParams{
+		ID:         id,
+		Permission: constants.PermissionCollector,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomain(row), nil
+}
+
+func (r *Repository) GetClients(ctx context.Context, collectorID int64, zone string) ([]*domain.CollectorClient, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetCollectorClients(ctx, sqlc.GetCollectorClientsParams{
+		CollectorID: sql.NullInt64{Int64: collectorID, Valid: true},
+		Zone:        zone,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	clients := make([]*domain.CollectorClient, len(rows))
+	for i, row := range rows {
+		clients[i] = &domain.CollectorClient{
+			ID:       row.ID,
+			Name:     row.Name,
+			Lastname: row.Lastname,
+			Dni:      row.Dni,
+			Phone:    utils.ParseToEmptyString(row.Phone),
+			Address:  utils.ParseToEmptyString(row.Address),
+			Zone:     row.Zone,
+			State: &domain.State{
+				ID:          row.StateID,
+				Description: row.StateDescription,
+			},
+		}
+	}
+
+	return clients, nil
+}
+
+// GetRouteQuotas agrupa las cuotas por cliente; la consulta ya viene ordenada por zona y cliente
+func (r *Repository) GetRouteQuotas(ctx context.Context, collectorID int64, before time.Time) ([]*domain.RouteStop, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetCollectorRouteQuotas(ctx, sqlc.GetCollectorRouteQuotasParams{
+		CollectorID: sql.NullInt64{Int64: collectorID, Valid: true},
+		Before:      before,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	var stops []*domain.RouteStop
+	var stop *domain.RouteStop
+	for _, row := range rows {
+		if stop == nil || stop.ClientID != row.ClientID {
+			stop = &domain.RouteStop{
+				ClientID: row.ClientID,
+				Name:     row.ClientName,
+				Lastname: row.ClientLastname,
+				Dni:      row.Dni,
+				Phone:    utils.ParseToEmptyString(row.Phone),
+				Address:  utils.ParseToEmptyString(row.Address),
+				Zone:     row.Zone,
+				StateID:  int(row.StateID),
+			}
+			stops = append(stops, stop)
+		}
+
+		balance := row.Amount - row.PaidAmount
+		stop.Quotas = append(stop.Quotas, &domain.RouteQuota{
+			QuotaID:         row.QuotaID,
+			Number:          row.QuotaNumber,
+			SaleID:          row.SaleID,
+			SaleDescription: row.SaleDescription,
+			DueDate:         row.DueDate,
+			Amount:          row.Amount,
+			PaidAmount:      row.PaidAmount,
+			Balance:         balance,
+		})
+		stop.Total += balance
+	}
+
+	return stops, nil
+}
+
+func (r *Repository) GetPaymentTotals(ctx context.Context, collectorID int64, from, to time.Time) (*domain.CollectorSummary, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetCollectorPaymentTotals(ctx, sqlc.GetCollectorPaymentTotalsParams{
+		CollectorID: sql.NullInt64{Int64: collectorID, Valid: true},
+		FromDate:    from,
+		ToDate:      to,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return &domain.CollectorSummary{
+		From:          from,
+		To:            to,
+		PaymentsCount: row.PaymentsCount,
+		ClientsCount:  row.ClientsCount,
+		Total:         row.Total,
+	}, nil
+}
+
+func (r *Repository) GetQuotaCollectorID(ctx context.Context, quotaID int64) (*int64, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	collectorID, err := r.Queries.GetQuotaCollectorID(ctx, quotaID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return utils.ParseToInt64Ptr(collectorID), nil
+}
+
+func toDomain(row sqlc.GetCollectorByIDRow) *domain.Collector {
+	return &domain.Collector{
+		ID:             row.ID,
+		Username:       row.Username,
+		FirstName:      utils.ParseToEmptyString(row.FirstName),
+		LastName:       utils.ParseToEmptyString(row.LastName),
+		IsActive:       row.IsActive,
+		CommissionRate: row.CommissionRate,
+		ClientsCount:   row.ClientsCount,
+	}
+}
+
+func (r *Repository) GetPendingSaleIDs(ctx context.Context, collectorID int64, zone string) ([]int64, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	ids, err := r.Queries.GetCollectorPendingSaleIDs(ctx, sqlc.GetCollectorPendingSaleIDsParams{
+		CollectorID: collectorID,
+		Zone:        zone,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+	return ids, nil
+}
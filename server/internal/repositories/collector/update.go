@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) UpdateCommission(ctx context.Context, id int64, rate float64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.UpdateCollectorCommission(ctx, sqlc.UpdateCollectorCommissionParams{
+		CommissionRate: rate,
+		ID:             id,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) AssignClient(ctx context.Context, clientID int64, collectorID *int64, zone string) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.AssignClientCollector(ctx, sqlc.AssignClientCollectorParams{
+		CollectorID: utils.ParseToSqlNullInt64(collectorID),
+		Zone:        zone,
+		ID:          clientID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
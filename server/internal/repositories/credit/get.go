@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetStatus(ctx context.Context, clientID int64) (*domain.CreditStatus, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetClientCreditStatus(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return &domain.CreditStatus{
+		ClientID:    row.ID,
+		StateID:     int(row.StateID),
+		Balance:     row.Balance,
+		ActiveSales: row.ActiveSales,
+		Limits: domain.CreditLimits{
+			MaxBalance:     utils.ParseToFloat64Ptr(row.MaxBalance),
+			MaxActiveSales: utils.ParseToInt64Ptr(row.MaxActiveSales),
+		},
+	}, nil
+}
+
+func (r *Repository) GetPolicies(ctx context.Context) ([]*domain.CreditPolicy, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetCreditPolicies(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	policies := make([]*domain.CreditPolicy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, toPolicy(sqlc.GetCreditPolicyRow(row)))
+	}
+	return policies, nil
+}
+
+func (r *Repository) GetPolicy(ctx context.Context, stateID int) (*domain.CreditPolicy, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetCreditPolicy(ctx, int64(stateID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, manageError(err)
+	}
+	return toPolicy(row), nil
+}
+
+func (r *Repository) GetOverrides(ctx context.Context, clientID int64, limit, offset int) ([]*domain.CreditOverride, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetCreditOverrides(ctx, sqlc.GetCreditOverridesParams{
+		ClientID: clientID,
+		Limit:    int64(limit),
+		Offset:   int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	overrides := make([]*domain.CreditOverride, 0, len(rows))
+	for _, row := range rows {
+		override := toOverride(sqlc.CreditOverride{
+			ID:         row.ID,
+			SaleID:     row.SaleID,
+			ClientID:   row.ClientID,
+			UserID:     row.UserID,
+			UserName:   row.UserName,
+			Reason:     row.Reason,
+			Violations: row.Violations,
+			Amount:     row.Amount,
+			CreatedAt:  row.CreatedAt,
+		})
+		override.ClientName = row.ClientName
+		override.ClientLastname = row.ClientLastname
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
+
+func toPolicy(row sqlc.GetCreditPolicyRow) *domain.CreditPolicy {
+	return &domain.CreditPolicy{
+		StateID:          int(row.StateID),
+		StateDescription: row.StateDescription,
+		AllowSales:       row.AllowSales,
+		MaxBalance:       utils.ParseToFloat64Ptr(row.MaxBalance),
+		MaxActiveSales:   utils.ParseToInt64Ptr(row.MaxActiveSales),
+		UpdatedAt:        row.UpdatedAt,
+	}
+}
+
+func toOverride(row sqlc.CreditOverride) *domain.CreditOverride {
+	return &domain.CreditOverride{
+		ID:         row.ID,
+		SaleID:     utils.ParseToInt64Ptr(row.SaleID),
+		ClientID:   row.ClientID,
+		UserID:     utils.ParseToInt64Ptr(row.UserID),
+		UserName:   row.UserName,
+		Reason:     row.Reason,
+		Violations: strings.Split(row.Violations, violationsSeparator),
+		Amount:     row.Amount,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.CreditRepository
+// at compile time
+var _ ports.CreditRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+// Las reglas salteadas de una autorización se guardan una por línea
+const violationsSeparator = "\n"
+
+func manageError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return domain.ErrTimeout
+	case strings.Contains(err.Error(), "FOREIGN KEY constraint failed"):
+		return domain.ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) UpdateClientLimits(ctx context.Context, clientID int64, limits domain.CreditLimits) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.UpdateClientCreditLimits(ctx, sqlc.UpdateClientCreditLimitsParams{
+		ID:             clientID,
+		MaxBalance:     utils.ParseToSqlNullFloat64Ptr(limits.MaxBalance),
+		MaxActiveSales: utils.ParseToSqlNullInt64(limits.MaxActiveSales),
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if rows == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) SavePolicy(ctx context.Context, policy *domain.CreditPolicy) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpsertCreditPolicy(ctx, sqlc.UpsertCreditPolicyParams{
+		StateID:        int64(policy.StateID),
+		AllowSales:     policy.AllowSales,
+		MaxBalance:     utils.ParseToSqlNullFloat64Ptr(policy.MaxBalance),
+		MaxActiveSales: utils.ParseToSqlNullInt64(policy.MaxActiveSales),
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	return nil
+}
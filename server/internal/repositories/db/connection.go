@@ -5,10 +5,14 @@ import (
 	"log"
 
 	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/benitez96/gostore/internal/config"
 )
 
-func Connect() (*sql.DB, error) {
-	db, err := sql.Open("sqlite3", "../../data/database.db?_foreign_keys=on")
+func Connect(cfg config.DatabaseConfig) (*sql.DB, error) {
+	// _txlock=immediate: las transacciones toman el lock de escritura al empezar, así las que
+	// leen y después escriben (p. ej. el control de crédito de una venta) no se pisan entre sí
+	db, err := sql.Open("sqlite3", cfg.Path+"?_foreign_keys=on&_txlock=immediate")
 	if err != nil {
 		log.Fatal("Error al conectar a la base de datos:", err)
 		return nil, err
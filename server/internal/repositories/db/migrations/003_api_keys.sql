@@ -0,0 +1,24 @@
+-- +goose Up
+-- API keys para integraciones de máquinas (planillas, kioscos, scripts)
+-- La key en texto plano nunca se guarda: solo su prefijo (para buscarla) y su hash SHA-256
+CREATE TABLE api_keys (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(20) UNIQUE NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    permissions INTEGER NOT NULL DEFAULT 0, -- Mismo bitmask que users.permissions
+    expires_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_by INT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_api_keys_prefix ON api_keys(key_prefix);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_api_keys_prefix;
+
+DROP TABLE api_keys;
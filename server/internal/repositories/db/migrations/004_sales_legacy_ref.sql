@@ -0,0 +1,10 @@
+-- +goose Up
+-- Identificador de la venta en el sistema anterior (planillas), para que reimportar el mismo archivo no duplique ventas
+ALTER TABLE sales ADD COLUMN legacy_ref VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_sales_legacy_ref ON sales(legacy_ref) WHERE legacy_ref IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_sales_legacy_ref;
+
+ALTER TABLE sales DROP COLUMN legacy_ref;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Los clientes pueden pedir no recibir recordatorios
+ALTER TABLE clients ADD COLUMN reminders_opt_out BOOLEAN NOT NULL DEFAULT false;
+
+-- Outbox de recordatorios: cada fila es un mensaje a enviar y, una vez enviado, el historial de la cuota.
+-- offset_days es negativo para "vence en N días" y positivo para "N días de atraso";
+-- la restricción UNIQUE evita mandar dos veces el mismo recordatorio por el mismo canal.
+CREATE TABLE reminders (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    quota_id INT NOT NULL,
+    client_id INT NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    offset_days INT NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, sent, failed, cancelled
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (quota_id, channel, offset_days),
+    FOREIGN KEY (quota_id) REFERENCES quotas(id) ON DELETE CASCADE,
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_reminders_status_next_attempt ON reminders(status, next_attempt_at);
+CREATE INDEX idx_reminders_quota_id ON reminders(quota_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reminders_quota_id;
+DROP INDEX IF EXISTS idx_reminders_status_next_attempt;
+
+DROP TABLE reminders;
+
+ALTER TABLE clients DROP COLUMN reminders_opt_out;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- Envíos por email del comprobante de pago. Cada fila es un envío (automático al
+-- registrar el pago o pedido a mano) y queda como historial del pago.
+CREATE TABLE receipt_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    payment_id INT NOT NULL,
+    client_id INT NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, sent, failed
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (payment_id) REFERENCES payments(id) ON DELETE CASCADE,
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_receipt_deliveries_status_next_attempt ON receipt_deliveries(status, next_attempt_at);
+CREATE INDEX idx_receipt_deliveries_payment_id ON receipt_deliveries(payment_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_receipt_deliveries_payment_id;
+DROP INDEX IF EXISTS idx_receipt_deliveries_status_next_attempt;
+
+DROP TABLE receipt_deliveries;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Datos del comercio que se imprimen en comprobantes, fichas y demás PDFs.
+-- Hay una sola fila (id = 1); el logo se guarda en la base para no depender del disco.
+CREATE TABLE business_settings (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    legal_name VARCHAR(150) NOT NULL DEFAULT '',
+    cuit VARCHAR(13) NOT NULL DEFAULT '',
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(50) NOT NULL DEFAULT '',
+    footer TEXT NOT NULL DEFAULT '',
+    logo BLOB,
+    logo_mime VARCHAR(50),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Mismo nombre que tenían fijo los templates
+INSERT INTO business_settings (id, name) VALUES (1, 'ELECTRODOMESTICOS');
+
+-- +goose Down
+DROP TABLE business_settings;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Ticketera térmica: ancho del papel (58 u 80 mm) y dirección de red (host:puerto)
+ALTER TABLE business_settings ADD COLUMN ticket_width INTEGER NOT NULL DEFAULT 80;
+ALTER TABLE business_settings ADD COLUMN ticket_printer VARCHAR(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE business_settings DROP COLUMN ticket_printer;
+ALTER TABLE business_settings DROP COLUMN ticket_width;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Condiciones que se imprimen en los contratos de venta; vacío usa las condiciones por defecto
+ALTER TABLE business_settings ADD COLUMN contract_terms TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE business_settings DROP COLUMN contract_terms;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Los cobradores son usuarios con el permiso de cobrador; cobran una comisión sobre lo cobrado
+ALTER TABLE users ADD COLUMN commission_rate REAL NOT NULL DEFAULT 0; -- Porcentaje, p. ej. 5 = 5%
+
+-- Cada cliente puede tener asignado un cobrador y una zona para armar la hoja de ruta
+ALTER TABLE clients ADD COLUMN collector_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE clients ADD COLUMN zone VARCHAR(100) NOT NULL DEFAULT '';
+
+-- Cobrador que registró el pago, para los totales y la comisión
+ALTER TABLE payments ADD COLUMN collector_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_clients_collector_id ON clients(collector_id);
+CREATE INDEX idx_payments_collector_id_date ON payments(collector_id, date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_payments_collector_id_date;
+DROP INDEX IF EXISTS idx_clients_collector_id;
+
+ALTER TABLE payments DROP COLUMN collector_id;
+ALTER TABLE clients DROP COLUMN zone;
+ALTER TABLE clients DROP COLUMN collector_id;
+ALTER TABLE users DROP COLUMN commission_rate;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Garantes de las ventas: una misma persona puede garantizar varias ventas
+CREATE TABLE guarantors (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    lastname VARCHAR(255) NOT NULL,
+    dni VARCHAR(20) NOT NULL UNIQUE,
+    phone VARCHAR(50) NOT NULL DEFAULT '',
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    relationship VARCHAR(100) NOT NULL DEFAULT '', -- Vínculo con el cliente (familiar, empleador, vecino...)
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE sale_guarantors (
+    sale_id INT NOT NULL,
+    guarantor_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (sale_id, guarantor_id),
+    FOREIGN KEY (sale_id) REFERENCES sales(id) ON DELETE CASCADE,
+    FOREIGN KEY (guarantor_id) REFERENCES guarantors(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sale_guarantors_guarantor_id ON sale_guarantors(guarantor_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_sale_guarantors_guarantor_id;
+
+DROP TABLE sale_guarantors;
+DROP TABLE guarantors;
@@ -0,0 +1,45 @@
+-- +goose Up
+-- Límites de crédito por cliente; NULL usa el de la política de su estado
+ALTER TABLE clients ADD COLUMN max_balance REAL;
+ALTER TABLE clients ADD COLUMN max_active_sales INTEGER;
+
+-- Política de crédito por estado del cliente: si puede comprar financiado y los límites por defecto
+CREATE TABLE credit_policies (
+    state_id INTEGER PRIMARY KEY,
+    allow_sales BOOLEAN NOT NULL DEFAULT true,
+    max_balance REAL,
+    max_active_sales INTEGER,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (state_id) REFERENCES states(id) ON DELETE CASCADE
+);
+
+INSERT INTO credit_policies (state_id, allow_sales) VALUES
+  (1, true),
+  (2, true),
+  (3, false);
+
+-- Ventas autorizadas por un encargado saltando las reglas de crédito
+CREATE TABLE credit_overrides (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    sale_id INTEGER REFERENCES sales(id) ON DELETE SET NULL,
+    client_id INTEGER NOT NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    user_name VARCHAR(255) NOT NULL,
+    reason TEXT NOT NULL,
+    violations TEXT NOT NULL, -- Reglas salteadas, una por línea
+    amount REAL NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_credit_overrides_client_id ON credit_overrides(client_id);
+CREATE INDEX idx_credit_overrides_created_at ON credit_overrides(created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_credit_overrides_created_at;
+DROP INDEX IF EXISTS idx_credit_overrides_client_id;
+DROP TABLE IF EXISTS credit_overrides;
+DROP TABLE IF EXISTS credit_policies;
+
+ALTER TABLE clients DROP COLUMN max_active_sales;
+ALTER TABLE clients DROP COLUMN max_balance;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Puntaje de riesgo del cliente (0 a 100, más alto es más riesgoso); lo recalcula el worker de estados
+ALTER TABLE clients ADD COLUMN risk_score INTEGER NOT NULL DEFAULT 0;
+
+-- Veces que el cliente pasó a Warning o a Suspended, para el puntaje
+ALTER TABLE clients ADD COLUMN warning_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clients ADD COLUMN suspended_count INTEGER NOT NULL DEFAULT 0;
+
+-- Sin historial previo se cuenta el estado actual
+UPDATE clients SET warning_count = 1 WHERE state_id = 2;
+UPDATE clients SET suspended_count = 1 WHERE state_id = 3;
+
+CREATE INDEX idx_clients_risk_score ON clients(risk_score);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_clients_risk_score;
+
+ALTER TABLE clients DROP COLUMN suspended_count;
+ALTER TABLE clients DROP COLUMN warning_count;
+ALTER TABLE clients DROP COLUMN risk_score;
@@ -0,0 +1,44 @@
+-- +goose Up
+-- Registro de gestiones de cobranza con cada cliente: llamadas, visitas y mensajes
+CREATE TABLE client_interactions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    client_id INTEGER NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('call', 'visit', 'whatsapp')),
+    outcome VARCHAR(30) NOT NULL, -- Resultado de la gestión (contactado, no atiende, promesa de pago...)
+    notes TEXT NOT NULL DEFAULT '',
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    author VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_client_interactions_client_id ON client_interactions(client_id, created_at);
+
+-- Promesas de pago: se cumplen cuando los pagos registrados después de la promesa
+-- y fechados hasta el día prometido alcanzan el monto, y se rompen si vence el día sin cubrirlo
+CREATE TABLE payment_promises (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    client_id INTEGER NOT NULL,
+    interaction_id INTEGER REFERENCES client_interactions(id) ON DELETE SET NULL,
+    promised_date TIMESTAMP NOT NULL,
+    amount REAL NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'kept', 'broken')),
+    paid_amount REAL NOT NULL DEFAULT 0, -- Pagado hasta ahora a cuenta de la promesa
+    resolved_at TIMESTAMP,
+    notes TEXT NOT NULL DEFAULT '',
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    author VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES clients(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_payment_promises_client_id ON payment_promises(client_id);
+CREATE INDEX idx_payment_promises_status_date ON payment_promises(status, promised_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_payment_promises_status_date;
+DROP INDEX IF EXISTS idx_payment_promises_client_id;
+DROP INDEX IF EXISTS idx_client_interactions_client_id;
+
+DROP TABLE payment_promises;
+DROP TABLE client_interactions;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Archivos adjuntos de clientes, ventas y pagos (DNI, contratos firmados, comprobantes de transferencia).
+-- El contenido vive en el almacenamiento de archivos; acá quedan los datos y las claves para encontrarlo.
+-- Al no haber clave foránea hacia el dueño, los adjuntos huérfanos se borran al borrar clientes, ventas o pagos.
+CREATE TABLE attachments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    owner_type VARCHAR(10) NOT NULL CHECK (owner_type IN ('client', 'sale', 'payment')),
+    owner_id INTEGER NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size INTEGER NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    thumbnail_key VARCHAR(255), -- Solo imágenes
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    author VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_attachments_owner ON attachments(owner_type, owner_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_attachments_owner;
+
+DROP TABLE attachments;
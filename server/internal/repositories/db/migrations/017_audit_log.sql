@@ -0,0 +1,23 @@
+-- +goose Up
+-- Registro de auditoría: cada request autenticado que modifica datos (todo lo que no es GET),
+-- con quién lo hizo, la ruta y el status de la respuesta. Los datos del actor se copian para que
+-- el registro sobreviva al borrado del usuario o la revocación de la API key.
+CREATE TABLE audit_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id INTEGER NOT NULL,
+    actor_name VARCHAR(255) NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    route VARCHAR(255) NOT NULL, -- Patrón de la ruta, p. ej. /api/clients/:id
+    path VARCHAR(2048) NOT NULL,
+    status INTEGER NOT NULL,
+    request_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_log_actor ON audit_log(actor_type, actor_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_log_actor;
+
+DROP TABLE audit_log;
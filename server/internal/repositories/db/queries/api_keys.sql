@@ -0,0 +1,26 @@
+-- name: InsertAPIKey :one
+INSERT INTO api_keys
+(name, key_prefix, key_hash, permissions, expires_at, created_by)
+VALUES
+(?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetAPIKeys :many
+SELECT * FROM api_keys
+ORDER BY revoked_at IS NOT NULL, created_at DESC;
+
+-- name: GetAPIKeyByID :one
+SELECT * FROM api_keys WHERE id = ?;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT * FROM api_keys WHERE key_prefix = ?;
+
+-- name: RevokeAPIKey :exec
+UPDATE api_keys
+SET revoked_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND revoked_at IS NULL;
+
+-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = ?;
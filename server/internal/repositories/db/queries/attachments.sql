@@ -0,0 +1,30 @@
+-- name: CreateAttachment :one
+INSERT INTO attachments (owner_type, owner_id, file_name, content_type, size, storage_key, thumbnail_key, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetAttachmentByID :one
+SELECT * FROM attachments WHERE id = ?;
+
+-- name: GetAttachmentsByOwner :many
+SELECT * FROM attachments
+WHERE owner_type = ? AND owner_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachments WHERE id = ?;
+
+-- name: GetOrphanAttachments :many
+SELECT a.* FROM attachments a
+WHERE (a.owner_type = 'client' AND NOT EXISTS (SELECT 1 FROM clients c WHERE c.id = a.owner_id))
+   OR (a.owner_type = 'sale' AND NOT EXISTS (SELECT 1 FROM sales s WHERE s.id = a.owner_id))
+   OR (a.owner_type = 'payment' AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.id = a.owner_id));
+
+-- name: ClientExists :one
+SELECT EXISTS (SELECT 1 FROM clients WHERE id = ?);
+
+-- name: SaleExists :one
+SELECT EXISTS (SELECT 1 FROM sales WHERE id = ?);
+
+-- name: PaymentExists :one
+SELECT EXISTS (SELECT 1 FROM payments WHERE id = ?);
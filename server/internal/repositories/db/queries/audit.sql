@@ -0,0 +1,11 @@
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (actor_type, actor_id, actor_name, method, route, path, status, request_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAuditEntries :many
+SELECT id, actor_type, actor_id, actor_name, method, route, path, status, request_id, created_at
+FROM audit_log
+WHERE (CAST(sqlc.arg(actor_type) AS TEXT) = '' OR actor_type = sqlc.arg(actor_type))
+  AND (CAST(sqlc.arg(actor_id) AS INTEGER) = 0 OR actor_id = sqlc.arg(actor_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
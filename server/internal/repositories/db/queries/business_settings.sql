@@ -0,0 +1,12 @@
+-- name: GetBusinessSettings :one
+SELECT * FROM business_settings WHERE id = 1;
+
+-- name: UpdateBusinessSettings :exec
+UPDATE business_settings
+SET name = ?, legal_name = ?, cuit = ?, address = ?, phone = ?, footer = ?, ticket_width = ?, ticket_printer = ?, contract_terms = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = 1;
+
+-- name: UpdateBusinessLogo :exec
+UPDATE business_settings
+SET logo = ?, logo_mime = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = 1;
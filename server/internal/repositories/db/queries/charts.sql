@@ -94,4 +94,15 @@ SELECT COUNT(*) FROM quotas WHERE strftime('%Y-%m', due_date) = strftime('%Y-%m'
 SELECT COUNT(*) FROM quotas WHERE is_paid = 1 AND strftime('%Y-%m', due_date) = strftime('%Y-%m', date('now', '-1 month'));
 
 -- name: GetCountQuotasDueLastMonth :one
-SELECT COUNT(*) FROM quotas WHERE strftime('%Y-%m', due_date) = strftime('%Y-%m', date('now', '-1 month')); 
\ No newline at end of file
+SELECT COUNT(*) FROM quotas WHERE strftime('%Y-%m', due_date) = strftime('%Y-%m', date('now', '-1 month')); 
+-- name: GetOverdueQuotasSummary :one
+SELECT
+    COUNT(q.id) AS overdue_count,
+    CAST(IFNULL(SUM(q.amount - IFNULL((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0)), 0) AS REAL) AS overdue_amount
+FROM quotas q
+WHERE q.is_paid = 0 AND date(q.due_date) < date('now', 'localtime');
+
+-- name: GetCollectedToday :one
+SELECT CAST(IFNULL(SUM(amount), 0) AS REAL) AS collected
+FROM payments
+WHERE strftime('%Y-%m-%d', date, 'localtime') = strftime('%Y-%m-%d', 'now', 'localtime');
@@ -5,14 +5,17 @@ SELECT
   lastname, 
   dni, 
   s.description AS stateDescription, 
-  s.id AS stateId
+  s.id AS stateId,
+  c.risk_score,
+  -- Orden por riesgo: 1 de mayor a menor, -1 de menor a mayor, 0 solo por nombre
+  CAST(? AS INTEGER) * c.risk_score AS risk_order
 FROM clients c
   INNER JOIN states s ON c.state_id = s.id
 WHERE (name LIKE ?
    OR lastname LIKE ?
    OR dni LIKE ?)
   AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (sqlc.slice('state_ids')) END)
-ORDER BY lastname ASC, name ASC
+ORDER BY risk_order DESC, lastname ASC, name ASC
 LIMIT ? OFFSET ?;
 
 -- name: CountClients :one
@@ -39,6 +42,10 @@ SELECT
   c.email, 
   c.phone, 
   c.address,
+  c.reminders_opt_out,
+  c.collector_id,
+  c.zone,
+  c.risk_score,
   c.state_id, 
   s.id AS state_id, 
   s.description AS state_description,
@@ -49,10 +56,21 @@ INNER JOIN states s ON c.state_id = s.id
 WHERE c.id = ?;
 
 -- name: UpdateClientState :exec
-UPDATE clients SET state_id = ? WHERE id = ?;
+-- Cuenta las veces que el cliente pasa a Warning o a Suspended para el puntaje de riesgo
+UPDATE clients
+SET state_id = sqlc.arg(state_id),
+  warning_count = warning_count + (CASE WHEN state_id != 2 AND sqlc.arg(state_id) = 2 THEN 1 ELSE 0 END),
+  suspended_count = suspended_count + (CASE WHEN state_id != 3 AND sqlc.arg(state_id) = 3 THEN 1 ELSE 0 END)
+WHERE id = sqlc.arg(id);
 
 -- name: DeleteClient :exec
 DELETE FROM clients WHERE id = ?;
 
 -- name: UpdateClient :exec
 UPDATE clients SET name = ?, lastname = ?, dni = ?, email = ?, phone = ?, address = ? WHERE id = ?;
+
+-- name: GetAllClientDNIs :many
+SELECT dni FROM clients;
+
+-- name: GetClientIDsByDNI :many
+SELECT id, dni FROM clients;
@@ -0,0 +1,97 @@
+-- name: GetCollectors :many
+SELECT
+  u.id,
+  u.username,
+  u.first_name,
+  u.last_name,
+  u.is_active,
+  u.commission_rate,
+  (SELECT COUNT(c.id) FROM clients c WHERE c.collector_id = u.id) AS clients_count
+FROM users u
+WHERE (u.permissions & sqlc.arg(permission)) != 0
+ORDER BY u.last_name ASC, u.first_name ASC;
+
+-- name: GetCollectorByID :one
+SELECT
+  u.id,
+  u.username,
+  u.first_name,
+  u.last_name,
+  u.is_active,
+  u.commission_rate,
+  (SELECT COUNT(c.id) FROM clients c WHERE c.collector_id = u.id) AS clients_count
+FROM users u
+WHERE u.id = sqlc.arg(id) AND (u.permissions & sqlc.arg(permission)) != 0;
+
+-- name: UpdateCollectorCommission :execrows
+UPDATE users SET commission_rate = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: AssignClientCollector :execrows
+UPDATE clients SET collector_id = ?, zone = ? WHERE id = ?;
+
+-- name: GetCollectorClients :many
+SELECT
+  c.id,
+  c.name,
+  c.lastname,
+  c.dni,
+  c.phone,
+  c.address,
+  c.zone,
+  s.id AS state_id,
+  s.description AS state_description
+FROM clients c
+  INNER JOIN states s ON c.state_id = s.id
+WHERE c.collector_id = sqlc.arg(collector_id)
+  AND (CAST(sqlc.arg(zone) AS TEXT) = '' OR c.zone = CAST(sqlc.arg(zone) AS TEXT))
+ORDER BY c.zone ASC, c.lastname ASC, c.name ASC;
+
+-- name: GetCollectorRouteQuotas :many
+SELECT
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.dni,
+  c.phone,
+  c.address,
+  c.zone,
+  c.state_id,
+  q.id AS quota_id,
+  q.number AS quota_number,
+  q.amount,
+  q.due_date,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  s.id AS sale_id,
+  s.description AS sale_description
+FROM quotas q
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE c.collector_id = sqlc.arg(collector_id)
+  AND q.is_paid = false
+  AND q.due_date < sqlc.arg(before)
+ORDER BY c.zone ASC, c.lastname ASC, c.name ASC, q.due_date ASC;
+
+-- name: GetCollectorPaymentTotals :one
+SELECT
+  COUNT(p.id) AS payments_count,
+  COUNT(DISTINCT p.client_id) AS clients_count,
+  CAST(COALESCE(SUM(p.amount), 0) AS REAL) AS total
+FROM payments p
+WHERE p.collector_id = sqlc.arg(collector_id)
+  AND p.date >= sqlc.arg(from_date)
+  AND p.date < sqlc.arg(to_date);
+
+-- name: GetQuotaCollectorID :one
+SELECT c.collector_id
+FROM quotas q
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE q.id = ?;
+
+-- name: GetCollectorPendingSaleIDs :many
+SELECT s.id
+FROM sales s
+  INNER JOIN clients c ON s.client_id = c.id
+WHERE s.is_paid = 0
+  AND (CAST(sqlc.arg(collector_id) AS INTEGER) = 0 OR c.collector_id = sqlc.arg(collector_id))
+  AND (CAST(sqlc.arg(zone) AS TEXT) = '' OR c.zone = CAST(sqlc.arg(zone) AS TEXT))
+ORDER BY c.lastname ASC, c.name ASC, s.id ASC;
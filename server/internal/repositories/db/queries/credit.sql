@@ -0,0 +1,53 @@
+-- name: GetClientCreditStatus :one
+SELECT
+  c.id,
+  c.state_id,
+  c.max_balance,
+  c.max_active_sales,
+  CAST(COALESCE((
+    SELECT SUM(q.amount - COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0))
+    FROM quotas q
+    WHERE q.client_id = c.id AND q.is_paid = false
+  ), 0) AS REAL) AS balance,
+  (SELECT COUNT(*) FROM sales s WHERE s.client_id = c.id AND s.is_paid = false) AS active_sales
+FROM clients c
+WHERE c.id = ?;
+
+-- name: UpdateClientCreditLimits :execrows
+UPDATE clients
+SET max_balance = sqlc.arg(max_balance), max_active_sales = sqlc.arg(max_active_sales), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id);
+
+-- name: GetCreditPolicies :many
+SELECT p.*, s.description AS state_description
+FROM credit_policies p
+  INNER JOIN states s ON s.id = p.state_id
+ORDER BY p.state_id ASC;
+
+-- name: GetCreditPolicy :one
+SELECT p.*, s.description AS state_description
+FROM credit_policies p
+  INNER JOIN states s ON s.id = p.state_id
+WHERE p.state_id = ?;
+
+-- name: UpsertCreditPolicy :exec
+INSERT INTO credit_policies (state_id, allow_sales, max_balance, max_active_sales)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (state_id) DO UPDATE SET
+  allow_sales = excluded.allow_sales,
+  max_balance = excluded.max_balance,
+  max_active_sales = excluded.max_active_sales,
+  updated_at = CURRENT_TIMESTAMP;
+
+-- name: CreateCreditOverride :one
+INSERT INTO credit_overrides (sale_id, client_id, user_id, user_name, reason, violations, amount)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetCreditOverrides :many
+SELECT o.*, c.name AS client_name, c.lastname AS client_lastname
+FROM credit_overrides o
+  INNER JOIN clients c ON c.id = o.client_id
+WHERE (CAST(sqlc.arg(client_id) AS INTEGER) = 0 OR o.client_id = sqlc.arg(client_id))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
@@ -0,0 +1,89 @@
+-- name: ExportClients :many
+SELECT
+  c.id,
+  c.name,
+  c.lastname,
+  c.dni,
+  c.email,
+  c.phone,
+  c.address,
+  s.description AS state_description,
+  c.created_at
+FROM clients c
+  INNER JOIN states s ON c.state_id = s.id
+WHERE c.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (sqlc.slice('state_ids')) END)
+ORDER BY c.id ASC
+LIMIT ?;
+
+-- name: ExportSales :many
+SELECT
+  s.id,
+  s.date,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  CAST(COALESCE((
+    SELECT GROUP_CONCAT(sp.name || ' x' || sp.quantity, ', ')
+    FROM sale_products sp WHERE sp.sale_id = s.id
+  ), '') AS TEXT) AS products,
+  s.amount,
+  CAST(COALESCE((
+    SELECT SUM(p.amount) FROM payments p
+    INNER JOIN quotas q ON p.quota_id = q.id
+    WHERE q.sale_id = s.id
+  ), 0) AS REAL) AS paid_amount,
+  (SELECT COUNT(*) FROM quotas q WHERE q.sale_id = s.id) AS quota_count,
+  s.is_paid,
+  st.description AS state_description
+FROM sales s
+  INNER JOIN clients c ON s.client_id = c.id
+  INNER JOIN states st ON s.state_id = st.id
+WHERE s.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (sqlc.slice('state_ids')) END)
+ORDER BY s.id ASC
+LIMIT ?;
+
+-- name: ExportQuotas :many
+SELECT
+  q.id,
+  q.sale_id,
+  q.number,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  q.amount,
+  q.due_date,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  q.is_paid,
+  st.description AS state_description
+FROM quotas q
+  INNER JOIN clients c ON q.client_id = c.id
+  INNER JOIN states st ON q.state_id = st.id
+WHERE q.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (sqlc.slice('state_ids')) END)
+ORDER BY q.id ASC
+LIMIT ?;
+
+-- name: ExportPayments :many
+SELECT
+  p.id,
+  p.date,
+  p.amount,
+  q.sale_id,
+  q.number AS quota_number,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname
+FROM payments p
+  INNER JOIN quotas q ON p.quota_id = q.id
+  INNER JOIN clients c ON p.client_id = c.id
+WHERE p.id > ?
+  AND p.date >= ? AND p.date < ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (sqlc.slice('state_ids')) END)
+ORDER BY p.id ASC
+LIMIT ?;
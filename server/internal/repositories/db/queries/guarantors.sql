@@ -0,0 +1,60 @@
+-- name: CreateGuarantor :one
+INSERT INTO guarantors (name, lastname, dni, phone, address, relationship)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdateGuarantor :execrows
+UPDATE guarantors
+SET name = ?, lastname = ?, dni = ?, phone = ?, address = ?, relationship = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: DeleteGuarantor :execrows
+DELETE FROM guarantors WHERE id = ?;
+
+-- name: GetGuarantorByID :one
+SELECT * FROM guarantors WHERE id = ?;
+
+-- name: GetGuarantors :many
+SELECT * FROM guarantors
+WHERE name LIKE ? OR lastname LIKE ? OR dni LIKE ?
+ORDER BY lastname ASC, name ASC
+LIMIT ? OFFSET ?;
+
+-- name: GetGuarantorSaleIDs :many
+SELECT sale_id FROM sale_guarantors WHERE guarantor_id = ? ORDER BY sale_id ASC;
+
+-- name: GetGuarantorsBySaleIDs :many
+SELECT sg.sale_id, g.*
+FROM sale_guarantors sg
+  INNER JOIN guarantors g ON sg.guarantor_id = g.id
+WHERE sg.sale_id IN (sqlc.slice('sale_ids'))
+ORDER BY sg.sale_id ASC, sg.created_at ASC, g.id ASC;
+
+-- name: LinkSaleGuarantor :exec
+INSERT INTO sale_guarantors (sale_id, guarantor_id) VALUES (?, ?)
+ON CONFLICT (sale_id, guarantor_id) DO NOTHING;
+
+-- name: UnlinkSaleGuarantor :execrows
+DELETE FROM sale_guarantors WHERE sale_id = ? AND guarantor_id = ?;
+
+-- name: GetGuarantorFollowUps :many
+SELECT
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.dni AS client_dni,
+  c.phone AS client_phone,
+  c.address AS client_address,
+  s.id AS sale_id,
+  s.description AS sale_description,
+  COUNT(q.id) AS overdue_quotas,
+  CAST(SUM(q.amount - COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0)) AS REAL) AS overdue_amount
+FROM clients c
+  INNER JOIN sales s ON s.client_id = c.id
+  INNER JOIN quotas q ON q.sale_id = s.id
+WHERE c.state_id = sqlc.arg(state_id)
+  AND q.is_paid = false
+  AND q.due_date < sqlc.arg(before)
+  AND EXISTS (SELECT 1 FROM sale_guarantors sg WHERE sg.sale_id = s.id)
+GROUP BY c.id, s.id
+ORDER BY c.lastname ASC, c.name ASC, s.id ASC;
@@ -0,0 +1,88 @@
+-- name: CreateClientInteraction :one
+INSERT INTO client_interactions (client_id, type, outcome, notes, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetClientInteractions :many
+SELECT * FROM client_interactions
+WHERE client_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CreatePaymentPromise :one
+INSERT INTO payment_promises (client_id, interaction_id, promised_date, amount, notes, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetClientPaymentPromises :many
+SELECT * FROM payment_promises
+WHERE client_id = ?
+ORDER BY promised_date DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: GetPaymentPromisesToResolve :many
+SELECT
+  pp.id,
+  pp.client_id,
+  pp.amount,
+  pp.promised_date,
+  pp.status,
+  pp.paid_amount,
+  pp.created_at
+FROM payment_promises pp
+WHERE pp.client_id IN (
+  SELECT pending.client_id FROM payment_promises pending
+  WHERE pending.status = 'pending'
+    AND CAST(sqlc.arg(client_id) AS INTEGER) = 0
+)
+  OR pp.client_id = sqlc.arg(client_id)
+ORDER BY pp.client_id, pp.promised_date, pp.id;
+
+-- name: GetPaymentsForPromises :many
+SELECT
+  p.id,
+  p.client_id,
+  p.amount,
+  p.date,
+  p.created_at
+FROM payments p
+WHERE (
+  p.client_id IN (
+    SELECT pending.client_id FROM payment_promises pending
+    WHERE pending.status = 'pending'
+      AND CAST(sqlc.arg(client_id) AS INTEGER) = 0
+  )
+  OR p.client_id = sqlc.arg(client_id)
+)
+  AND p.created_at >= (SELECT MIN(earliest.created_at) FROM payment_promises earliest WHERE earliest.client_id = p.client_id)
+ORDER BY p.client_id, p.date, p.id;
+
+-- name: UpdatePaymentPromiseStatus :exec
+UPDATE payment_promises
+SET status = ?, paid_amount = ?, resolved_at = ?
+WHERE id = ?;
+
+-- name: GetPaymentPromisesDue :many
+SELECT
+  pp.id,
+  pp.client_id,
+  pp.interaction_id,
+  pp.promised_date,
+  pp.amount,
+  pp.status,
+  pp.paid_amount,
+  pp.resolved_at,
+  pp.notes,
+  pp.user_id,
+  pp.author,
+  pp.created_at,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.phone AS client_phone,
+  c.address AS client_address
+FROM payment_promises pp
+  INNER JOIN clients c ON c.id = pp.client_id
+WHERE pp.status = 'pending'
+  AND pp.promised_date >= sqlc.arg(from_date)
+  AND pp.promised_date < sqlc.arg(to_date)
+ORDER BY c.lastname ASC, c.name ASC, pp.id ASC;
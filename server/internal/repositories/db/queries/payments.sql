@@ -5,8 +5,8 @@ SELECT * FROM payments WHERE quota_id = ?;
 SELECT * FROM payments WHERE id = ?;
 
 -- name: CreatePayment :one
-INSERT INTO payments (amount, date, quota_id, client_id) 
-VALUES (?, ?, ?, ?) 
+INSERT INTO payments (amount, date, quota_id, client_id, collector_id) 
+VALUES (?, ?, ?, ?, ?) 
 RETURNING *;
 
 -- name: DeletePayment :exec
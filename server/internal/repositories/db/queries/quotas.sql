@@ -38,4 +38,14 @@ INNER JOIN sales s ON c.id = s.client_id
 WHERE s.is_paid = 0;
 
 -- name: UpdateClientStateBulk :exec
-UPDATE clients SET state_id = ? WHERE id = ?;
+-- Cuenta las veces que el cliente pasa a Warning o a Suspended para el puntaje de riesgo
+UPDATE clients
+SET state_id = sqlc.arg(state_id),
+  warning_count = warning_count + (CASE WHEN state_id != 2 AND sqlc.arg(state_id) = 2 THEN 1 ELSE 0 END),
+  suspended_count = suspended_count + (CASE WHEN state_id != 3 AND sqlc.arg(state_id) = 3 THEN 1 ELSE 0 END)
+WHERE id = sqlc.arg(id);
+
+-- name: CreateImportedQuota :one
+INSERT INTO quotas (number, amount, due_date, is_paid, state_id, sale_id, client_id)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id;
@@ -0,0 +1,48 @@
+-- name: GetReceiptTarget :one
+SELECT
+  p.id AS payment_id,
+  p.amount,
+  p.date,
+  q.number AS quota_number,
+  s.description AS sale_description,
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.email
+FROM payments p
+  INNER JOIN quotas q ON p.quota_id = q.id
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON p.client_id = c.id
+WHERE p.id = ?;
+
+-- name: CreateReceiptDelivery :one
+INSERT INTO receipt_deliveries (payment_id, client_id, recipient, next_attempt_at)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetDueReceiptDeliveries :many
+SELECT * FROM receipt_deliveries
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY next_attempt_at ASC
+LIMIT ?;
+
+-- name: MarkReceiptDeliverySent :exec
+UPDATE receipt_deliveries
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: UpdateReceiptDeliveryAttempt :exec
+UPDATE receipt_deliveries
+SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetReceiptDeliveryByID :one
+SELECT * FROM receipt_deliveries WHERE id = ?;
+
+-- name: GetReceiptDeliveriesByPaymentID :many
+SELECT * FROM receipt_deliveries WHERE payment_id = ? ORDER BY id DESC;
+
+-- name: RetryReceiptDelivery :execrows
+UPDATE receipt_deliveries
+SET status = 'pending', next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'failed';
@@ -0,0 +1,61 @@
+-- name: GetReminderTarget :one
+SELECT
+  q.id AS quota_id,
+  q.number AS quota_number,
+  q.amount,
+  q.due_date,
+  q.is_paid,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  s.id AS sale_id,
+  s.description AS sale_description,
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.email,
+  c.phone,
+  c.reminders_opt_out
+FROM quotas q
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE q.id = ?;
+
+-- name: EnqueueReminder :execrows
+INSERT INTO reminders (quota_id, client_id, channel, offset_days, recipient, subject, body, next_attempt_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (quota_id, channel, offset_days) DO NOTHING;
+
+-- name: GetDueReminders :many
+SELECT * FROM reminders
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY next_attempt_at ASC
+LIMIT ?;
+
+-- name: MarkReminderSent :exec
+UPDATE reminders
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: UpdateReminderAttempt :exec
+UPDATE reminders
+SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetReminderByID :one
+SELECT * FROM reminders WHERE id = ?;
+
+-- name: GetRemindersByQuotaID :many
+SELECT * FROM reminders WHERE quota_id = ? ORDER BY id DESC;
+
+-- name: GetReminders :many
+SELECT * FROM reminders
+WHERE (CASE WHEN ? = '' THEN 1 ELSE status = ? END)
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: RetryReminder :execrows
+UPDATE reminders
+SET status = 'pending', next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'failed';
+
+-- name: UpdateClientRemindersOptOut :execrows
+UPDATE clients SET reminders_opt_out = ? WHERE id = ?;
@@ -0,0 +1,16 @@
+-- name: GetClientsRiskCounters :many
+SELECT id, warning_count, suspended_count, risk_score
+FROM clients;
+
+-- name: GetQuotasForRiskScore :many
+SELECT
+  q.client_id,
+  q.amount,
+  q.due_date,
+  q.is_paid,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  CAST(COALESCE((SELECT MAX(julianday(p.date)) FROM payments p WHERE p.quota_id = q.id) - julianday(q.due_date), 0) AS REAL) AS days_late_paid
+FROM quotas q;
+
+-- name: UpdateClientRiskScore :exec
+UPDATE clients SET risk_score = ? WHERE id = ?;
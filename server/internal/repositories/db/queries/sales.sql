@@ -35,3 +35,11 @@ FROM sales s
 INNER JOIN clients c ON s.client_id = c.id
 WHERE s.is_paid = 0
 ORDER BY c.lastname ASC, c.name ASC, s.id ASC;
+
+-- name: CreateImportedSale :one
+INSERT INTO sales (description, amount, is_paid, client_id, date, legacy_ref)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id;
+
+-- name: GetSaleLegacyRefs :many
+SELECT legacy_ref FROM sales WHERE legacy_ref IS NOT NULL;
@@ -0,0 +1,55 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, events, description, active)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions ORDER BY id;
+
+-- name: GetActiveWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions WHERE active = true ORDER BY id;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT * FROM webhook_subscriptions WHERE id = ?;
+
+-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET url = ?, secret = ?, events = ?, description = ?, active = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions WHERE id = ?;
+
+-- name: EnqueueWebhookDelivery :one
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- Las entregas de suscripciones desactivadas quedan pendientes hasta que se reactiven
+-- name: GetDueWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE status = 'pending' AND next_attempt_at <= ?
+  AND subscription_id IN (SELECT id FROM webhook_subscriptions WHERE active = true)
+ORDER BY next_attempt_at ASC
+LIMIT ?;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, response_status = ?, response_body = ?, last_error = NULL,
+    delivered_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = ?, attempts = ?, response_status = ?, response_body = ?, last_error = ?, next_attempt_at = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries WHERE id = ?;
+
+-- name: GetWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = ? AND (CASE WHEN ? = '' THEN 1 ELSE status = ? END)
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
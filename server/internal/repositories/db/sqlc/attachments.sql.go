@@ -0,0 +1,214 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: attachments.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+)
+
+const clientExists = `-- name: ClientExists :one
+SELECT EXISTS (SELECT 1 FROM clients WHERE id = ?)
+`
+
+func (q *Queries) ClientExists(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, clientExists, id)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachments (owner_type, owner_id, file_name, content_type, size, storage_key, thumbnail_key, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, owner_type, owner_id, file_name, content_type, size, storage_key, thumbnail_key, user_id, author, created_at
+`
+
+type CreateAttachmentParams struct {
+	OwnerType    string
+	OwnerID      int64
+	FileName     string
+	ContentType  string
+	Size         int64
+	StorageKey   string
+	ThumbnailKey sql.NullString
+	UserID       sql.NullInt64
+	Author       string
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRowContext(ctx, createAttachment,
+		arg.OwnerType,
+		arg.OwnerID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+		arg.ThumbnailKey,
+		arg.UserID,
+		arg.Author,
+	)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerType,
+		&i.OwnerID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.ThumbnailKey,
+		&i.UserID,
+		&i.Author,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :execrows
+DELETE FROM attachments WHERE id = ?
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAttachment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getAttachmentByID = `-- name: GetAttachmentByID :one
+SELECT id, owner_type, owner_id, file_name, content_type, size, storage_key, thumbnail_key, user_id, author, created_at FROM attachments WHERE id = ?
+`
+
+func (q *Queries) GetAttachmentByID(ctx context.Context, id int64) (Attachment, error) {
+	row := q.db.QueryRowContext(ctx, getAttachmentByID, id)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerType,
+		&i.OwnerID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.ThumbnailKey,
+		&i.UserID,
+		&i.Author,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAttachmentsByOwner = `-- name: GetAttachmentsByOwner :many
+SELECT id, owner_type, owner_id, file_name, content_type, size, storage_key, thumbnail_key, user_id, author, created_at FROM attachments
+WHERE owner_type = ? AND owner_id = ?
+ORDER BY created_at DESC, id DESC
+`
+
+type GetAttachmentsByOwnerParams struct {
+	OwnerType string
+	OwnerID   int64
+}
+
+func (q *Queries) GetAttachmentsByOwner(ctx context.Context, arg GetAttachmentsByOwnerParams) ([]Attachment, error) {
+	rows, err := q.db.QueryContext(ctx, getAttachmentsByOwner, arg.OwnerType, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerType,
+			&i.OwnerID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.ThumbnailKey,
+			&i.UserID,
+			&i.Author,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrphanAttachments = `-- name: GetOrphanAttachments :many
+SELECT a.id, a.owner_type, a.owner_id, a.file_name, a.content_type, a.size, a.storage_key, a.thumbnail_key, a.user_id, a.author, a.created_at FROM attachments a
+WHERE (a.owner_type = 'client' AND NOT EXISTS (SELECT 1 FROM clients c WHERE c.id = a.owner_id))
+   OR (a.owner_type = 'sale' AND NOT EXISTS (SELECT 1 FROM sales s WHERE s.id = a.owner_id))
+   OR (a.owner_type = 'payment' AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.id = a.owner_id))
+`
+
+func (q *Queries) GetOrphanAttachments(ctx context.Context) ([]Attachment, error) {
+	rows, err := q.db.QueryContext(ctx, getOrphanAttachments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerType,
+			&i.OwnerID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.ThumbnailKey,
+			&i.UserID,
+			&i.Author,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const paymentExists = `-- name: PaymentExists :one
+SELECT EXISTS (SELECT 1 FROM payments WHERE id = ?)
+`
+
+func (q *Queries) PaymentExists(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, paymentExists, id)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const saleExists = `-- name: SaleExists :one
+SELECT EXISTS (SELECT 1 FROM sales WHERE id = ?)
+`
+
+func (q *Queries) SaleExists(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, saleExists, id)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
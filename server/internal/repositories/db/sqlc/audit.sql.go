@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: audit.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const createAuditEntry = `-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (actor_type, actor_id, actor_name, method, route, path, status, request_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateAuditEntryParams struct {
+	ActorType string
+	ActorID   int64
+	ActorName string
+	Method    string
+	Route     string
+	Path      string
+	Status    int64
+	RequestID string
+}
+
+func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createAuditEntry,
+		arg.ActorType,
+		arg.ActorID,
+		arg.ActorName,
+		arg.Method,
+		arg.Route,
+		arg.Path,
+		arg.Status,
+		arg.RequestID,
+	)
+	return err
+}
+
+const getAuditEntries = `-- name: GetAuditEntries :many
+SELECT id, actor_type, actor_id, actor_name, method, route, path, status, request_id, created_at
+FROM audit_log
+WHERE (CAST(?1 AS TEXT) = '' OR actor_type = ?1)
+  AND (CAST(?2 AS INTEGER) = 0 OR actor_id = ?2)
+ORDER BY id DESC
+LIMIT ?4 OFFSET ?3
+`
+
+type GetAuditEntriesParams struct {
+	ActorType string
+	ActorID   int64
+	Offset    int64
+	Limit     int64
+}
+
+func (q *Queries) GetAuditEntries(ctx context.Context, arg GetAuditEntriesParams) ([]AuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, getAuditEntries,
+		arg.ActorType,
+		arg.ActorID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorType,
+			&i.ActorID,
+			&i.ActorName,
+			&i.Method,
+			&i.Route,
+			&i.Path,
+			&i.Status,
+			&i.RequestID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: business_settings.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getBusinessSettings = `-- name: GetBusinessSettings :one
+SELECT id, name, legal_name, cuit, address, phone, footer, logo, logo_mime, updated_at, ticket_width, ticket_printer, contract_terms FROM business_settings WHERE id = 1
+`
+
+func (q *Queries) GetBusinessSettings(ctx context.Context) (BusinessSetting, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessSettings)
+	var i BusinessSetting
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.LegalName,
+		&i.Cuit,
+		&i.Address,
+		&i.Phone,
+		&i.Footer,
+		&i.Logo,
+		&i.LogoMime,
+		&i.UpdatedAt,
+		&i.TicketWidth,
+		&i.TicketPrinter,
+		&i.ContractTerms,
+	)
+	return i, err
+}
+
+const updateBusinessLogo = `-- name: UpdateBusinessLogo :exec
+UPDATE business_settings
+SET logo = ?, logo_mime = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = 1
+`
+
+type UpdateBusinessLogoParams struct {
+	Logo     []byte
+	LogoMime sql.NullString
+}
+
+func (q *Queries) UpdateBusinessLogo(ctx context.Context, arg UpdateBusinessLogoParams) error {
+	_, err := q.db.ExecContext(ctx, updateBusinessLogo, arg.Logo, arg.LogoMime)
+	return err
+}
+
+const updateBusinessSettings = `-- name: UpdateBusinessSettings :exec
+UPDATE business_settings
+SET name = ?, legal_name = ?, cuit = ?, address = ?, phone = ?, footer = ?, ticket_width = ?, ticket_printer = ?, contract_terms = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = 1
+`
+
+type UpdateBusinessSettingsParams struct {
+	Name          string
+	LegalName     string
+	Cuit          string
+	Address       string
+	Phone         string
+	Footer        string
+	TicketWidth   int64
+	TicketPrinter string
+	ContractTerms string
+}
+
+func (q *Queries) UpdateBusinessSettings(ctx context.Context, arg UpdateBusinessSettingsParams) error {
+	_, err := q.db.ExecContext(ctx, updateBusinessSettings,
+		arg.Name,
+		arg.LegalName,
+		arg.Cuit,
+		arg.Address,
+		arg.Phone,
+		arg.Footer,
+		arg.TicketWidth,
+		arg.TicketPrinter,
+		arg.ContractTerms,
+	)
+	return err
+}
@@ -118,6 +118,19 @@ func (q *Queries) GetCollectedThisMonth(ctx context.Context) (interface{}, error
 	return ifnull, err
 }
 
+const getCollectedToday = `-- name: GetCollectedToday :one
+SELECT CAST(IFNULL(SUM(amount), 0) AS REAL) AS collected
+FROM payments
+WHERE strftime('%Y-%m-%d', date, 'localtime') = strftime('%Y-%m-%d', 'now', 'localtime')
+`
+
+func (q *Queries) GetCollectedToday(ctx context.Context) (float64, error) {
+	row := q.db.QueryRowContext(ctx, getCollectedToday)
+	var collected float64
+	err := row.Scan(&collected)
+	return collected, err
+}
+
 const getCountQuotasDueLastMonth = `-- name: GetCountQuotasDueLastMonth :one
 SELECT COUNT(*) FROM quotas WHERE strftime('%Y-%m', due_date) = strftime('%Y-%m', date('now', '-1 month'))
 `
@@ -185,6 +198,26 @@ func (q *Queries) GetDailyCollections(ctx context.Context, arg GetDailyCollectio
 	return items, nil
 }
 
+const getOverdueQuotasSummary = `-- name: GetOverdueQuotasSummary :one
+SELECT
+    COUNT(q.id) AS overdue_count,
+    CAST(IFNULL(SUM(q.amount - IFNULL((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0)), 0) AS REAL) AS overdue_amount
+FROM quotas q
+WHERE q.is_paid = 0 AND date(q.due_date) < date('now', 'localtime')
+`
+
+type GetOverdueQuotasSummaryRow struct {
+	OverdueCount  int64
+	OverdueAmount float64
+}
+
+func (q *Queries) GetOverdueQuotasSummary(ctx context.Context) (GetOverdueQuotasSummaryRow, error) {
+	row := q.db.QueryRowContext(ctx, getOverdueQuotasSummary)
+	var i GetOverdueQuotasSummaryRow
+	err := row.Scan(&i.OverdueCount, &i.OverdueAmount)
+	return i, err
+}
+
 const getPaidQuotasDueLastMonth = `-- name: GetPaidQuotasDueLastMonth :one
 SELECT COUNT(*) FROM quotas WHERE is_paid = 1 AND strftime('%Y-%m', due_date) = strftime('%Y-%m', date('now', '-1 month'))
 `
@@ -58,6 +58,33 @@ func (q *Queries) DeleteClient(ctx context.Context, id int64) error {
 	return err
 }
 
+const getAllClientDNIs = `-- name: GetAllClientDNIs :many
+SELECT dni FROM clients
+`
+
+func (q *Queries) GetAllClientDNIs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getAllClientDNIs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var dni string
+		if err := rows.Scan(&dni); err != nil {
+			return nil, err
+		}
+		items = append(items, dni)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getClientByID = `-- name: GetClientByID :one
 SELECT 
   c.id, 
@@ -67,6 +94,10 @@ SELECT
   c.email, 
   c.phone, 
   c.address,
+  c.reminders_opt_out,
+  c.collector_id,
+  c.zone,
+  c.risk_score,
   c.state_id, 
   s.id AS state_id, 
   s.description AS state_description,
@@ -85,6 +116,10 @@ type GetClientByIDRow struct {
 	Email            sql.NullString
 	Phone            sql.NullString
 	Address          sql.NullString
+	RemindersOptOut  bool
+	CollectorID      sql.NullInt64
+	Zone             string
+	RiskScore        int64
 	StateID          int64
 	StateID_2        int64
 	StateDescription string
@@ -103,6 +138,10 @@ func (q *Queries) GetClientByID(ctx context.Context, id int64) (GetClientByIDRow
 		&i.Email,
 		&i.Phone,
 		&i.Address,
+		&i.RemindersOptOut,
+		&i.CollectorID,
+		&i.Zone,
+		&i.RiskScore,
 		&i.StateID,
 		&i.StateID_2,
 		&i.StateDescription,
@@ -112,6 +151,38 @@ func (q *Queries) GetClientByID(ctx context.Context, id int64) (GetClientByIDRow
 	return i, err
 }
 
+const getClientIDsByDNI = `-- name: GetClientIDsByDNI :many
+SELECT id, dni FROM clients
+`
+
+type GetClientIDsByDNIRow struct {
+	ID  int64
+	Dni string
+}
+
+func (q *Queries) GetClientIDsByDNI(ctx context.Context) ([]GetClientIDsByDNIRow, error) {
+	rows, err := q.db.QueryContext(ctx, getClientIDsByDNI)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetClientIDsByDNIRow
+	for rows.Next() {
+		var i GetClientIDsByDNIRow
+		if err := rows.Scan(&i.ID, &i.Dni); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getClients = `-- name: GetClients :many
 SELECT 
   c.id,
@@ -119,22 +190,26 @@ SELECT
   lastname, 
   dni, 
   s.description AS stateDescription, 
-  s.id AS stateId
+  s.id AS stateId,
+  c.risk_score,
+  -- Orden por riesgo: 1 de mayor a menor, -1 de menor a mayor, 0 solo por nombre
+  CAST(? AS INTEGER) * c.risk_score AS risk_order
 FROM clients c
   INNER JOIN states s ON c.state_id = s.id
 WHERE (name LIKE ?
    OR lastname LIKE ?
    OR dni LIKE ?)
   AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (/*SLICE:state_ids*/?) END)
-ORDER BY lastname ASC, name ASC
+ORDER BY risk_order DESC, lastname ASC, name ASC
 LIMIT ? OFFSET ?
 `
 
 type GetClientsParams struct {
+	Column1  int64
 	Name     string
 	Lastname string
 	Dni      string
-	Column4  interface{}
+	Column5  interface{}
 	StateIds []int64
 	Limit    int64
 	Offset   int64
@@ -147,15 +222,18 @@ type GetClientsRow struct {
 	Dni              string
 	Statedescription string
 	Stateid          int64
+	RiskScore        int64
+	RiskOrder        int64
 }
 
 func (q *Queries) GetClients(ctx context.Context, arg GetClientsParams) ([]GetClientsRow, error) {
 	query := getClients
 	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Column1)
 	queryParams = append(queryParams, arg.Name)
 	queryParams = append(queryParams, arg.Lastname)
 	queryParams = append(queryParams, arg.Dni)
-	queryParams = append(queryParams, arg.Column4)
+	queryParams = append(queryParams, arg.Column5)
 	if len(arg.StateIds) > 0 {
 		for _, v := range arg.StateIds {
 			queryParams = append(queryParams, v)
@@ -181,6 +259,8 @@ func (q *Queries) GetClients(ctx context.Context, arg GetClientsParams) ([]GetCl
 			&i.Dni,
 			&i.Statedescription,
 			&i.Stateid,
+			&i.RiskScore,
+			&i.RiskOrder,
 		); err != nil {
 			return nil, err
 		}
@@ -200,7 +280,7 @@ INSERT INTO clients
 ( name, lastname, dni, email, phone, address, state_id)
 VALUES
 (?, ?, ?, ?, ?, ?, 1)
-RETURNING id, name, lastname, dni, email, phone, address, state_id, created_at, updated_at
+RETURNING id, name, lastname, dni, email, phone, address, state_id, created_at, updated_at, reminders_opt_out, collector_id, zone, max_balance, max_active_sales, risk_score, warning_count, suspended_count
 `
 
 type InsertClientParams struct {
@@ -233,6 +313,14 @@ func (q *Queries) InsertClient(ctx context.Context, arg InsertClientParams) (Cli
 		&i.StateID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RemindersOptOut,
+		&i.CollectorID,
+		&i.Zone,
+		&i.MaxBalance,
+		&i.MaxActiveSales,
+		&i.RiskScore,
+		&i.WarningCount,
+		&i.SuspendedCount,
 	)
 	return i, err
 }
@@ -265,7 +353,11 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) erro
 }
 
 const updateClientState = `-- name: UpdateClientState :exec
-UPDATE clients SET state_id = ? WHERE id = ?
+UPDATE clients
+SET state_id = ?1,
+  warning_count = warning_count + (CASE WHEN state_id != 2 AND ?1 = 2 THEN 1 ELSE 0 END),
+  suspended_count = suspended_count + (CASE WHEN state_id != 3 AND ?1 = 3 THEN 1 ELSE 0 END)
+WHERE id = ?2
 `
 
 type UpdateClientStateParams struct {
@@ -273,6 +365,7 @@ type UpdateClientStateParams struct {
 	ID      int64
 }
 
+// Cuenta las veces que el cliente pasa a Warning o a Suspended para el puntaje de riesgo
 func (q *Queries) UpdateClientState(ctx context.Context, arg UpdateClientStateParams) error {
 	_, err := q.db.ExecContext(ctx, updateClientState, arg.StateID, arg.ID)
 	return err
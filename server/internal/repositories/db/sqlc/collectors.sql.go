@@ -0,0 +1,383 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: collectors.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const assignClientCollector = `-- name: AssignClientCollector :execrows
+UPDATE clients SET collector_id = ?, zone = ? WHERE id = ?
+`
+
+type AssignClientCollectorParams struct {
+	CollectorID sql.NullInt64
+	Zone        string
+	ID          int64
+}
+
+func (q *Queries) AssignClientCollector(ctx context.Context, arg AssignClientCollectorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, assignClientCollector, arg.CollectorID, arg.Zone, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getCollectorByID = `-- name: GetCollectorByID :one
+SELECT
+  u.id,
+  u.username,
+  u.first_name,
+  u.last_name,
+  u.is_active,
+  u.commission_rate,
+  (SELECT COUNT(c.id) FROM clients c WHERE c.collector_id = u.id) AS clients_count
+FROM users u
+WHERE u.id = ?1 AND (u.permissions & ?2) != 0
+`
+
+type GetCollectorByIDParams struct {
+	ID         int64
+	Permission int64
+}
+
+type GetCollectorByIDRow struct {
+	ID             int64
+	Username       string
+	FirstName      sql.NullString
+	LastName       sql.NullString
+	IsActive       bool
+	CommissionRate float64
+	ClientsCount   int64
+}
+
+func (q *Queries) GetCollectorByID(ctx context.Context, arg GetCollectorByIDParams) (GetCollectorByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getCollectorByID, arg.ID, arg.Permission)
+	var i GetCollectorByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FirstName,
+		&i.LastName,
+		&i.IsActive,
+		&i.CommissionRate,
+		&i.ClientsCount,
+	)
+	return i, err
+}
+
+const getCollectorClients = `-- name: GetCollectorClients :many
+SELECT
+  c.id,
+  c.name,
+  c.lastname,
+  c.dni,
+  c.phone,
+  c.address,
+  c.zone,
+  s.id AS state_id,
+  s.description AS state_description
+FROM clients c
+  INNER JOIN states s ON c.state_id = s.id
+WHERE c.collector_id = ?1
+  AND (CAST(?2 AS TEXT) = '' OR c.zone = CAST(?2 AS TEXT))
+ORDER BY c.zone ASC, c.lastname ASC, c.name ASC
+`
+
+type GetCollectorClientsParams struct {
+	CollectorID sql.NullInt64
+	Zone        string
+}
+
+type GetCollectorClientsRow struct {
+	ID               int64
+	Name             string
+	Lastname         string
+	Dni              string
+	Phone            sql.NullString
+	Address          sql.NullString
+	Zone             string
+	StateID          int64
+	StateDescription string
+}
+
+func (q *Queries) GetCollectorClients(ctx context.Context, arg GetCollectorClientsParams) ([]GetCollectorClientsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCollectorClients, arg.CollectorID, arg.Zone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCollectorClientsRow
+	for rows.Next() {
+		var i GetCollectorClientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Lastname,
+			&i.Dni,
+			&i.Phone,
+			&i.Address,
+			&i.Zone,
+			&i.StateID,
+			&i.StateDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollectorPaymentTotals = `-- name: GetCollectorPaymentTotals :one
+SELECT
+  COUNT(p.id) AS payments_count,
+  COUNT(DISTINCT p.client_id) AS clients_count,
+  CAST(COALESCE(SUM(p.amount), 0) AS REAL) AS total
+FROM payments p
+WHERE p.collector_id = ?1
+  AND p.date >= ?2
+  AND p.date < ?3
+`
+
+type GetCollectorPaymentTotalsParams struct {
+	CollectorID sql.NullInt64
+	FromDate    time.Time
+	ToDate      time.Time
+}
+
+type GetCollectorPaymentTotalsRow struct {
+	PaymentsCount int64
+	ClientsCount  int64
+	Total         float64
+}
+
+func (q *Queries) GetCollectorPaymentTotals(ctx context.Context, arg GetCollectorPaymentTotalsParams) (GetCollectorPaymentTotalsRow, error) {
+	row := q.db.QueryRowContext(ctx, getCollectorPaymentTotals, arg.CollectorID, arg.FromDate, arg.ToDate)
+	var i GetCollectorPaymentTotalsRow
+	err := row.Scan(&i.PaymentsCount, &i.ClientsCount, &i.Total)
+	return i, err
+}
+
+const getCollectorPendingSaleIDs = `-- name: GetCollectorPendingSaleIDs :many
+SELECT s.id
+FROM sales s
+  INNER JOIN clients c ON s.client_id = c.id
+WHERE s.is_paid = 0
+  AND (CAST(?1 AS INTEGER) = 0 OR c.collector_id = ?1)
+  AND (CAST(?2 AS TEXT) = '' OR c.zone = CAST(?2 AS TEXT))
+ORDER BY c.lastname ASC, c.name ASC, s.id ASC
+`
+
+type GetCollectorPendingSaleIDsParams struct {
+	CollectorID int64
+	Zone        string
+}
+
+func (q *Queries) GetCollectorPendingSaleIDs(ctx context.Context, arg GetCollectorPendingSaleIDsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getCollectorPendingSaleIDs, arg.CollectorID, arg.Zone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollectorRouteQuotas = `-- name: GetCollectorRouteQuotas :many
+SELECT
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.dni,
+  c.phone,
+  c.address,
+  c.zone,
+  c.state_id,
+  q.id AS quota_id,
+  q.number AS quota_number,
+  q.amount,
+  q.due_date,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  s.id AS sale_id,
+  s.description AS sale_description
+FROM quotas q
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE c.collector_id = ?1
+  AND q.is_paid = false
+  AND q.due_date < ?2
+ORDER BY c.zone ASC, c.lastname ASC, c.name ASC, q.due_date ASC
+`
+
+type GetCollectorRouteQuotasParams struct {
+	CollectorID sql.NullInt64
+	Before      time.Time
+}
+
+type GetCollectorRouteQuotasRow struct {
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	Dni             string
+	Phone           sql.NullString
+	Address         sql.NullString
+	Zone            string
+	StateID         int64
+	QuotaID         int64
+	QuotaNumber     int64
+	Amount          float64
+	DueDate         time.Time
+	PaidAmount      float64
+	SaleID          int64
+	SaleDescription string
+}
+
+func (q *Queries) GetCollectorRouteQuotas(ctx context.Context, arg GetCollectorRouteQuotasParams) ([]GetCollectorRouteQuotasRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCollectorRouteQuotas, arg.CollectorID, arg.Before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCollectorRouteQuotasRow
+	for rows.Next() {
+		var i GetCollectorRouteQuotasRow
+		if err := rows.Scan(
+			&i.ClientID,
+			&i.ClientName,
+			&i.ClientLastname,
+			&i.Dni,
+			&i.Phone,
+			&i.Address,
+			&i.Zone,
+			&i.StateID,
+			&i.QuotaID,
+			&i.QuotaNumber,
+			&i.Amount,
+			&i.DueDate,
+			&i.PaidAmount,
+			&i.SaleID,
+			&i.SaleDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollectors = `-- name: GetCollectors :many
+SELECT
+  u.id,
+  u.username,
+  u.first_name,
+  u.last_name,
+  u.is_active,
+  u.commission_rate,
+  (SELECT COUNT(c.id) FROM clients c WHERE c.collector_id = u.id) AS clients_count
+FROM users u
+WHERE (u.permissions & ?1) != 0
+ORDER BY u.last_name ASC, u.first_name ASC
+`
+
+type GetCollectorsRow struct {
+	ID             int64
+	Username       string
+	FirstName      sql.NullString
+	LastName       sql.NullString
+	IsActive       bool
+	CommissionRate float64
+	ClientsCount   int64
+}
+
+func (q *Queries) GetCollectors(ctx context.Context, permission int64) ([]GetCollectorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCollectors, permission)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCollectorsRow
+	for rows.Next() {
+		var i GetCollectorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.FirstName,
+			&i.LastName,
+			&i.IsActive,
+			&i.CommissionRate,
+			&i.ClientsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuotaCollectorID = `-- name: GetQuotaCollectorID :one
+SELECT c.collector_id
+FROM quotas q
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE q.id = ?
+`
+
+func (q *Queries) GetQuotaCollectorID(ctx context.Context, id int64) (sql.NullInt64, error) {
+	row := q.db.QueryRowContext(ctx, getQuotaCollectorID, id)
+	var collector_id sql.NullInt64
+	err := row.Scan(&collector_id)
+	return collector_id, err
+}
+
+const updateCollectorCommission = `-- name: UpdateCollectorCommission :execrows
+UPDATE users SET commission_rate = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+`
+
+type UpdateCollectorCommissionParams struct {
+	CommissionRate float64
+	ID             int64
+}
+
+func (q *Queries) UpdateCollectorCommission(ctx context.Context, arg UpdateCollectorCommissionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateCollectorCommission, arg.CommissionRate, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,279 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: credit.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createCreditOverride = `-- name: CreateCreditOverride :one
+INSERT INTO credit_overrides (sale_id, client_id, user_id, user_name, reason, violations, amount)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id, sale_id, client_id, user_id, user_name, reason, violations, amount, created_at
+`
+
+type CreateCreditOverrideParams struct {
+	SaleID     sql.NullInt64
+	ClientID   int64
+	UserID     sql.NullInt64
+	UserName   string
+	Reason     string
+	Violations string
+	Amount     float64
+}
+
+func (q *Queries) CreateCreditOverride(ctx context.Context, arg CreateCreditOverrideParams) (CreditOverride, error) {
+	row := q.db.QueryRowContext(ctx, createCreditOverride,
+		arg.SaleID,
+		arg.ClientID,
+		arg.UserID,
+		arg.UserName,
+		arg.Reason,
+		arg.Violations,
+		arg.Amount,
+	)
+	var i CreditOverride
+	err := row.Scan(
+		&i.ID,
+		&i.SaleID,
+		&i.ClientID,
+		&i.UserID,
+		&i.UserName,
+		&i.Reason,
+		&i.Violations,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getClientCreditStatus = `-- name: GetClientCreditStatus :one
+SELECT
+  c.id,
+  c.state_id,
+  c.max_balance,
+  c.max_active_sales,
+  CAST(COALESCE((
+    SELECT SUM(q.amount - COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0))
+    FROM quotas q
+    WHERE q.client_id = c.id AND q.is_paid = false
+  ), 0) AS REAL) AS balance,
+  (SELECT COUNT(*) FROM sales s WHERE s.client_id = c.id AND s.is_paid = false) AS active_sales
+FROM clients c
+WHERE c.id = ?
+`
+
+type GetClientCreditStatusRow struct {
+	ID             int64
+	StateID        int64
+	MaxBalance     sql.NullFloat64
+	MaxActiveSales sql.NullInt64
+	Balance        float64
+	ActiveSales    int64
+}
+
+func (q *Queries) GetClientCreditStatus(ctx context.Context, id int64) (GetClientCreditStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, getClientCreditStatus, id)
+	var i GetClientCreditStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.StateID,
+		&i.MaxBalance,
+		&i.MaxActiveSales,
+		&i.Balance,
+		&i.ActiveSales,
+	)
+	return i, err
+}
+
+const getCreditOverrides = `-- name: GetCreditOverrides :many
+SELECT o.id, o.sale_id, o.client_id, o.user_id, o.user_name, o.reason, o.violations, o.amount, o.created_at, c.name AS client_name, c.lastname AS client_lastname
+FROM credit_overrides o
+  INNER JOIN clients c ON c.id = o.client_id
+WHERE (CAST(?1 AS INTEGER) = 0 OR o.client_id = ?1)
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type GetCreditOverridesParams struct {
+	ClientID int64
+	Offset   int64
+	Limit    int64
+}
+
+type GetCreditOverridesRow struct {
+	ID             int64
+	SaleID         sql.NullInt64
+	ClientID       int64
+	UserID         sql.NullInt64
+	UserName       string
+	Reason         string
+	Violations     string
+	Amount         float64
+	CreatedAt      time.Time
+	ClientName     string
+	ClientLastname string
+}
+
+func (q *Queries) GetCreditOverrides(ctx context.Context, arg GetCreditOverridesParams) ([]GetCreditOverridesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCreditOverrides, arg.ClientID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCreditOverridesRow
+	for rows.Next() {
+		var i GetCreditOverridesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SaleID,
+			&i.ClientID,
+			&i.UserID,
+			&i.UserName,
+			&i.Reason,
+			&i.Violations,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.ClientName,
+			&i.ClientLastname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCreditPolicies = `-- name: GetCreditPolicies :many
+SELECT p.state_id, p.allow_sales, p.max_balance, p.max_active_sales, p.updated_at, s.description AS state_description
+FROM credit_policies p
+  INNER JOIN states s ON s.id = p.state_id
+ORDER BY p.state_id ASC
+`
+
+type GetCreditPoliciesRow struct {
+	StateID          int64
+	AllowSales       bool
+	MaxBalance       sql.NullFloat64
+	MaxActiveSales   sql.NullInt64
+	UpdatedAt        time.Time
+	StateDescription string
+}
+
+func (q *Queries) GetCreditPolicies(ctx context.Context) ([]GetCreditPoliciesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCreditPolicies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCreditPoliciesRow
+	for rows.Next() {
+		var i GetCreditPoliciesRow
+		if err := rows.Scan(
+			&i.StateID,
+			&i.AllowSales,
+			&i.MaxBalance,
+			&i.MaxActiveSales,
+			&i.UpdatedAt,
+			&i.StateDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCreditPolicy = `-- name: GetCreditPolicy :one
+SELECT p.state_id, p.allow_sales, p.max_balance, p.max_active_sales, p.updated_at, s.description AS state_description
+FROM credit_policies p
+  INNER JOIN states s ON s.id = p.state_id
+WHERE p.state_id = ?
+`
+
+type GetCreditPolicyRow struct {
+	StateID          int64
+	AllowSales       bool
+	MaxBalance       sql.NullFloat64
+	MaxActiveSales   sql.NullInt64
+	UpdatedAt        time.Time
+	StateDescription string
+}
+
+func (q *Queries) GetCreditPolicy(ctx context.Context, stateID int64) (GetCreditPolicyRow, error) {
+	row := q.db.QueryRowContext(ctx, getCreditPolicy, stateID)
+	var i GetCreditPolicyRow
+	err := row.Scan(
+		&i.StateID,
+		&i.AllowSales,
+		&i.MaxBalance,
+		&i.MaxActiveSales,
+		&i.UpdatedAt,
+		&i.StateDescription,
+	)
+	return i, err
+}
+
+const updateClientCreditLimits = `-- name: UpdateClientCreditLimits :execrows
+UPDATE clients
+SET max_balance = ?1, max_active_sales = ?2, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?3
+`
+
+type UpdateClientCreditLimitsParams struct {
+	MaxBalance     sql.NullFloat64
+	MaxActiveSales sql.NullInt64
+	ID             int64
+}
+
+func (q *Queries) UpdateClientCreditLimits(ctx context.Context, arg UpdateClientCreditLimitsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateClientCreditLimits, arg.MaxBalance, arg.MaxActiveSales, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertCreditPolicy = `-- name: UpsertCreditPolicy :exec
+INSERT INTO credit_policies (state_id, allow_sales, max_balance, max_active_sales)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (state_id) DO UPDATE SET
+  allow_sales = excluded.allow_sales,
+  max_balance = excluded.max_balance,
+  max_active_sales = excluded.max_active_sales,
+  updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertCreditPolicyParams struct {
+	StateID        int64
+	AllowSales     bool
+	MaxBalance     sql.NullFloat64
+	MaxActiveSales sql.NullInt64
+}
+
+func (q *Queries) UpsertCreditPolicy(ctx context.Context, arg UpsertCreditPolicyParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCreditPolicy,
+		arg.StateID,
+		arg.AllowSales,
+		arg.MaxBalance,
+		arg.MaxActiveSales,
+	)
+	return err
+}
@@ -0,0 +1,401 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: exports.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const exportClients = `-- name: ExportClients :many
+SELECT
+  c.id,
+  c.name,
+  c.lastname,
+  c.dni,
+  c.email,
+  c.phone,
+  c.address,
+  s.description AS state_description,
+  c.created_at
+FROM clients c
+  INNER JOIN states s ON c.state_id = s.id
+WHERE c.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (/*SLICE:state_ids*/?) END)
+ORDER BY c.id ASC
+LIMIT ?
+`
+
+type ExportClientsParams struct {
+	ID       int64
+	Name     string
+	Lastname string
+	Dni      string
+	Column5  interface{}
+	StateIds []int64
+	Limit    int64
+}
+
+type ExportClientsRow struct {
+	ID               int64
+	Name             string
+	Lastname         string
+	Dni              string
+	Email            sql.NullString
+	Phone            sql.NullString
+	Address          sql.NullString
+	StateDescription string
+	CreatedAt        sql.NullTime
+}
+
+func (q *Queries) ExportClients(ctx context.Context, arg ExportClientsParams) ([]ExportClientsRow, error) {
+	query := exportClients
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ID)
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.Lastname)
+	queryParams = append(queryParams, arg.Dni)
+	queryParams = append(queryParams, arg.Column5)
+	if len(arg.StateIds) > 0 {
+		for _, v := range arg.StateIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", strings.Repeat(",?", len(arg.StateIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportClientsRow
+	for rows.Next() {
+		var i ExportClientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Lastname,
+			&i.Dni,
+			&i.Email,
+			&i.Phone,
+			&i.Address,
+			&i.StateDescription,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportPayments = `-- name: ExportPayments :many
+SELECT
+  p.id,
+  p.date,
+  p.amount,
+  q.sale_id,
+  q.number AS quota_number,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname
+FROM payments p
+  INNER JOIN quotas q ON p.quota_id = q.id
+  INNER JOIN clients c ON p.client_id = c.id
+WHERE p.id > ?
+  AND p.date >= ? AND p.date < ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (/*SLICE:state_ids*/?) END)
+ORDER BY p.id ASC
+LIMIT ?
+`
+
+type ExportPaymentsParams struct {
+	ID       int64
+	Date     time.Time
+	Date_2   time.Time
+	Name     string
+	Lastname string
+	Dni      string
+	Column7  interface{}
+	StateIds []int64
+	Limit    int64
+}
+
+type ExportPaymentsRow struct {
+	ID             int64
+	Date           time.Time
+	Amount         float64
+	SaleID         int64
+	QuotaNumber    int64
+	ClientDni      string
+	ClientName     string
+	ClientLastname string
+}
+
+func (q *Queries) ExportPayments(ctx context.Context, arg ExportPaymentsParams) ([]ExportPaymentsRow, error) {
+	query := exportPayments
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ID)
+	queryParams = append(queryParams, arg.Date)
+	queryParams = append(queryParams, arg.Date_2)
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.Lastname)
+	queryParams = append(queryParams, arg.Dni)
+	queryParams = append(queryParams, arg.Column7)
+	if len(arg.StateIds) > 0 {
+		for _, v := range arg.StateIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", strings.Repeat(",?", len(arg.StateIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportPaymentsRow
+	for rows.Next() {
+		var i ExportPaymentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.Amount,
+			&i.SaleID,
+			&i.QuotaNumber,
+			&i.ClientDni,
+			&i.ClientName,
+			&i.ClientLastname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportQuotas = `-- name: ExportQuotas :many
+SELECT
+  q.id,
+  q.sale_id,
+  q.number,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  q.amount,
+  q.due_date,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  q.is_paid,
+  st.description AS state_description
+FROM quotas q
+  INNER JOIN clients c ON q.client_id = c.id
+  INNER JOIN states st ON q.state_id = st.id
+WHERE q.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (/*SLICE:state_ids*/?) END)
+ORDER BY q.id ASC
+LIMIT ?
+`
+
+type ExportQuotasParams struct {
+	ID       int64
+	Name     string
+	Lastname string
+	Dni      string
+	Column5  interface{}
+	StateIds []int64
+	Limit    int64
+}
+
+type ExportQuotasRow struct {
+	ID               int64
+	SaleID           int64
+	Number           int64
+	ClientDni        string
+	ClientName       string
+	ClientLastname   string
+	Amount           float64
+	DueDate          time.Time
+	PaidAmount       float64
+	IsPaid           sql.NullBool
+	StateDescription string
+}
+
+func (q *Queries) ExportQuotas(ctx context.Context, arg ExportQuotasParams) ([]ExportQuotasRow, error) {
+	query := exportQuotas
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ID)
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.Lastname)
+	queryParams = append(queryParams, arg.Dni)
+	queryParams = append(queryParams, arg.Column5)
+	if len(arg.StateIds) > 0 {
+		for _, v := range arg.StateIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", strings.Repeat(",?", len(arg.StateIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportQuotasRow
+	for rows.Next() {
+		var i ExportQuotasRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SaleID,
+			&i.Number,
+			&i.ClientDni,
+			&i.ClientName,
+			&i.ClientLastname,
+			&i.Amount,
+			&i.DueDate,
+			&i.PaidAmount,
+			&i.IsPaid,
+			&i.StateDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportSales = `-- name: ExportSales :many
+SELECT
+  s.id,
+  s.date,
+  c.dni AS client_dni,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  CAST(COALESCE((
+    SELECT GROUP_CONCAT(sp.name || ' x' || sp.quantity, ', ')
+    FROM sale_products sp WHERE sp.sale_id = s.id
+  ), '') AS TEXT) AS products,
+  s.amount,
+  CAST(COALESCE((
+    SELECT SUM(p.amount) FROM payments p
+    INNER JOIN quotas q ON p.quota_id = q.id
+    WHERE q.sale_id = s.id
+  ), 0) AS REAL) AS paid_amount,
+  (SELECT COUNT(*) FROM quotas q WHERE q.sale_id = s.id) AS quota_count,
+  s.is_paid,
+  st.description AS state_description
+FROM sales s
+  INNER JOIN clients c ON s.client_id = c.id
+  INNER JOIN states st ON s.state_id = st.id
+WHERE s.id > ?
+  AND (c.name LIKE ? OR c.lastname LIKE ? OR c.dni LIKE ?)
+  AND (CASE WHEN ? = '' THEN 1 ELSE c.state_id IN (/*SLICE:state_ids*/?) END)
+ORDER BY s.id ASC
+LIMIT ?
+`
+
+type ExportSalesParams struct {
+	ID       int64
+	Name     string
+	Lastname string
+	Dni      string
+	Column5  interface{}
+	StateIds []int64
+	Limit    int64
+}
+
+type ExportSalesRow struct {
+	ID               int64
+	Date             time.Time
+	ClientDni        string
+	ClientName       string
+	ClientLastname   string
+	Products         string
+	Amount           float64
+	PaidAmount       float64
+	QuotaCount       int64
+	IsPaid           bool
+	StateDescription string
+}
+
+func (q *Queries) ExportSales(ctx context.Context, arg ExportSalesParams) ([]ExportSalesRow, error) {
+	query := exportSales
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.ID)
+	queryParams = append(queryParams, arg.Name)
+	queryParams = append(queryParams, arg.Lastname)
+	queryParams = append(queryParams, arg.Dni)
+	queryParams = append(queryParams, arg.Column5)
+	if len(arg.StateIds) > 0 {
+		for _, v := range arg.StateIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", strings.Repeat(",?", len(arg.StateIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:state_ids*/?", "NULL", 1)
+	}
+	queryParams = append(queryParams, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportSalesRow
+	for rows.Next() {
+		var i ExportSalesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.ClientDni,
+			&i.ClientName,
+			&i.ClientLastname,
+			&i.Products,
+			&i.Amount,
+			&i.PaidAmount,
+			&i.QuotaCount,
+			&i.IsPaid,
+			&i.StateDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,370 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: guarantors.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+const createGuarantor = `-- name: CreateGuarantor :one
+INSERT INTO guarantors (name, lastname, dni, phone, address, relationship)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, name, lastname, dni, phone, address, relationship, created_at, updated_at
+`
+
+type CreateGuarantorParams struct {
+	Name         string
+	Lastname     string
+	Dni          string
+	Phone        string
+	Address      string
+	Relationship string
+}
+
+func (q *Queries) CreateGuarantor(ctx context.Context, arg CreateGuarantorParams) (Guarantor, error) {
+	row := q.db.QueryRowContext(ctx, createGuarantor,
+		arg.Name,
+		arg.Lastname,
+		arg.Dni,
+		arg.Phone,
+		arg.Address,
+		arg.Relationship,
+	)
+	var i Guarantor
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Lastname,
+		&i.Dni,
+		&i.Phone,
+		&i.Address,
+		&i.Relationship,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteGuarantor = `-- name: DeleteGuarantor :execrows
+DELETE FROM guarantors WHERE id = ?
+`
+
+func (q *Queries) DeleteGuarantor(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteGuarantor, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getGuarantorByID = `-- name: GetGuarantorByID :one
+SELECT id, name, lastname, dni, phone, address, relationship, created_at, updated_at FROM guarantors WHERE id = ?
+`
+
+func (q *Queries) GetGuarantorByID(ctx context.Context, id int64) (Guarantor, error) {
+	row := q.db.QueryRowContext(ctx, getGuarantorByID, id)
+	var i Guarantor
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Lastname,
+		&i.Dni,
+		&i.Phone,
+		&i.Address,
+		&i.Relationship,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getGuarantorFollowUps = `-- name: GetGuarantorFollowUps :many
+SELECT
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.dni AS client_dni,
+  c.phone AS client_phone,
+  c.address AS client_address,
+  s.id AS sale_id,
+  s.description AS sale_description,
+  COUNT(q.id) AS overdue_quotas,
+  CAST(SUM(q.amount - COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0)) AS REAL) AS overdue_amount
+FROM clients c
+  INNER JOIN sales s ON s.client_id = c.id
+  INNER JOIN quotas q ON q.sale_id = s.id
+WHERE c.state_id = ?1
+  AND q.is_paid = false
+  AND q.due_date < ?2
+  AND EXISTS (SELECT 1 FROM sale_guarantors sg WHERE sg.sale_id = s.id)
+GROUP BY c.id, s.id
+ORDER BY c.lastname ASC, c.name ASC, s.id ASC
+`
+
+type GetGuarantorFollowUpsParams struct {
+	StateID int64
+	Before  time.Time
+}
+
+type GetGuarantorFollowUpsRow struct {
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	ClientDni       string
+	ClientPhone     sql.NullString
+	ClientAddress   sql.NullString
+	SaleID          int64
+	SaleDescription string
+	OverdueQuotas   int64
+	OverdueAmount   float64
+}
+
+func (q *Queries) GetGuarantorFollowUps(ctx context.Context, arg GetGuarantorFollowUpsParams) ([]GetGuarantorFollowUpsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGuarantorFollowUps, arg.StateID, arg.Before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuarantorFollowUpsRow
+	for rows.Next() {
+		var i GetGuarantorFollowUpsRow
+		if err := rows.Scan(
+			&i.ClientID,
+			&i.ClientName,
+			&i.ClientLastname,
+			&i.ClientDni,
+			&i.ClientPhone,
+			&i.ClientAddress,
+			&i.SaleID,
+			&i.SaleDescription,
+			&i.OverdueQuotas,
+			&i.OverdueAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuarantorSaleIDs = `-- name: GetGuarantorSaleIDs :many
+SELECT sale_id FROM sale_guarantors WHERE guarantor_id = ? ORDER BY sale_id ASC
+`
+
+func (q *Queries) GetGuarantorSaleIDs(ctx context.Context, guarantorID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getGuarantorSaleIDs, guarantorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var sale_id int64
+		if err := rows.Scan(&sale_id); err != nil {
+			return nil, err
+		}
+		items = append(items, sale_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuarantors = `-- name: GetGuarantors :many
+SELECT id, name, lastname, dni, phone, address, relationship, created_at, updated_at FROM guarantors
+WHERE name LIKE ? OR lastname LIKE ? OR dni LIKE ?
+ORDER BY lastname ASC, name ASC
+LIMIT ? OFFSET ?
+`
+
+type GetGuarantorsParams struct {
+	Name     string
+	Lastname string
+	Dni      string
+	Limit    int64
+	Offset   int64
+}
+
+func (q *Queries) GetGuarantors(ctx context.Context, arg GetGuarantorsParams) ([]Guarantor, error) {
+	rows, err := q.db.QueryContext(ctx, getGuarantors,
+		arg.Name,
+		arg.Lastname,
+		arg.Dni,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Guarantor
+	for rows.Next() {
+		var i Guarantor
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Lastname,
+			&i.Dni,
+			&i.Phone,
+			&i.Address,
+			&i.Relationship,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuarantorsBySaleIDs = `-- name: GetGuarantorsBySaleIDs :many
+SELECT sg.sale_id, g.id, g.name, g.lastname, g.dni, g.phone, g.address, g.relationship, g.created_at, g.updated_at
+FROM sale_guarantors sg
+  INNER JOIN guarantors g ON sg.guarantor_id = g.id
+WHERE sg.sale_id IN (/*SLICE:sale_ids*/?)
+ORDER BY sg.sale_id ASC, sg.created_at ASC, g.id ASC
+`
+
+type GetGuarantorsBySaleIDsRow struct {
+	SaleID       int64
+	ID           int64
+	Name         string
+	Lastname     string
+	Dni          string
+	Phone        string
+	Address      string
+	Relationship string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (q *Queries) GetGuarantorsBySaleIDs(ctx context.Context, saleIds []int64) ([]GetGuarantorsBySaleIDsRow, error) {
+	query := getGuarantorsBySaleIDs
+	var queryParams []interface{}
+	if len(saleIds) > 0 {
+		for _, v := range saleIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:sale_ids*/?", strings.Repeat(",?", len(saleIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:sale_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuarantorsBySaleIDsRow
+	for rows.Next() {
+		var i GetGuarantorsBySaleIDsRow
+		if err := rows.Scan(
+			&i.SaleID,
+			&i.ID,
+			&i.Name,
+			&i.Lastname,
+			&i.Dni,
+			&i.Phone,
+			&i.Address,
+			&i.Relationship,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const linkSaleGuarantor = `-- name: LinkSaleGuarantor :exec
+INSERT INTO sale_guarantors (sale_id, guarantor_id) VALUES (?, ?)
+ON CONFLICT (sale_id, guarantor_id) DO NOTHING
+`
+
+type LinkSaleGuarantorParams struct {
+	SaleID      int64
+	GuarantorID int64
+}
+
+func (q *Queries) LinkSaleGuarantor(ctx context.Context, arg LinkSaleGuarantorParams) error {
+	_, err := q.db.ExecContext(ctx, linkSaleGuarantor, arg.SaleID, arg.GuarantorID)
+	return err
+}
+
+const unlinkSaleGuarantor = `-- name: UnlinkSaleGuarantor :execrows
+DELETE FROM sale_guarantors WHERE sale_id = ? AND guarantor_id = ?
+`
+
+type UnlinkSaleGuarantorParams struct {
+	SaleID      int64
+	GuarantorID int64
+}
+
+func (q *Queries) UnlinkSaleGuarantor(ctx context.Context, arg UnlinkSaleGuarantorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, unlinkSaleGuarantor, arg.SaleID, arg.GuarantorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateGuarantor = `-- name: UpdateGuarantor :execrows
+UPDATE guarantors
+SET name = ?, lastname = ?, dni = ?, phone = ?, address = ?, relationship = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateGuarantorParams struct {
+	Name         string
+	Lastname     string
+	Dni          string
+	Phone        string
+	Address      string
+	Relationship string
+	ID           int64
+}
+
+func (q *Queries) UpdateGuarantor(ctx context.Context, arg UpdateGuarantorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateGuarantor,
+		arg.Name,
+		arg.Lastname,
+		arg.Dni,
+		arg.Phone,
+		arg.Address,
+		arg.Relationship,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,418 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: interactions.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createClientInteraction = `-- name: CreateClientInteraction :one
+INSERT INTO client_interactions (client_id, type, outcome, notes, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id, client_id, type, outcome, notes, user_id, author, created_at
+`
+
+type CreateClientInteractionParams struct {
+	ClientID int64
+	Type     string
+	Outcome  string
+	Notes    string
+	UserID   sql.NullInt64
+	Author   string
+}
+
+func (q *Queries) CreateClientInteraction(ctx context.Context, arg CreateClientInteractionParams) (ClientInteraction, error) {
+	row := q.db.QueryRowContext(ctx, createClientInteraction,
+		arg.ClientID,
+		arg.Type,
+		arg.Outcome,
+		arg.Notes,
+		arg.UserID,
+		arg.Author,
+	)
+	var i ClientInteraction
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.Type,
+		&i.Outcome,
+		&i.Notes,
+		&i.UserID,
+		&i.Author,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPaymentPromise = `-- name: CreatePaymentPromise :one
+INSERT INTO payment_promises (client_id, interaction_id, promised_date, amount, notes, user_id, author)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id, client_id, interaction_id, promised_date, amount, status, paid_amount, resolved_at, notes, user_id, author, created_at
+`
+
+type CreatePaymentPromiseParams struct {
+	ClientID      int64
+	InteractionID sql.NullInt64
+	PromisedDate  time.Time
+	Amount        float64
+	Notes         string
+	UserID        sql.NullInt64
+	Author        string
+}
+
+func (q *Queries) CreatePaymentPromise(ctx context.Context, arg CreatePaymentPromiseParams) (PaymentPromise, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentPromise,
+		arg.ClientID,
+		arg.InteractionID,
+		arg.PromisedDate,
+		arg.Amount,
+		arg.Notes,
+		arg.UserID,
+		arg.Author,
+	)
+	var i PaymentPromise
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.InteractionID,
+		&i.PromisedDate,
+		&i.Amount,
+		&i.Status,
+		&i.PaidAmount,
+		&i.ResolvedAt,
+		&i.Notes,
+		&i.UserID,
+		&i.Author,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getClientInteractions = `-- name: GetClientInteractions :many
+SELECT id, client_id, type, outcome, notes, user_id, author, created_at FROM client_interactions
+WHERE client_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type GetClientInteractionsParams struct {
+	ClientID int64
+	Limit    int64
+	Offset   int64
+}
+
+func (q *Queries) GetClientInteractions(ctx context.Context, arg GetClientInteractionsParams) ([]ClientInteraction, error) {
+	rows, err := q.db.QueryContext(ctx, getClientInteractions, arg.ClientID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClientInteraction
+	for rows.Next() {
+		var i ClientInteraction
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.Type,
+			&i.Outcome,
+			&i.Notes,
+			&i.UserID,
+			&i.Author,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getClientPaymentPromises = `-- name: GetClientPaymentPromises :many
+SELECT id, client_id, interaction_id, promised_date, amount, status, paid_amount, resolved_at, notes, user_id, author, created_at FROM payment_promises
+WHERE client_id = ?
+ORDER BY promised_date DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type GetClientPaymentPromisesParams struct {
+	ClientID int64
+	Limit    int64
+	Offset   int64
+}
+
+func (q *Queries) GetClientPaymentPromises(ctx context.Context, arg GetClientPaymentPromisesParams) ([]PaymentPromise, error) {
+	rows, err := q.db.QueryContext(ctx, getClientPaymentPromises, arg.ClientID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentPromise
+	for rows.Next() {
+		var i PaymentPromise
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.InteractionID,
+			&i.PromisedDate,
+			&i.Amount,
+			&i.Status,
+			&i.PaidAmount,
+			&i.ResolvedAt,
+			&i.Notes,
+			&i.UserID,
+			&i.Author,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentPromisesDue = `-- name: GetPaymentPromisesDue :many
+SELECT
+  pp.id,
+  pp.client_id,
+  pp.interaction_id,
+  pp.promised_date,
+  pp.amount,
+  pp.status,
+  pp.paid_amount,
+  pp.resolved_at,
+  pp.notes,
+  pp.user_id,
+  pp.author,
+  pp.created_at,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.phone AS client_phone,
+  c.address AS client_address
+FROM payment_promises pp
+  INNER JOIN clients c ON c.id = pp.client_id
+WHERE pp.status = 'pending'
+  AND pp.promised_date >= ?1
+  AND pp.promised_date < ?2
+ORDER BY c.lastname ASC, c.name ASC, pp.id ASC
+`
+
+type GetPaymentPromisesDueParams struct {
+	FromDate time.Time
+	ToDate   time.Time
+}
+
+type GetPaymentPromisesDueRow struct {
+	ID             int64
+	ClientID       int64
+	InteractionID  sql.NullInt64
+	PromisedDate   time.Time
+	Amount         float64
+	Status         string
+	PaidAmount     float64
+	ResolvedAt     sql.NullTime
+	Notes          string
+	UserID         sql.NullInt64
+	Author         string
+	CreatedAt      time.Time
+	ClientName     string
+	ClientLastname string
+	ClientPhone    sql.NullString
+	ClientAddress  sql.NullString
+}
+
+func (q *Queries) GetPaymentPromisesDue(ctx context.Context, arg GetPaymentPromisesDueParams) ([]GetPaymentPromisesDueRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPaymentPromisesDue, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaymentPromisesDueRow
+	for rows.Next() {
+		var i GetPaymentPromisesDueRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.InteractionID,
+			&i.PromisedDate,
+			&i.Amount,
+			&i.Status,
+			&i.PaidAmount,
+			&i.ResolvedAt,
+			&i.Notes,
+			&i.UserID,
+			&i.Author,
+			&i.CreatedAt,
+			&i.ClientName,
+			&i.ClientLastname,
+			&i.ClientPhone,
+			&i.ClientAddress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentPromisesToResolve = `-- name: GetPaymentPromisesToResolve :many
+SELECT
+  pp.id,
+  pp.client_id,
+  pp.amount,
+  pp.promised_date,
+  pp.status,
+  pp.paid_amount,
+  pp.created_at
+FROM payment_promises pp
+WHERE pp.client_id IN (
+  SELECT pending.client_id FROM payment_promises pending
+  WHERE pending.status = 'pending'
+    AND CAST(?1 AS INTEGER) = 0
+)
+  OR pp.client_id = ?1
+ORDER BY pp.client_id, pp.promised_date, pp.id
+`
+
+type GetPaymentPromisesToResolveRow struct {
+	ID           int64
+	ClientID     int64
+	Amount       float64
+	PromisedDate time.Time
+	Status       string
+	PaidAmount   float64
+	CreatedAt    time.Time
+}
+
+func (q *Queries) GetPaymentPromisesToResolve(ctx context.Context, clientID int64) ([]GetPaymentPromisesToResolveRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPaymentPromisesToResolve, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaymentPromisesToResolveRow
+	for rows.Next() {
+		var i GetPaymentPromisesToResolveRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.Amount,
+			&i.PromisedDate,
+			&i.Status,
+			&i.PaidAmount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPaymentsForPromises = `-- name: GetPaymentsForPromises :many
+SELECT
+  p.id,
+  p.client_id,
+  p.amount,
+  p.date,
+  p.created_at
+FROM payments p
+WHERE (
+  p.client_id IN (
+    SELECT pending.client_id FROM payment_promises pending
+    WHERE pending.status = 'pending'
+      AND CAST(?1 AS INTEGER) = 0
+  )
+  OR p.client_id = ?1
+)
+  AND p.created_at >= (SELECT MIN(earliest.created_at) FROM payment_promises earliest WHERE earliest.client_id = p.client_id)
+ORDER BY p.client_id, p.date, p.id
+`
+
+type GetPaymentsForPromisesRow struct {
+	ID        int64
+	ClientID  int64
+	Amount    float64
+	Date      time.Time
+	CreatedAt time.Time
+}
+
+func (q *Queries) GetPaymentsForPromises(ctx context.Context, clientID int64) ([]GetPaymentsForPromisesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPaymentsForPromises, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaymentsForPromisesRow
+	for rows.Next() {
+		var i GetPaymentsForPromisesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.Amount,
+			&i.Date,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePaymentPromiseStatus = `-- name: UpdatePaymentPromiseStatus :exec
+UPDATE payment_promises
+SET status = ?, paid_amount = ?, resolved_at = ?
+WHERE id = ?
+`
+
+type UpdatePaymentPromiseStatusParams struct {
+	Status     string
+	PaidAmount float64
+	ResolvedAt sql.NullTime
+	ID         int64
+}
+
+func (q *Queries) UpdatePaymentPromiseStatus(ctx context.Context, arg UpdatePaymentPromiseStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updatePaymentPromiseStatus,
+		arg.Status,
+		arg.PaidAmount,
+		arg.ResolvedAt,
+		arg.ID,
+	)
+	return err
+}
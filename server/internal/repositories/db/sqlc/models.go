@@ -9,17 +9,125 @@ import (
 	"time"
 )
 
+type ApiKey struct {
+	ID          int64
+	Name        string
+	KeyPrefix   string
+	KeyHash     string
+	Permissions int64
+	ExpiresAt   time.Time
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime
+	CreatedBy   sql.NullInt64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Attachment struct {
+	ID           int64
+	OwnerType    string
+	OwnerID      int64
+	FileName     string
+	ContentType  string
+	Size         int64
+	StorageKey   string
+	ThumbnailKey sql.NullString
+	UserID       sql.NullInt64
+	Author       string
+	CreatedAt    time.Time
+}
+
+type AuditLog struct {
+	ID        int64
+	ActorType string
+	ActorID   int64
+	ActorName string
+	Method    string
+	Route     string
+	Path      string
+	Status    int64
+	RequestID string
+	CreatedAt time.Time
+}
+
+type BusinessSetting struct {
+	ID            int64
+	Name          string
+	LegalName     string
+	Cuit          string
+	Address       string
+	Phone         string
+	Footer        string
+	Logo          []byte
+	LogoMime      sql.NullString
+	UpdatedAt     time.Time
+	TicketWidth   int64
+	TicketPrinter string
+	ContractTerms string
+}
+
 type Client struct {
+	ID              int64
+	Name            string
+	Lastname        string
+	Dni             string
+	Email           sql.NullString
+	Phone           sql.NullString
+	Address         sql.NullString
+	StateID         int64
+	CreatedAt       sql.NullTime
+	UpdatedAt       sql.NullTime
+	RemindersOptOut bool
+	CollectorID     sql.NullInt64
+	Zone            string
+	MaxBalance      sql.NullFloat64
+	MaxActiveSales  sql.NullInt64
+	RiskScore       int64
+	WarningCount    int64
+	SuspendedCount  int64
+}
+
+type ClientInteraction struct {
 	ID        int64
-	Name      string
-	Lastname  string
-	Dni       string
-	Email     sql.NullString
-	Phone     sql.NullString
-	Address   sql.NullString
-	StateID   int64
-	CreatedAt sql.NullTime
-	UpdatedAt sql.NullTime
+	ClientID  int64
+	Type      string
+	Outcome   string
+	Notes     string
+	UserID    sql.NullInt64
+	Author    string
+	CreatedAt time.Time
+}
+
+type CreditOverride struct {
+	ID         int64
+	SaleID     sql.NullInt64
+	ClientID   int64
+	UserID     sql.NullInt64
+	UserName   string
+	Reason     string
+	Violations string
+	Amount     float64
+	CreatedAt  time.Time
+}
+
+type CreditPolicy struct {
+	StateID        int64
+	AllowSales     bool
+	MaxBalance     sql.NullFloat64
+	MaxActiveSales sql.NullInt64
+	UpdatedAt      time.Time
+}
+
+type Guarantor struct {
+	ID           int64
+	Name         string
+	Lastname     string
+	Dni          string
+	Phone        string
+	Address      string
+	Relationship string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type Note struct {
@@ -31,13 +139,29 @@ type Note struct {
 }
 
 type Payment struct {
-	ID        int64
-	Amount    float64
-	Date      time.Time
-	QuotaID   int64
-	ClientID  int64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64
+	Amount      float64
+	Date        time.Time
+	QuotaID     int64
+	ClientID    int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CollectorID sql.NullInt64
+}
+
+type PaymentPromise struct {
+	ID            int64
+	ClientID      int64
+	InteractionID sql.NullInt64
+	PromisedDate  time.Time
+	Amount        float64
+	Status        string
+	PaidAmount    float64
+	ResolvedAt    sql.NullTime
+	Notes         string
+	UserID        sql.NullInt64
+	Author        string
+	CreatedAt     time.Time
 }
 
 type Product struct {
@@ -63,6 +187,38 @@ type Quota struct {
 	UpdatedAt time.Time
 }
 
+type ReceiptDelivery struct {
+	ID            int64
+	PaymentID     int64
+	ClientID      int64
+	Recipient     string
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	SentAt        sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type Reminder struct {
+	ID            int64
+	QuotaID       int64
+	ClientID      int64
+	Channel       string
+	OffsetDays    int64
+	Recipient     string
+	Subject       string
+	Body          string
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	SentAt        sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
 type Sale struct {
 	ID          int64
 	Description string
@@ -73,6 +229,13 @@ type Sale struct {
 	Date        time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	LegacyRef   sql.NullString
+}
+
+type SaleGuarantor struct {
+	SaleID      int64
+	GuarantorID int64
+	CreatedAt   time.Time
 }
 
 type SaleProduct struct {
@@ -93,14 +256,43 @@ type State struct {
 }
 
 type User struct {
-	ID           int64
-	Username     string
-	PasswordHash string
-	Permissions  int64
-	FirstName    sql.NullString
-	LastName     sql.NullString
-	IsActive     bool
-	LastLoginAt  sql.NullTime
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID             int64
+	Username       string
+	PasswordHash   string
+	Permissions    int64
+	FirstName      sql.NullString
+	LastName       sql.NullString
+	IsActive       bool
+	LastLoginAt    sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CommissionRate float64
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int64
+	ResponseStatus sql.NullInt64
+	ResponseBody   sql.NullString
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	DeliveredAt    sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type WebhookSubscription struct {
+	ID          int64
+	Url         string
+	Secret      string
+	Events      string
+	Description string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
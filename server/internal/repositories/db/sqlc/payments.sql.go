@@ -7,20 +7,22 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const createPayment = `-- name: CreatePayment :one
-INSERT INTO payments (amount, date, quota_id, client_id) 
-VALUES (?, ?, ?, ?) 
-RETURNING id, amount, date, quota_id, client_id, created_at, updated_at
+INSERT INTO payments (amount, date, quota_id, client_id, collector_id) 
+VALUES (?, ?, ?, ?, ?) 
+RETURNING id, amount, date, quota_id, client_id, created_at, updated_at, collector_id
 `
 
 type CreatePaymentParams struct {
-	Amount   float64
-	Date     time.Time
-	QuotaID  int64
-	ClientID int64
+	Amount      float64
+	Date        time.Time
+	QuotaID     int64
+	ClientID    int64
+	CollectorID sql.NullInt64
 }
 
 func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error) {
@@ -29,6 +31,7 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		arg.Date,
 		arg.QuotaID,
 		arg.ClientID,
+		arg.CollectorID,
 	)
 	var i Payment
 	err := row.Scan(
@@ -39,6 +42,7 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		&i.ClientID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CollectorID,
 	)
 	return i, err
 }
@@ -53,7 +57,7 @@ func (q *Queries) DeletePayment(ctx context.Context, id int64) error {
 }
 
 const getPaymentByID = `-- name: GetPaymentByID :one
-SELECT id, amount, date, quota_id, client_id, created_at, updated_at FROM payments WHERE id = ?
+SELECT id, amount, date, quota_id, client_id, created_at, updated_at, collector_id FROM payments WHERE id = ?
 `
 
 func (q *Queries) GetPaymentByID(ctx context.Context, id int64) (Payment, error) {
@@ -67,12 +71,13 @@ func (q *Queries) GetPaymentByID(ctx context.Context, id int64) (Payment, error)
 		&i.ClientID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CollectorID,
 	)
 	return i, err
 }
 
 const getQuotaPayments = `-- name: GetQuotaPayments :many
-SELECT id, amount, date, quota_id, client_id, created_at, updated_at FROM payments WHERE quota_id = ?
+SELECT id, amount, date, quota_id, client_id, created_at, updated_at, collector_id FROM payments WHERE quota_id = ?
 `
 
 func (q *Queries) GetQuotaPayments(ctx context.Context, quotaID int64) ([]Payment, error) {
@@ -92,6 +97,7 @@ func (q *Queries) GetQuotaPayments(ctx context.Context, quotaID int64) ([]Paymen
 			&i.ClientID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CollectorID,
 		); err != nil {
 			return nil, err
 		}
@@ -11,6 +11,37 @@ import (
 	"time"
 )
 
+const createImportedQuota = `-- name: CreateImportedQuota :one
+INSERT INTO quotas (number, amount, due_date, is_paid, state_id, sale_id, client_id)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id
+`
+
+type CreateImportedQuotaParams struct {
+	Number   int64
+	Amount   float64
+	DueDate  time.Time
+	IsPaid   sql.NullBool
+	StateID  int64
+	SaleID   int64
+	ClientID int64
+}
+
+func (q *Queries) CreateImportedQuota(ctx context.Context, arg CreateImportedQuotaParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createImportedQuota,
+		arg.Number,
+		arg.Amount,
+		arg.DueDate,
+		arg.IsPaid,
+		arg.StateID,
+		arg.SaleID,
+		arg.ClientID,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createQuota = `-- name: CreateQuota :exec
 INSERT INTO quotas (number, amount, due_date, sale_id, client_id)
 VALUES (?, ?, ?, ?, ?)
@@ -212,7 +243,11 @@ func (q *Queries) GetUnpaidQuotasForStateUpdate(ctx context.Context) ([]GetUnpai
 }
 
 const updateClientStateBulk = `-- name: UpdateClientStateBulk :exec
-UPDATE clients SET state_id = ? WHERE id = ?
+UPDATE clients
+SET state_id = ?1,
+  warning_count = warning_count + (CASE WHEN state_id != 2 AND ?1 = 2 THEN 1 ELSE 0 END),
+  suspended_count = suspended_count + (CASE WHEN state_id != 3 AND ?1 = 3 THEN 1 ELSE 0 END)
+WHERE id = ?2
 `
 
 type UpdateClientStateBulkParams struct {
@@ -220,6 +255,7 @@ type UpdateClientStateBulkParams struct {
 	ID      int64
 }
 
+// Cuenta las veces que el cliente pasa a Warning o a Suspended para el puntaje de riesgo
 func (q *Queries) UpdateClientStateBulk(ctx context.Context, arg UpdateClientStateBulkParams) error {
 	_, err := q.db.ExecContext(ctx, updateClientStateBulk, arg.StateID, arg.ID)
 	return err
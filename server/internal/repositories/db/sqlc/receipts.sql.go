@@ -0,0 +1,265 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: receipts.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createReceiptDelivery = `-- name: CreateReceiptDelivery :one
+INSERT INTO receipt_deliveries (payment_id, client_id, recipient, next_attempt_at)
+VALUES (?, ?, ?, ?)
+RETURNING id, payment_id, client_id, recipient, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at
+`
+
+type CreateReceiptDeliveryParams struct {
+	PaymentID     int64
+	ClientID      int64
+	Recipient     string
+	NextAttemptAt time.Time
+}
+
+func (q *Queries) CreateReceiptDelivery(ctx context.Context, arg CreateReceiptDeliveryParams) (ReceiptDelivery, error) {
+	row := q.db.QueryRowContext(ctx, createReceiptDelivery,
+		arg.PaymentID,
+		arg.ClientID,
+		arg.Recipient,
+		arg.NextAttemptAt,
+	)
+	var i ReceiptDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentID,
+		&i.ClientID,
+		&i.Recipient,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.SentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getDueReceiptDeliveries = `-- name: GetDueReceiptDeliveries :many
+SELECT id, payment_id, client_id, recipient, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM receipt_deliveries
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY next_attempt_at ASC
+LIMIT ?
+`
+
+type GetDueReceiptDeliveriesParams struct {
+	NextAttemptAt time.Time
+	Limit         int64
+}
+
+func (q *Queries) GetDueReceiptDeliveries(ctx context.Context, arg GetDueReceiptDeliveriesParams) ([]ReceiptDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getDueReceiptDeliveries, arg.NextAttemptAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReceiptDelivery
+	for rows.Next() {
+		var i ReceiptDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentID,
+			&i.ClientID,
+			&i.Recipient,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReceiptDeliveriesByPaymentID = `-- name: GetReceiptDeliveriesByPaymentID :many
+SELECT id, payment_id, client_id, recipient, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM receipt_deliveries WHERE payment_id = ? ORDER BY id DESC
+`
+
+func (q *Queries) GetReceiptDeliveriesByPaymentID(ctx context.Context, paymentID int64) ([]ReceiptDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getReceiptDeliveriesByPaymentID, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReceiptDelivery
+	for rows.Next() {
+		var i ReceiptDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentID,
+			&i.ClientID,
+			&i.Recipient,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReceiptDeliveryByID = `-- name: GetReceiptDeliveryByID :one
+SELECT id, payment_id, client_id, recipient, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM receipt_deliveries WHERE id = ?
+`
+
+func (q *Queries) GetReceiptDeliveryByID(ctx context.Context, id int64) (ReceiptDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getReceiptDeliveryByID, id)
+	var i ReceiptDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentID,
+		&i.ClientID,
+		&i.Recipient,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.SentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReceiptTarget = `-- name: GetReceiptTarget :one
+SELECT
+  p.id AS payment_id,
+  p.amount,
+  p.date,
+  q.number AS quota_number,
+  s.description AS sale_description,
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.email
+FROM payments p
+  INNER JOIN quotas q ON p.quota_id = q.id
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON p.client_id = c.id
+WHERE p.id = ?
+`
+
+type GetReceiptTargetRow struct {
+	PaymentID       int64
+	Amount          float64
+	Date            time.Time
+	QuotaNumber     int64
+	SaleDescription string
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	Email           sql.NullString
+}
+
+func (q *Queries) GetReceiptTarget(ctx context.Context, id int64) (GetReceiptTargetRow, error) {
+	row := q.db.QueryRowContext(ctx, getReceiptTarget, id)
+	var i GetReceiptTargetRow
+	err := row.Scan(
+		&i.PaymentID,
+		&i.Amount,
+		&i.Date,
+		&i.QuotaNumber,
+		&i.SaleDescription,
+		&i.ClientID,
+		&i.ClientName,
+		&i.ClientLastname,
+		&i.Email,
+	)
+	return i, err
+}
+
+const markReceiptDeliverySent = `-- name: MarkReceiptDeliverySent :exec
+UPDATE receipt_deliveries
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type MarkReceiptDeliverySentParams struct {
+	SentAt sql.NullTime
+	ID     int64
+}
+
+func (q *Queries) MarkReceiptDeliverySent(ctx context.Context, arg MarkReceiptDeliverySentParams) error {
+	_, err := q.db.ExecContext(ctx, markReceiptDeliverySent, arg.SentAt, arg.ID)
+	return err
+}
+
+const retryReceiptDelivery = `-- name: RetryReceiptDelivery :execrows
+UPDATE receipt_deliveries
+SET status = 'pending', next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'failed'
+`
+
+type RetryReceiptDeliveryParams struct {
+	NextAttemptAt time.Time
+	ID            int64
+}
+
+func (q *Queries) RetryReceiptDelivery(ctx context.Context, arg RetryReceiptDeliveryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryReceiptDelivery, arg.NextAttemptAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateReceiptDeliveryAttempt = `-- name: UpdateReceiptDeliveryAttempt :exec
+UPDATE receipt_deliveries
+SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateReceiptDeliveryAttemptParams struct {
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	ID            int64
+}
+
+func (q *Queries) UpdateReceiptDeliveryAttempt(ctx context.Context, arg UpdateReceiptDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, updateReceiptDeliveryAttempt,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
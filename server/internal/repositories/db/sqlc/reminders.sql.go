@@ -0,0 +1,363 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: reminders.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const enqueueReminder = `-- name: EnqueueReminder :execrows
+INSERT INTO reminders (quota_id, client_id, channel, offset_days, recipient, subject, body, next_attempt_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (quota_id, channel, offset_days) DO NOTHING
+`
+
+type EnqueueReminderParams struct {
+	QuotaID       int64
+	ClientID      int64
+	Channel       string
+	OffsetDays    int64
+	Recipient     string
+	Subject       string
+	Body          string
+	NextAttemptAt time.Time
+}
+
+func (q *Queries) EnqueueReminder(ctx context.Context, arg EnqueueReminderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, enqueueReminder,
+		arg.QuotaID,
+		arg.ClientID,
+		arg.Channel,
+		arg.OffsetDays,
+		arg.Recipient,
+		arg.Subject,
+		arg.Body,
+		arg.NextAttemptAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDueReminders = `-- name: GetDueReminders :many
+SELECT id, quota_id, client_id, channel, offset_days, recipient, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM reminders
+WHERE status = 'pending' AND next_attempt_at <= ?
+ORDER BY next_attempt_at ASC
+LIMIT ?
+`
+
+type GetDueRemindersParams struct {
+	NextAttemptAt time.Time
+	Limit         int64
+}
+
+func (q *Queries) GetDueReminders(ctx context.Context, arg GetDueRemindersParams) ([]Reminder, error) {
+	rows, err := q.db.QueryContext(ctx, getDueReminders, arg.NextAttemptAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reminder
+	for rows.Next() {
+		var i Reminder
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuotaID,
+			&i.ClientID,
+			&i.Channel,
+			&i.OffsetDays,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReminderByID = `-- name: GetReminderByID :one
+SELECT id, quota_id, client_id, channel, offset_days, recipient, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM reminders WHERE id = ?
+`
+
+func (q *Queries) GetReminderByID(ctx context.Context, id int64) (Reminder, error) {
+	row := q.db.QueryRowContext(ctx, getReminderByID, id)
+	var i Reminder
+	err := row.Scan(
+		&i.ID,
+		&i.QuotaID,
+		&i.ClientID,
+		&i.Channel,
+		&i.OffsetDays,
+		&i.Recipient,
+		&i.Subject,
+		&i.Body,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.SentAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReminderTarget = `-- name: GetReminderTarget :one
+SELECT
+  q.id AS quota_id,
+  q.number AS quota_number,
+  q.amount,
+  q.due_date,
+  q.is_paid,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  s.id AS sale_id,
+  s.description AS sale_description,
+  c.id AS client_id,
+  c.name AS client_name,
+  c.lastname AS client_lastname,
+  c.email,
+  c.phone,
+  c.reminders_opt_out
+FROM quotas q
+  INNER JOIN sales s ON q.sale_id = s.id
+  INNER JOIN clients c ON q.client_id = c.id
+WHERE q.id = ?
+`
+
+type GetReminderTargetRow struct {
+	QuotaID         int64
+	QuotaNumber     int64
+	Amount          float64
+	DueDate         time.Time
+	IsPaid          sql.NullBool
+	PaidAmount      float64
+	SaleID          int64
+	SaleDescription string
+	ClientID        int64
+	ClientName      string
+	ClientLastname  string
+	Email           sql.NullString
+	Phone           sql.NullString
+	RemindersOptOut bool
+}
+
+func (q *Queries) GetReminderTarget(ctx context.Context, id int64) (GetReminderTargetRow, error) {
+	row := q.db.QueryRowContext(ctx, getReminderTarget, id)
+	var i GetReminderTargetRow
+	err := row.Scan(
+		&i.QuotaID,
+		&i.QuotaNumber,
+		&i.Amount,
+		&i.DueDate,
+		&i.IsPaid,
+		&i.PaidAmount,
+		&i.SaleID,
+		&i.SaleDescription,
+		&i.ClientID,
+		&i.ClientName,
+		&i.ClientLastname,
+		&i.Email,
+		&i.Phone,
+		&i.RemindersOptOut,
+	)
+	return i, err
+}
+
+const getReminders = `-- name: GetReminders :many
+SELECT id, quota_id, client_id, channel, offset_days, recipient, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM reminders
+WHERE (CASE WHEN ? = '' THEN 1 ELSE status = ? END)
+ORDER BY id DESC
+LIMIT ? OFFSET ?
+`
+
+type GetRemindersParams struct {
+	Column1 interface{}
+	Status  string
+	Limit   int64
+	Offset  int64
+}
+
+func (q *Queries) GetReminders(ctx context.Context, arg GetRemindersParams) ([]Reminder, error) {
+	rows, err := q.db.QueryContext(ctx, getReminders,
+		arg.Column1,
+		arg.Status,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reminder
+	for rows.Next() {
+		var i Reminder
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuotaID,
+			&i.ClientID,
+			&i.Channel,
+			&i.OffsetDays,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRemindersByQuotaID = `-- name: GetRemindersByQuotaID :many
+SELECT id, quota_id, client_id, channel, offset_days, recipient, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at FROM reminders WHERE quota_id = ? ORDER BY id DESC
+`
+
+func (q *Queries) GetRemindersByQuotaID(ctx context.Context, quotaID int64) ([]Reminder, error) {
+	rows, err := q.db.QueryContext(ctx, getRemindersByQuotaID, quotaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reminder
+	for rows.Next() {
+		var i Reminder
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuotaID,
+			&i.ClientID,
+			&i.Channel,
+			&i.OffsetDays,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.SentAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markReminderSent = `-- name: MarkReminderSent :exec
+UPDATE reminders
+SET status = 'sent', attempts = attempts + 1, last_error = NULL, sent_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type MarkReminderSentParams struct {
+	SentAt sql.NullTime
+	ID     int64
+}
+
+func (q *Queries) MarkReminderSent(ctx context.Context, arg MarkReminderSentParams) error {
+	_, err := q.db.ExecContext(ctx, markReminderSent, arg.SentAt, arg.ID)
+	return err
+}
+
+const retryReminder = `-- name: RetryReminder :execrows
+UPDATE reminders
+SET status = 'pending', next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'failed'
+`
+
+type RetryReminderParams struct {
+	NextAttemptAt time.Time
+	ID            int64
+}
+
+func (q *Queries) RetryReminder(ctx context.Context, arg RetryReminderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryReminder, arg.NextAttemptAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateClientRemindersOptOut = `-- name: UpdateClientRemindersOptOut :execrows
+UPDATE clients SET reminders_opt_out = ? WHERE id = ?
+`
+
+type UpdateClientRemindersOptOutParams struct {
+	RemindersOptOut bool
+	ID              int64
+}
+
+func (q *Queries) UpdateClientRemindersOptOut(ctx context.Context, arg UpdateClientRemindersOptOutParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateClientRemindersOptOut, arg.RemindersOptOut, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateReminderAttempt = `-- name: UpdateReminderAttempt :exec
+UPDATE reminders
+SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateReminderAttemptParams struct {
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	ID            int64
+}
+
+func (q *Queries) UpdateReminderAttempt(ctx context.Context, arg UpdateReminderAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, updateReminderAttempt,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
@@ -0,0 +1,116 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: risk.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const getClientsRiskCounters = `-- name: GetClientsRiskCounters :many
+SELECT id, warning_count, suspended_count, risk_score
+FROM clients
+`
+
+type GetClientsRiskCountersRow struct {
+	ID             int64
+	WarningCount   int64
+	SuspendedCount int64
+	RiskScore      int64
+}
+
+func (q *Queries) GetClientsRiskCounters(ctx context.Context) ([]GetClientsRiskCountersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getClientsRiskCounters)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetClientsRiskCountersRow
+	for rows.Next() {
+		var i GetClientsRiskCountersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WarningCount,
+			&i.SuspendedCount,
+			&i.RiskScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuotasForRiskScore = `-- name: GetQuotasForRiskScore :many
+SELECT
+  q.client_id,
+  q.amount,
+  q.due_date,
+  q.is_paid,
+  CAST(COALESCE((SELECT SUM(p.amount) FROM payments p WHERE p.quota_id = q.id), 0) AS REAL) AS paid_amount,
+  CAST(COALESCE((SELECT MAX(julianday(p.date)) FROM payments p WHERE p.quota_id = q.id) - julianday(q.due_date), 0) AS REAL) AS days_late_paid
+FROM quotas q
+`
+
+type GetQuotasForRiskScoreRow struct {
+	ClientID     int64
+	Amount       float64
+	DueDate      time.Time
+	IsPaid       sql.NullBool
+	PaidAmount   float64
+	DaysLatePaid float64
+}
+
+func (q *Queries) GetQuotasForRiskScore(ctx context.Context) ([]GetQuotasForRiskScoreRow, error) {
+	rows, err := q.db.QueryContext(ctx, getQuotasForRiskScore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetQuotasForRiskScoreRow
+	for rows.Next() {
+		var i GetQuotasForRiskScoreRow
+		if err := rows.Scan(
+			&i.ClientID,
+			&i.Amount,
+			&i.DueDate,
+			&i.IsPaid,
+			&i.PaidAmount,
+			&i.DaysLatePaid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateClientRiskScore = `-- name: UpdateClientRiskScore :exec
+UPDATE clients SET risk_score = ? WHERE id = ?
+`
+
+type UpdateClientRiskScoreParams struct {
+	RiskScore int64
+	ID        int64
+}
+
+func (q *Queries) UpdateClientRiskScore(ctx context.Context, arg UpdateClientRiskScoreParams) error {
+	_, err := q.db.ExecContext(ctx, updateClientRiskScore, arg.RiskScore, arg.ID)
+	return err
+}
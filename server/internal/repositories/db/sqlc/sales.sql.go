@@ -7,9 +7,39 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
+const createImportedSale = `-- name: CreateImportedSale :one
+INSERT INTO sales (description, amount, is_paid, client_id, date, legacy_ref)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING id
+`
+
+type CreateImportedSaleParams struct {
+	Description string
+	Amount      float64
+	IsPaid      bool
+	ClientID    int64
+	Date        time.Time
+	LegacyRef   sql.NullString
+}
+
+func (q *Queries) CreateImportedSale(ctx context.Context, arg CreateImportedSaleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, createImportedSale,
+		arg.Description,
+		arg.Amount,
+		arg.IsPaid,
+		arg.ClientID,
+		arg.Date,
+		arg.LegacyRef,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createSale = `-- name: CreateSale :one
 INSERT INTO sales (description, amount, client_id, date)
 VALUES (?, ?, ?, ?)
@@ -101,7 +131,7 @@ func (q *Queries) GetPendingSalesOrderedByClient(ctx context.Context) ([]GetPend
 }
 
 const getSaleByID = `-- name: GetSaleByID :one
-SELECT id, description, amount, is_paid, state_id, client_id, date, created_at, updated_at FROM sales WHERE id = ?
+SELECT id, description, amount, is_paid, state_id, client_id, date, created_at, updated_at, legacy_ref FROM sales WHERE id = ?
 `
 
 func (q *Queries) GetSaleByID(ctx context.Context, id int64) (Sale, error) {
@@ -117,10 +147,38 @@ func (q *Queries) GetSaleByID(ctx context.Context, id int64) (Sale, error) {
 		&i.Date,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LegacyRef,
 	)
 	return i, err
 }
 
+const getSaleLegacyRefs = `-- name: GetSaleLegacyRefs :many
+SELECT legacy_ref FROM sales WHERE legacy_ref IS NOT NULL
+`
+
+func (q *Queries) GetSaleLegacyRefs(ctx context.Context) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, getSaleLegacyRefs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullString
+	for rows.Next() {
+		var legacy_ref sql.NullString
+		if err := rows.Scan(&legacy_ref); err != nil {
+			return nil, err
+		}
+		items = append(items, legacy_ref)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSalesByClientID = `-- name: GetSalesByClientID :many
 SELECT 
   s.id,
@@ -0,0 +1,415 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhooks.sql
+
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (url, secret, events, description, active)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, url, secret, events, description, active, created_at, updated_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	Url         string
+	Secret      string
+	Events      string
+	Description string
+	Active      bool
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookSubscription,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.Description,
+		arg.Active,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Description,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions WHERE id = ?
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookSubscription, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const enqueueWebhookDelivery = `-- name: EnqueueWebhookDelivery :one
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id, subscription_id, event_id, event_type, payload, status, attempts, response_status, response_body, last_error, next_attempt_at, delivered_at, created_at, updated_at
+`
+
+type EnqueueWebhookDeliveryParams struct {
+	SubscriptionID int64
+	EventID        string
+	EventType      string
+	Payload        string
+	NextAttemptAt  time.Time
+}
+
+func (q *Queries) EnqueueWebhookDelivery(ctx context.Context, arg EnqueueWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, enqueueWebhookDelivery,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.NextAttemptAt,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getActiveWebhookSubscriptions = `-- name: GetActiveWebhookSubscriptions :many
+SELECT id, url, secret, events, description, active, created_at, updated_at FROM webhook_subscriptions WHERE active = true ORDER BY id
+`
+
+func (q *Queries) GetActiveWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueWebhookDeliveries = `-- name: GetDueWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, response_status, response_body, last_error, next_attempt_at, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE status = 'pending' AND next_attempt_at <= ?
+  AND subscription_id IN (SELECT id FROM webhook_subscriptions WHERE active = true)
+ORDER BY next_attempt_at ASC
+LIMIT ?
+`
+
+type GetDueWebhookDeliveriesParams struct {
+	NextAttemptAt time.Time
+	Limit         int64
+}
+
+// Las entregas de suscripciones desactivadas quedan pendientes hasta que se reactiven
+func (q *Queries) GetDueWebhookDeliveries(ctx context.Context, arg GetDueWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getDueWebhookDeliveries, arg.NextAttemptAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, response_status, response_body, last_error, next_attempt_at, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE subscription_id = ? AND (CASE WHEN ? = '' THEN 1 ELSE status = ? END)
+ORDER BY id DESC
+LIMIT ? OFFSET ?
+`
+
+type GetWebhookDeliveriesParams struct {
+	SubscriptionID int64
+	Column2        interface{}
+	Status         string
+	Limit          int64
+	Offset         int64
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, getWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Column2,
+		arg.Status,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDeliveryByID = `-- name: GetWebhookDeliveryByID :one
+SELECT id, subscription_id, event_id, event_type, payload, status, attempts, response_status, response_body, last_error, next_attempt_at, delivered_at, created_at, updated_at FROM webhook_deliveries WHERE id = ?
+`
+
+func (q *Queries) GetWebhookDeliveryByID(ctx context.Context, id int64) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDeliveryByID, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.SubscriptionID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscriptionByID = `-- name: GetWebhookSubscriptionByID :one
+SELECT id, url, secret, events, description, active, created_at, updated_at FROM webhook_subscriptions WHERE id = ?
+`
+
+func (q *Queries) GetWebhookSubscriptionByID(ctx context.Context, id int64) (WebhookSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookSubscriptionByID, id)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Description,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscriptions = `-- name: GetWebhookSubscriptions :many
+SELECT id, url, secret, events, description, active, created_at, updated_at FROM webhook_subscriptions ORDER BY id
+`
+
+func (q *Queries) GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, getWebhookSubscriptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDeliveryDelivered = `-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, response_status = ?, response_body = ?, last_error = NULL,
+    delivered_at = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type MarkWebhookDeliveryDeliveredParams struct {
+	ResponseStatus sql.NullInt64
+	ResponseBody   sql.NullString
+	DeliveredAt    sql.NullTime
+	ID             int64
+}
+
+func (q *Queries) MarkWebhookDeliveryDelivered(ctx context.Context, arg MarkWebhookDeliveryDeliveredParams) error {
+	_, err := q.db.ExecContext(ctx, markWebhookDeliveryDelivered,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateWebhookDeliveryAttempt = `-- name: UpdateWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = ?, attempts = ?, response_status = ?, response_body = ?, last_error = ?, next_attempt_at = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateWebhookDeliveryAttemptParams struct {
+	Status         string
+	Attempts       int64
+	ResponseStatus sql.NullInt64
+	ResponseBody   sql.NullString
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	ID             int64
+}
+
+func (q *Queries) UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookDeliveryAttempt,
+		arg.Status,
+		arg.Attempts,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET url = ?, secret = ?, events = ?, description = ?, active = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	Url         string
+	Secret      string
+	Events      string
+	Description string
+	Active      bool
+	ID          int64
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateWebhookSubscription,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.Description,
+		arg.Active,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) ExportClients(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.ClientExport, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	f := newClientFilter(filter)
+	rows, err := r.Queries.ExportClients(ctx, sqlc.ExportClientsParams{
+		ID:       afterID,
+		Name:     f.search,
+		Lastname: f.search,
+		Dni:      f.search,
+		Column5:  f.stateFilter,
+		StateIds: f.stateIDs,
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	clients := make([]*domain.ClientExport, len(rows))
+	for i, row := range rows {
+		clients[i] = &domain.ClientExport{
+			ID:        row.ID,
+			Name:      row.Name,
+			Lastname:  row.Lastname,
+			Dni:       row.Dni,
+			Email:     utils.ParseToEmptyString(row.Email),
+			Phone:     utils.ParseToEmptyString(row.Phone),
+			Address:   utils.ParseToEmptyString(row.Address),
+			State:     row.StateDescription,
+			CreatedAt: parseNullTime(row.CreatedAt),
+		}
+	}
+
+	return clients, nil
+}
+
+func (r *Repository) ExportSales(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.SaleExport, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	f := newClientFilter(filter)
+	rows, err := r.Queries.ExportSales(ctx, sqlc.ExportSalesParams{
+		ID:       afterID,
+		Name:     f.search,
+		Lastname: f.search,
+		Dni:      f.search,
+		Column5:  f.stateFilter,
+		StateIds: f.stateIDs,
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	sales := make([]*domain.SaleExport, len(rows))
+	for i, row := range rows {
+		sales[i] = &domain.SaleExport{
+			ID:             row.ID,
+			Date:           row.Date,
+			ClientDni:      row.ClientDni,
+			ClientName:     row.ClientName,
+			ClientLastname: row.ClientLastname,
+			Products:       row.Products,
+			Amount:         row.Amount,
+			PaidAmount:     row.PaidAmount,
+			QuotaCount:     row.QuotaCount,
+			IsPaid:         row.IsPaid,
+			State:          row.StateDescription,
+		}
+	}
+
+	return sales, nil
+}
+
+func (r *Repository) ExportQuotas(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.QuotaExport, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	f := newClientFilter(filter)
+	rows, err := r.Queries.ExportQuotas(ctx, sqlc.ExportQuotasParams{
+		ID:       afterID,
+		Name:     f.search,
+		Lastname: f.search,
+		Dni:      f.search,
+		Column5:  f.stateFilter,
+		StateIds: f.stateIDs,
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	quotas := make([]*domain.QuotaExport, len(rows))
+	for i, row := range rows {
+		quotas[i] = &domain.QuotaExport{
+			ID:             row.ID,
+			SaleID:         row.SaleID,
+			Number:         row.Number,
+			ClientDni:      row.ClientDni,
+			ClientName:     row.ClientName,
+			ClientLastname: row.ClientLastname,
+			Amount:         row.Amount,
+			DueDate:        row.DueDate,
+			PaidAmount:     row.PaidAmount,
+			IsPaid:         row.IsPaid.Bool,
+			State:          row.StateDescription,
+		}
+	}
+
+	return quotas, nil
+}
+
+func (r *Repository) ExportPayments(ctx context.Context, filter *dto.ExportFilter, afterID int64, limit int) ([]*domain.PaymentExport, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	f := newClientFilter(filter)
+	from, to := dateRange(filter)
+	rows, err := r.Queries.ExportPayments(ctx, sqlc.ExportPaymentsParams{
+		ID:       afterID,
+		Date:     from,
+		Date_2:   to,
+		Name:     f.search,
+		Lastname: f.search,
+		Dni:      f.search,
+		Column7:  f.stateFilter,
+		StateIds: f.stateIDs,
+		Limit:    int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	payments := make([]*domain.PaymentExport, len(rows))
+	for i, row := range rows {
+		payments[i] = &domain.PaymentExport{
+			ID:             row.ID,
+			Date:           row.Date,
+			Amount:         row.Amount,
+			SaleID:         row.SaleID,
+			QuotaNumber:    row.QuotaNumber,
+			ClientDni:      row.ClientDni,
+			ClientName:     row.ClientName,
+			ClientLastname: row.ClientLastname,
+		}
+	}
+
+	return payments, nil
+}
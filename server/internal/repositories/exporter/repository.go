@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.ExportRepository
+// at compile time
+var _ ports.ExportRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+// clientFilter traduce los filtros de GET /api/clients a los parámetros de las consultas
+type clientFilter struct {
+	search      string
+	stateFilter string
+	stateIDs    []int64
+}
+
+func newClientFilter(filter *dto.ExportFilter) clientFilter {
+	f := clientFilter{search: filter.Search + "%", stateIDs: filter.StateIDs}
+	// Igual que GetClients: con los 3 estados (o ninguno) no se filtra
+	if len(filter.StateIDs) > 0 && len(filter.StateIDs) < 3 {
+		f.stateFilter = "filter"
+	}
+	return f
+}
+
+// dateRange devuelve [desde, hasta) a partir de un rango inclusive por día
+func dateRange(filter *dto.ExportFilter) (time.Time, time.Time) {
+	from := time.Time{}
+	if filter.From != nil {
+		from = *filter.From
+	}
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if filter.To != nil {
+		to = filter.To.AddDate(0, 0, 1)
+	}
+	return from, to
+}
+
+func parseNullTime(nt sql.NullTime) *time.Time {
+	if nt.Valid {
+		return &nt.Time
+	}
+	return nil
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, guarantor *domain.Guarantor) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.CreateGuarantor(ctx, sqlc.CreateGuarantorParams{
+		Name:         guarantor.Name,
+		Lastname:     guarantor.Lastname,
+		Dni:          guarantor.Dni,
+		Phone:        guarantor.Phone,
+		Address:      guarantor.Address,
+		Relationship: guarantor.Relationship,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*guarantor = *toDomain(row)
+	return nil
+}
+
+func (r *Repository) Link(ctx context.Context, saleID, guarantorID int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.LinkSaleGuarantor(ctx, sqlc.LinkSaleGuarantorParams{
+		SaleID:      saleID,
+		GuarantorID: guarantorID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.DeleteGuarantor(ctx, id)
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) Unlink(ctx context.Context, saleID, guarantorID int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.UnlinkSaleGuarantor(ctx, sqlc.UnlinkSaleGuarantorParams{
+		SaleID:      saleID,
+		GuarantorID: guarantorID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// GetByID devuelve el garante con las ventas que garantiza
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Guarantor, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetGuarantorByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	saleIDs, err := r.Queries.GetGuarantorSaleIDs(ctx, id)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	guarantor := toDomain(row)
+	guarantor.SaleIDs = saleIDs
+	return guarantor, nil
+}
+
+func (r *Repository) GetAll(ctx context.Context, search string, limit, offset int) ([]*domain.Guarantor, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetGuarantors(ctx, sqlc.GetGuarantorsParams{
+		Name:     search + "%",
+		Lastname: search + "%",
+		Dni:      search + "%",
+		Limit:    int64(limit),
+		Offset:   int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	guarantors := make([]*domain.Guarantor, len(rows))
+	for i, row := range rows {
+		guarantors[i] = toDomain(row)
+	}
+	return guarantors, nil
+}
+
+func (r *Repository) GetBySaleIDs(ctx context.Context, saleIDs []int64) (map[int64][]*domain.Guarantor, error) {
+	guarantors := make(map[int64][]*domain.Guarantor)
+	if len(saleIDs) == 0 {
+		return guarantors, nil
+	}
+
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetGuarantorsBySaleIDs(ctx, saleIDs)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	for _, row := range rows {
+		guarantors[row.SaleID] = append(guarantors[row.SaleID], &domain.Guarantor{
+			ID:           row.ID,
+			Name:         row.Name,
+			Lastname:     row.Lastname,
+			Dni:          row.Dni,
+			Phone:        row.Phone,
+			Address:      row.Address,
+			Relationship: row.Relationship,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+		})
+	}
+	return guarantors, nil
+}
+
+func (r *Repository) GetFollowUps(ctx context.Context, stateID int, before time.Time) ([]*domain.GuarantorFollowUp, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetGuarantorFollowUps(ctx, sqlc.GetGuarantorFollowUpsParams{
+		StateID: int64(stateID),
+		Before:  before,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	followUps := make([]*domain.GuarantorFollowUp, len(rows))
+	for i, row := range rows {
+		followUps[i] = &domain.GuarantorFollowUp{
+			ClientID:        row.ClientID,
+			ClientName:      row.ClientName,
+			ClientLastname:  row.ClientLastname,
+			ClientDni:       row.ClientDni,
+			ClientPhone:     utils.ParseToEmptyString(row.ClientPhone),
+			ClientAddress:   utils.ParseToEmptyString(row.ClientAddress),
+			SaleID:          row.SaleID,
+			SaleDescription: row.SaleDescription,
+			OverdueQuotas:   row.OverdueQuotas,
+			OverdueAmount:   row.OverdueAmount,
+		}
+	}
+	return followUps, nil
+}
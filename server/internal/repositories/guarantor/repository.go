@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.GuarantorRepository
+// at compile time
+var _ ports.GuarantorRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
+
+func manageError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return domain.ErrTimeout
+	case strings.Contains(err.Error(), "UNIQUE constraint failed"):
+		return fmt.Errorf("%w: %s", domain.ErrDuplicateKey, err.Error())
+	case strings.Contains(err.Error(), "FOREIGN KEY constraint failed"):
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+func toDomain(row sqlc.Guarantor) *domain.Guarantor {
+	return &domain.Guarantor{
+		ID:           row.ID,
+		Name:         row.Name,
+		Lastname:     row.Lastname,
+		Dni:          row.Dni,
+		Phone:        row.Phone,
+		Address:      row.Address,
+		Relationship: row.Relationship,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
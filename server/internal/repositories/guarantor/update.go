@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Update(ctx context.Context, guarantor *domain.Guarantor) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	affected, err := r.Queries.UpdateGuarantor(ctx, sqlc.UpdateGuarantorParams{
+		Name:         guarantor.Name,
+		Lastname:     guarantor.Lastname,
+		Dni:          guarantor.Dni,
+		Phone:        guarantor.Phone,
+		Address:      guarantor.Address,
+		Relationship: guarantor.Relationship,
+		ID:           guarantor.ID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
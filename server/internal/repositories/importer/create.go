@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// InsertClients inserta todos los clientes en una única transacción: o entran todos o ninguno
+func (r *Repository) InsertClients(ctx context.Context, clients []*domain.Client) error {
+	ctx, cancel := getContext()
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return manageError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.Queries.WithTx(tx)
+
+	for _, c := range clients {
+		_, err := qtx.InsertClient(ctx, sqlc.InsertClientParams{
+			Name:     c.Name,
+			Lastname: c.Lastname,
+			Dni:      c.Dni,
+			Email:    utils.ParseToSqlNullString(c.Email),
+			Phone:    utils.ParseToSqlNullString(c.Phone),
+			Address:  utils.ParseToSqlNullString(c.Address),
+		})
+		if err != nil {
+			if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+				return fmt.Errorf("%w: dni %s", domain.ErrDuplicateKey, c.Dni)
+			}
+			return manageError(err)
+		}
+	}
+
+	return manageError(tx.Commit())
+}
+
+// InsertProducts inserta todos los productos en una única transacción
+func (r *Repository) InsertProducts(ctx context.Context, products []*domain.Product) error {
+	ctx, cancel := getContext()
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return manageError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.Queries.WithTx(tx)
+
+	for _, p := range products {
+		_, err := qtx.CreateProduct(ctx, sqlc.CreateProductParams{
+			Name:  p.Name,
+			Cost:  utils.ParseToSqlNullFloat64(p.Cost),
+			Price: utils.ParseToSqlNullFloat64(p.Price),
+			Stock: int64(p.Stock),
+		})
+		if err != nil {
+			return manageError(err)
+		}
+	}
+
+	return manageError(tx.Commit())
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// InsertSales inserta las ventas históricas con sus productos, cuotas y pagos en una única transacción.
+// No descuenta stock: son ventas que ya se entregaron. Devuelve los IDs de las ventas creadas.
+func (r *Repository) InsertSales(ctx context.Context, sales []*domain.ImportedSale) ([]int64, error) {
+	ctx, cancel := getContext()
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, manageError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.Queries.WithTx(tx)
+
+	saleIDs := make([]int64, 0, len(sales))
+	for _, s := range sales {
+		clientID := s.ClientID.(int64)
+
+		saleID, err := qtx.CreateImportedSale(ctx, sqlc.CreateImportedSaleParams{
+			Description: s.Description,
+			Amount:      s.Amount,
+			IsPaid:      s.IsPaid,
+			ClientID:    clientID,
+			Date:        *s.Date,
+			LegacyRef:   utils.ParseToSqlNullString(s.Ref),
+		})
+		if err != nil {
+			if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+				return nil, fmt.Errorf("%w: sale %s", domain.ErrDuplicateKey, s.Ref)
+			}
+			return nil, manageError(err)
+		}
+
+		for _, p := range s.Products {
+			err := qtx.CreateSaleProduct(ctx, sqlc.CreateSaleProductParams{
+				Name:     p.Name,
+				Cost:     utils.ParseToSqlNullFloat64(p.Cost),
+				Price:    utils.ParseToSqlNullFloat64(p.Price),
+				Quantity: p.Quantity,
+				SaleID:   saleID,
+				ClientID: clientID,
+			})
+			if err != nil {
+				return nil, manageError(err)
+			}
+		}
+
+		for _, q := range s.Quotas {
+			quotaID, err := qtx.CreateImportedQuota(ctx, sqlc.CreateImportedQuotaParams{
+				Number:   int64(q.Number),
+				Amount:   q.Amount,
+				DueDate:  *q.DueDate,
+				IsPaid:   sql.NullBool{Bool: q.IsPaid, Valid: true},
+				StateID:  int64(q.StateID),
+				SaleID:   saleID,
+				ClientID: clientID,
+			})
+			if err != nil {
+				return nil, manageError(err)
+			}
+
+			for _, p := range q.Payments {
+				// La fecha original del pago se conserva
+				_, err := qtx.CreatePayment(ctx, sqlc.CreatePaymentParams{
+					Amount:   p.Amount,
+					Date:     *p.Date,
+					QuotaID:  quotaID,
+					ClientID: clientID,
+				})
+				if err != nil {
+					return nil, manageError(err)
+				}
+			}
+		}
+
+		saleIDs = append(saleIDs, saleID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, manageError(err)
+	}
+
+	return saleIDs, nil
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetClientDNIs(ctx context.Context) ([]string, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	dnis, err := r.Queries.GetAllClientDNIs(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return dnis, nil
+}
+
+// GetClientIDsByDNI devuelve dni -> id de todos los clientes
+func (r *Repository) GetClientIDsByDNI(ctx context.Context) (map[string]int64, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetClientIDsByDNI(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	ids := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		ids[row.Dni] = row.ID
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) GetSaleLegacyRefs(ctx context.Context) ([]string, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetSaleLegacyRefs(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	refs := make([]string, 0, len(rows))
+	for _, ref := range rows {
+		refs = append(refs, ref.String)
+	}
+
+	return refs, nil
+}
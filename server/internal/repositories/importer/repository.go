@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.ImportRepository
+// at compile time
+var _ ports.ImportRepository = &Repository{}
+
+// importTimeout es mayor que el de utils.GetContext: una importación puede tener miles de filas
+const importTimeout = 2 * time.Minute
+
+type Repository struct {
+	Queries *sqlc.Queries
+	DB      *sql.DB
+}
+
+func getContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), importTimeout)
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
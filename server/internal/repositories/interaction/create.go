@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, interaction *domain.ClientInteraction) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	qtx := r.Queries.WithTx(tx)
+
+	row, err := qtx.CreateClientInteraction(ctx, sqlc.CreateClientInteractionParams{
+		ClientID: interaction.ClientID,
+		Type:     interaction.Type,
+		Outcome:  interaction.Outcome,
+		Notes:    interaction.Notes,
+		UserID:   utils.ParseToSqlNullInt64(interaction.UserID),
+		Author:   interaction.Author,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	promise := interaction.Promise
+	*interaction = *toInteraction(row)
+
+	if promise != nil {
+		promise.ClientID = row.ClientID
+		promise.InteractionID = &row.ID
+		if err := createPromise(ctx, qtx, promise); err != nil {
+			return err
+		}
+		interaction.Promise = promise
+	}
+
+	if err := tx.Commit(); err != nil {
+		return manageError(err)
+	}
+	return nil
+}
+
+func (r *Repository) CreatePromise(ctx context.Context, promise *domain.PaymentPromise) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	return createPromise(ctx, r.Queries, promise)
+}
+
+func createPromise(ctx context.Context, queries *sqlc.Queries, promise *domain.PaymentPromise) error {
+	row, err := queries.CreatePaymentPromise(ctx, sqlc.CreatePaymentPromiseParams{
+		ClientID:      promise.ClientID,
+		InteractionID: utils.ParseToSqlNullInt64(promise.InteractionID),
+		PromisedDate:  promise.PromisedDate,
+		Amount:        promise.Amount,
+		Notes:         promise.Notes,
+		UserID:        utils.ParseToSqlNullInt64(promise.UserID),
+		Author:        promise.Author,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*promise = *toPromise(row)
+	return nil
+}
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.ClientInteraction, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetClientInteractions(ctx, sqlc.GetClientInteractionsParams{
+		ClientID: clientID,
+		Limit:    int64(limit),
+		Offset:   int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	interactions := make([]*domain.ClientInteraction, len(rows))
+	for i, row := range rows {
+		interactions[i] = toInteraction(row)
+	}
+	return interactions, nil
+}
+
+func (r *Repository) GetPromisesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.PaymentPromise, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetClientPaymentPromises(ctx, sqlc.GetClientPaymentPromisesParams{
+		ClientID: clientID,
+		Limit:    int64(limit),
+		Offset:   int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	promises := make([]*domain.PaymentPromise, len(rows))
+	for i, row := range rows {
+		promises[i] = toPromise(row)
+	}
+	return promises, nil
+}
+
+func (r *Repository) GetPromisesToResolve(ctx context.Context, clientID int64) ([]*domain.PromiseToResolve, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetPaymentPromisesToResolve(ctx, clientID)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	promises := make([]*domain.PromiseToResolve, len(rows))
+	for i, row := range rows {
+		promises[i] = &domain.PromiseToResolve{
+			ID:           row.ID,
+			ClientID:     row.ClientID,
+			Amount:       row.Amount,
+			PromisedDate: row.PromisedDate,
+			Status:       row.Status,
+			PaidAmount:   row.PaidAmount,
+			CreatedAt:    row.CreatedAt,
+		}
+	}
+	return promises, nil
+}
+
+func (r *Repository) GetPaymentsForPromises(ctx context.Context, clientID int64) ([]*domain.PromisePayment, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetPaymentsForPromises(ctx, clientID)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	payments := make([]*domain.PromisePayment, len(rows))
+	for i, row := range rows {
+		payments[i] = &domain.PromisePayment{
+			ID:        row.ID,
+			ClientID:  row.ClientID,
+			Amount:    row.Amount,
+			Date:      row.Date,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return payments, nil
+}
+
+func (r *Repository) GetPromisesDue(ctx context.Context, from, to time.Time) ([]*domain.PaymentPromise, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetPaymentPromisesDue(ctx, sqlc.GetPaymentPromisesDueParams{
+		FromDate: from,
+		ToDate:   to,
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	promises := make([]*domain.PaymentPromise, len(rows))
+	for i, row := range rows {
+		promise := toPromise(sqlc.PaymentPromise{
+			ID:            row.ID,
+			ClientID:      row.ClientID,
+			InteractionID: row.InteractionID,
+			PromisedDate:  row.PromisedDate,
+			Amount:        row.Amount,
+			Status:        row.Status,
+			PaidAmount:    row.PaidAmount,
+			ResolvedAt:    row.ResolvedAt,
+			Notes:         row.Notes,
+			UserID:        row.UserID,
+			Author:        row.Author,
+			CreatedAt:     row.CreatedAt,
+		})
+		promise.ClientName = row.ClientName
+		promise.ClientLastname = row.ClientLastname
+		promise.ClientPhone = utils.ParseToEmptyString(row.ClientPhone)
+		promise.ClientAddress = utils.ParseToEmptyString(row.ClientAddress)
+		promises[i] = promise
+	}
+	return promises, nil
+}
@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// Make sure Repository implements ports.InteractionRepository
+// at compile time
+var _ ports.InteractionRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+	DB      *sql.DB
+}
+
+func manageError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return domain.ErrTimeout
+	case strings.Contains(err.Error(), "UNIQUE constraint failed"):
+		return fmt.Errorf("%w: %s", domain.ErrDuplicateKey, err.Error())
+	case strings.Contains(err.Error(), "FOREIGN KEY constraint failed"):
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+func toInteraction(row sqlc.ClientInteraction) *domain.ClientInteraction {
+	return &domain.ClientInteraction{
+		ID:        row.ID,
+		ClientID:  row.ClientID,
+		Type:      row.Type,
+		Outcome:   row.Outcome,
+		Notes:     row.Notes,
+		UserID:    utils.ParseToInt64Ptr(row.UserID),
+		Author:    row.Author,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func toPromise(row sqlc.PaymentPromise) *domain.PaymentPromise {
+	promise := &domain.PaymentPromise{
+		ID:            row.ID,
+		ClientID:      row.ClientID,
+		InteractionID: utils.ParseToInt64Ptr(row.InteractionID),
+		PromisedDate:  row.PromisedDate,
+		Amount:        row.Amount,
+		Status:        row.Status,
+		PaidAmount:    row.PaidAmount,
+		Notes:         row.Notes,
+		UserID:        utils.ParseToInt64Ptr(row.UserID),
+		Author:        row.Author,
+		CreatedAt:     row.CreatedAt,
+	}
+	if row.ResolvedAt.Valid {
+		promise.ResolvedAt = &row.ResolvedAt.Time
+	}
+	return promise
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) UpdatePromiseStatus(ctx context.Context, id int64, status string, paidAmount float64, resolvedAt *time.Time) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	params := sqlc.UpdatePaymentPromiseStatusParams{
+		Status:     status,
+		PaidAmount: paidAmount,
+		ID:         id,
+	}
+	if resolvedAt != nil {
+		params.ResolvedAt = sql.NullTime{Time: *resolvedAt, Valid: true}
+	}
+
+	if err := r.Queries.UpdatePaymentPromiseStatus(ctx, params); err != nil {
+		return manageError(err)
+	}
+	return nil
+}
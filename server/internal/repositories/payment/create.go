@@ -24,12 +24,18 @@ func (r *Repository) Create(payment *domain.Payment) error {
 		date = *payment.Date
 	}
 
-	_, err = r.Queries.CreatePayment(ctx, sqlc.CreatePaymentParams{
-		Amount:   payment.Amount,
-		Date:     date,
-		QuotaID:  quota.ID,
-		ClientID: quota.ClientID,
+	created, err := r.Queries.CreatePayment(ctx, sqlc.CreatePaymentParams{
+		Amount:      payment.Amount,
+		Date:        date,
+		QuotaID:     quota.ID,
+		ClientID:    quota.ClientID,
+		CollectorID: utils.ParseToSqlNullInt64(payment.CollectorID),
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	payment.ID = created.ID
+	payment.Date = &created.Date
+	return nil
 }
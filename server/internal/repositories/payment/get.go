@@ -22,10 +22,11 @@ func (r *Repository) GetByQuotaID(id string) ([]*domain.Payment, error) {
 	payments := make([]*domain.Payment, 0, len(paymentsDB))
 	for _, p := range paymentsDB {
 		payments = append(payments, &domain.Payment{
-			ID:      p.ID,
-			Amount:  p.Amount,
-			Date:    &p.Date,
-			QuotaID: p.QuotaID,
+			ID:          p.ID,
+			Amount:      p.Amount,
+			Date:        &p.Date,
+			QuotaID:     p.QuotaID,
+			CollectorID: utils.ParseToInt64Ptr(p.CollectorID),
 		})
 	}
 
@@ -47,9 +48,10 @@ func (r *Repository) GetByID(id string) (*domain.Payment, error) {
 	}
 
 	return &domain.Payment{
-		ID:      paymentDB.ID,
-		Amount:  paymentDB.Amount,
-		Date:    &paymentDB.Date,
-		QuotaID: paymentDB.QuotaID,
+		ID:          paymentDB.ID,
+		Amount:      paymentDB.Amount,
+		Date:        &paymentDB.Date,
+		QuotaID:     paymentDB.QuotaID,
+		CollectorID: utils.ParseToInt64Ptr(paymentDB.CollectorID),
 	}, nil
 }
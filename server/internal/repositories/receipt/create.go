@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Create(ctx context.Context, delivery *domain.ReceiptDelivery) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.CreateReceiptDelivery(ctx, sqlc.CreateReceiptDeliveryParams{
+		PaymentID:     delivery.PaymentID,
+		ClientID:      delivery.ClientID,
+		Recipient:     delivery.Recipient,
+		NextAttemptAt: delivery.NextAttemptAt,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*delivery = *toDomain(row)
+	return nil
+}
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) GetTarget(ctx context.Context, paymentID int64) (*domain.ReceiptTarget, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetReceiptTarget(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return &domain.ReceiptTarget{
+		PaymentID:       row.PaymentID,
+		Amount:          row.Amount,
+		Date:            row.Date,
+		QuotaNumber:     row.QuotaNumber,
+		SaleDescription: row.SaleDescription,
+		ClientID:        row.ClientID,
+		ClientName:      row.ClientName,
+		ClientLastname:  row.ClientLastname,
+		Email:           utils.ParseToEmptyString(row.Email),
+	}, nil
+}
+
+func (r *Repository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.ReceiptDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetDueReceiptDeliveries(ctx, sqlc.GetDueReceiptDeliveriesParams{
+		NextAttemptAt: now,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainList(rows), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.ReceiptDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetReceiptDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomain(row), nil
+}
+
+func (r *Repository) GetByPaymentID(ctx context.Context, paymentID int64) ([]*domain.ReceiptDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetReceiptDeliveriesByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainList(rows), nil
+}
+
+func toDomainList(rows []sqlc.ReceiptDelivery) []*domain.ReceiptDelivery {
+	deliveries := make([]*domain.ReceiptDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = toDomain(row)
+	}
+	return deliveries
+}
+
+func toDomain(row sqlc.ReceiptDelivery) *domain.ReceiptDelivery {
+	delivery := &domain.ReceiptDelivery{
+		ID:            row.ID,
+		PaymentID:     row.PaymentID,
+		ClientID:      row.ClientID,
+		Recipient:     row.Recipient,
+		Status:        row.Status,
+		Attempts:      int(row.Attempts),
+		LastError:     utils.ParseToEmptyString(row.LastError),
+		NextAttemptAt: row.NextAttemptAt,
+		CreatedAt:     row.CreatedAt,
+	}
+	if row.SentAt.Valid {
+		delivery.SentAt = &row.SentAt.Time
+	}
+	return delivery
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) MarkSent(ctx context.Context, id int64, sentAt time.Time) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.MarkReceiptDeliverySent(ctx, sqlc.MarkReceiptDeliverySentParams{
+		SentAt: sql.NullTime{Time: sentAt, Valid: true},
+		ID:     id,
+	})
+	return manageError(err)
+}
+
+// UpdateAttempt guarda el resultado de un intento fallido
+func (r *Repository) UpdateAttempt(ctx context.Context, delivery *domain.ReceiptDelivery) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpdateReceiptDeliveryAttempt(ctx, sqlc.UpdateReceiptDeliveryAttemptParams{
+		Status:        delivery.Status,
+		Attempts:      int64(delivery.Attempts),
+		LastError:     utils.ParseToSqlNullString(delivery.LastError),
+		NextAttemptAt: delivery.NextAttemptAt,
+		ID:            delivery.ID,
+	})
+	return manageError(err)
+}
+
+// Retry vuelve a poner en cola un envío fallido
+func (r *Repository) Retry(ctx context.Context, id int64, at time.Time) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	updated, err := r.Queries.RetryReceiptDelivery(ctx, sqlc.RetryReceiptDeliveryParams{
+		NextAttemptAt: at,
+		ID:            id,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if updated == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Enqueue(ctx context.Context, reminder *domain.Reminder) (bool, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	inserted, err := r.Queries.EnqueueReminder(ctx, sqlc.EnqueueReminderParams{
+		QuotaID:       reminder.QuotaID,
+		ClientID:      reminder.ClientID,
+		Channel:       reminder.Channel,
+		OffsetDays:    int64(reminder.OffsetDays),
+		Recipient:     reminder.Recipient,
+		Subject:       reminder.Subject,
+		Body:          reminder.Body,
+		NextAttemptAt: reminder.NextAttemptAt,
+	})
+	if err != nil {
+		return false, manageError(err)
+	}
+
+	return inserted > 0, nil
+}
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// GetUnpaidQuotas usa la misma consulta que el worker de estados
+func (r *Repository) GetUnpaidQuotas(ctx context.Context) ([]*domain.Quota, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetUnpaidQuotasForStateUpdate(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	quotas := make([]*domain.Quota, len(rows))
+	for i, row := range rows {
+		dueDate := row.DueDate
+		quotas[i] = &domain.Quota{
+			ID:       row.ID,
+			DueDate:  &dueDate,
+			StateID:  int(row.StateID),
+			SaleID:   row.SaleID,
+			ClientID: row.ClientID,
+		}
+	}
+
+	return quotas, nil
+}
+
+func (r *Repository) GetTarget(ctx context.Context, quotaID int64) (*domain.ReminderTarget, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetReminderTarget(ctx, quotaID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return &domain.ReminderTarget{
+		QuotaID:         row.QuotaID,
+		QuotaNumber:     row.QuotaNumber,
+		Amount:          row.Amount,
+		PaidAmount:      row.PaidAmount,
+		DueDate:         row.DueDate,
+		IsPaid:          row.IsPaid.Bool,
+		SaleID:          row.SaleID,
+		SaleDescription: row.SaleDescription,
+		ClientID:        row.ClientID,
+		ClientName:      row.ClientName,
+		ClientLastname:  row.ClientLastname,
+		Email:           utils.ParseToEmptyString(row.Email),
+		Phone:           utils.ParseToEmptyString(row.Phone),
+		OptOut:          row.RemindersOptOut,
+	}, nil
+}
+
+func (r *Repository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.Reminder, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetDueReminders(ctx, sqlc.GetDueRemindersParams{
+		NextAttemptAt: now,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainList(rows), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Reminder, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetReminderByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomain(row), nil
+}
+
+func (r *Repository) GetByQuotaID(ctx context.Context, quotaID int64) ([]*domain.Reminder, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetRemindersByQuotaID(ctx, quotaID)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainList(rows), nil
+}
+
+func (r *Repository) GetAll(ctx context.Context, status string, limit, offset int) ([]*domain.Reminder, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetReminders(ctx, sqlc.GetRemindersParams{
+		Column1: status,
+		Status:  status,
+		Limit:   int64(limit),
+		Offset:  int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainList(rows), nil
+}
+
+func toDomainList(rows []sqlc.Reminder) []*domain.Reminder {
+	reminders := make([]*domain.Reminder, len(rows))
+	for i, row := range rows {
+		reminders[i] = toDomain(row)
+	}
+	return reminders
+}
+
+func toDomain(row sqlc.Reminder) *domain.Reminder {
+	reminder := &domain.Reminder{
+		ID:            row.ID,
+		QuotaID:       row.QuotaID,
+		ClientID:      row.ClientID,
+		Channel:       row.Channel,
+		OffsetDays:    int(row.OffsetDays),
+		Recipient:     row.Recipient,
+		Subject:       row.Subject,
+		Body:          row.Body,
+		Status:        row.Status,
+		Attempts:      int(row.Attempts),
+		LastError:     utils.ParseToEmptyString(row.LastError),
+		NextAttemptAt: row.NextAttemptAt,
+		CreatedAt:     row.CreatedAt,
+	}
+	if row.SentAt.Valid {
+		reminder.SentAt = &row.SentAt.Time
+	}
+	return reminder
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
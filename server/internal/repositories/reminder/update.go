@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) MarkSent(ctx context.Context, id int64, sentAt time.Time) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.MarkReminderSent(ctx, sqlc.MarkReminderSentParams{
+		SentAt: sql.NullTime{Time: sentAt, Valid: true},
+		ID:     id,
+	})
+	return manageError(err)
+}
+
+// UpdateAttempt guarda el resultado de un intento fallido (o la cancelación)
+func (r *Repository) UpdateAttempt(ctx context.Context, reminder *domain.Reminder) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpdateReminderAttempt(ctx, sqlc.UpdateReminderAttemptParams{
+		Status:        reminder.Status,
+		Attempts:      int64(reminder.Attempts),
+		LastError:     utils.ParseToSqlNullString(reminder.LastError),
+		NextAttemptAt: reminder.NextAttemptAt,
+		ID:            reminder.ID,
+	})
+	return manageError(err)
+}
+
+// Retry vuelve a poner en cola un recordatorio fallido
+func (r *Repository) Retry(ctx context.Context, id int64, at time.Time) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	updated, err := r.Queries.RetryReminder(ctx, sqlc.RetryReminderParams{
+		NextAttemptAt: at,
+		ID:            id,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if updated == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) SetClientOptOut(ctx context.Context, clientID int64, optOut bool) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	updated, err := r.Queries.UpdateClientRemindersOptOut(ctx, sqlc.UpdateClientRemindersOptOutParams{
+		RemindersOptOut: optOut,
+		ID:              clientID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if updated == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
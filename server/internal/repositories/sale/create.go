@@ -5,11 +5,12 @@ import (
 	"strings"
 
 	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
 	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
 	"github.com/benitez96/gostore/internal/repositories/utils"
 )
 
-func (r *Repository) CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto) (int64, error) {
+func (r *Repository) CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto, credit ports.SaleCreditGuard) (int64, error) {
 	ctx, cancel := utils.GetContext()
 	defer cancel()
 
@@ -20,6 +21,13 @@ func (r *Repository) CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto) (in
 
 	qtx := r.Queries.WithTx(tx)
 
+	if credit != nil {
+		if err := recheckCredit(ctx, qtx, int64(dto.ClientID), credit); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	saleID, err := qtx.CreateSale(ctx, sqlc.CreateSaleParams{
 		Description: buildSaleDescription(dto.Products),
 		Amount:      dto.Amount,
@@ -31,6 +39,13 @@ func (r *Repository) CreateSaleWithProductsAndQuotas(dto *dto.CreateSaleDto) (in
 		return 0, err
 	}
 
+	if credit != nil && credit.Override() != nil {
+		if err := createOverride(ctx, qtx, credit.Override(), saleID); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	for _, p := range dto.Products {
 
 		if p.ID != 0 {
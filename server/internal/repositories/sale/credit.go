@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+// recheckCredit lee el saldo y la política del cliente dentro de la transacción de la venta,
+// así lo que ve el control incluye las ventas que se confirmaron mientras tanto
+func recheckCredit(ctx context.Context, qtx *sqlc.Queries, clientID int64, credit ports.SaleCreditGuard) error {
+	row, err := qtx.GetClientCreditStatus(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	status := &domain.CreditStatus{
+		ClientID:    row.ID,
+		StateID:     int(row.StateID),
+		Balance:     row.Balance,
+		ActiveSales: row.ActiveSales,
+		Limits: domain.CreditLimits{
+			MaxBalance:     utils.ParseToFloat64Ptr(row.MaxBalance),
+			MaxActiveSales: utils.ParseToInt64Ptr(row.MaxActiveSales),
+		},
+	}
+
+	var policy *domain.CreditPolicy
+	policyRow, err := qtx.GetCreditPolicy(ctx, row.StateID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return err
+	default:
+		policy = &domain.CreditPolicy{
+			StateID:          int(policyRow.StateID),
+			StateDescription: policyRow.StateDescription,
+			AllowSales:       policyRow.AllowSales,
+			MaxBalance:       utils.ParseToFloat64Ptr(policyRow.MaxBalance),
+			MaxActiveSales:   utils.ParseToInt64Ptr(policyRow.MaxActiveSales),
+		}
+	}
+
+	return credit.Recheck(status, policy)
+}
+
+// createOverride guarda la autorización de un encargado junto con la venta que habilitó.
+// Las reglas salteadas van una por línea, como las lee el repositorio de crédito.
+func createOverride(ctx context.Context, qtx *sqlc.Queries, override *domain.CreditOverride, saleID int64) error {
+	row, err := qtx.CreateCreditOverride(ctx, sqlc.CreateCreditOverrideParams{
+		SaleID:     sql.NullInt64{Int64: saleID, Valid: true},
+		ClientID:   override.ClientID,
+		UserID:     utils.ParseToSqlNullInt64(override.UserID),
+		UserName:   override.UserName,
+		Reason:     override.Reason,
+		Violations: strings.Join(override.Violations, "\n"),
+		Amount:     override.Amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	override.ID = row.ID
+	override.SaleID = &saleID
+	override.CreatedAt = row.CreatedAt
+	return nil
+}
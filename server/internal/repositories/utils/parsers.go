@@ -23,6 +23,36 @@ func ParseToEmptyString(s sql.NullString) string {
 	return ""
 }
 
+// ParseToSqlNullInt64 convierte un ID opcional; nil se guarda como NULL
+func ParseToSqlNullInt64(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{ Int64: *n, Valid: true }
+}
+
+func ParseToInt64Ptr(n sql.NullInt64) *int64 {
+	if n.Valid {
+		return &n.Int64
+	}
+	return nil
+}
+
+// ParseToSqlNullFloat64Ptr convierte un límite opcional; nil se guarda como NULL (a diferencia de ParseToSqlNullFloat64, 0 es un valor válido)
+func ParseToSqlNullFloat64Ptr(n *float64) sql.NullFloat64 {
+	if n == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{ Float64: *n, Valid: true }
+}
+
+func ParseToFloat64Ptr(n sql.NullFloat64) *float64 {
+	if n.Valid {
+		return &n.Float64
+	}
+	return nil
+}
+
 func ParseToInt64(idStr string) (int64, error) {
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
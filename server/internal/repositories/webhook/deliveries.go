@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) Enqueue(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.EnqueueWebhookDelivery(ctx, sqlc.EnqueueWebhookDeliveryParams{
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		NextAttemptAt:  delivery.NextAttemptAt,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*delivery = *toDomainDelivery(row)
+	return nil
+}
+
+func (r *Repository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetDueWebhookDeliveries(ctx, sqlc.GetDueWebhookDeliveriesParams{
+		NextAttemptAt: now,
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainDeliveries(rows), nil
+}
+
+func (r *Repository) MarkDelivered(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.MarkWebhookDeliveryDelivered(ctx, sqlc.MarkWebhookDeliveryDeliveredParams{
+		ResponseStatus: toNullInt64(delivery.ResponseStatus),
+		ResponseBody:   utils.ParseToSqlNullString(delivery.ResponseBody),
+		DeliveredAt:    sql.NullTime{Time: time.Now(), Valid: true},
+		ID:             delivery.ID,
+	})
+	return manageError(err)
+}
+
+// UpdateAttempt guarda el resultado de un intento fallido
+func (r *Repository) UpdateAttempt(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	err := r.Queries.UpdateWebhookDeliveryAttempt(ctx, sqlc.UpdateWebhookDeliveryAttemptParams{
+		Status:         delivery.Status,
+		Attempts:       int64(delivery.Attempts),
+		ResponseStatus: toNullInt64(delivery.ResponseStatus),
+		ResponseBody:   utils.ParseToSqlNullString(delivery.ResponseBody),
+		LastError:      utils.ParseToSqlNullString(delivery.LastError),
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ID:             delivery.ID,
+	})
+	return manageError(err)
+}
+
+func (r *Repository) GetDeliveryByID(ctx context.Context, id int64) (*domain.WebhookDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomainDelivery(row), nil
+}
+
+func (r *Repository) GetDeliveries(ctx context.Context, subscriptionID int64, status string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetWebhookDeliveries(ctx, sqlc.GetWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Column2:        status,
+		Status:         status,
+		Limit:          int64(limit),
+		Offset:         int64(offset),
+	})
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainDeliveries(rows), nil
+}
+
+func toDomainDeliveries(rows []sqlc.WebhookDelivery) []*domain.WebhookDelivery {
+	deliveries := make([]*domain.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = toDomainDelivery(row)
+	}
+	return deliveries
+}
+
+func toDomainDelivery(row sqlc.WebhookDelivery) *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        []byte(row.Payload),
+		Status:         row.Status,
+		Attempts:       int(row.Attempts),
+		ResponseBody:   utils.ParseToEmptyString(row.ResponseBody),
+		LastError:      utils.ParseToEmptyString(row.LastError),
+		NextAttemptAt:  row.NextAttemptAt,
+		CreatedAt:      row.CreatedAt,
+	}
+	if row.ResponseStatus.Valid {
+		status := int(row.ResponseStatus.Int64)
+		delivery.ResponseStatus = &status
+	}
+	if row.DeliveredAt.Valid {
+		delivery.DeliveredAt = &row.DeliveredAt.Time
+	}
+	return delivery
+}
+
+func toNullInt64(value *int) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*value), Valid: true}
+}
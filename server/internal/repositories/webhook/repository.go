@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+)
+
+// Make sure Repository implements ports.WebhookRepository
+// at compile time
+var _ ports.WebhookRepository = &Repository{}
+
+type Repository struct {
+	Queries *sqlc.Queries
+}
@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
+	"github.com/benitez96/gostore/internal/repositories/utils"
+)
+
+func (r *Repository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.CreateWebhookSubscription(ctx, sqlc.CreateWebhookSubscriptionParams{
+		Url:         subscription.URL,
+		Secret:      subscription.Secret,
+		Events:      strings.Join(subscription.Events, ","),
+		Description: subscription.Description,
+		Active:      subscription.Active,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+
+	*subscription = *toDomainSubscription(row)
+	return nil
+}
+
+func (r *Repository) GetSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainSubscriptions(rows), nil
+}
+
+func (r *Repository) GetActiveSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	rows, err := r.Queries.GetActiveWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, manageError(err)
+	}
+
+	return toDomainSubscriptions(rows), nil
+}
+
+func (r *Repository) GetSubscriptionByID(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	row, err := r.Queries.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, manageError(err)
+	}
+
+	return toDomainSubscription(row), nil
+}
+
+func (r *Repository) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	updated, err := r.Queries.UpdateWebhookSubscription(ctx, sqlc.UpdateWebhookSubscriptionParams{
+		Url:         subscription.URL,
+		Secret:      subscription.Secret,
+		Events:      strings.Join(subscription.Events, ","),
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		ID:          subscription.ID,
+	})
+	if err != nil {
+		return manageError(err)
+	}
+	if updated == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteSubscription(ctx context.Context, id int64) error {
+	ctx, cancel := utils.GetContext()
+	defer cancel()
+
+	deleted, err := r.Queries.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return manageError(err)
+	}
+	if deleted == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func toDomainSubscriptions(rows []sqlc.WebhookSubscription) []*domain.WebhookSubscription {
+	subscriptions := make([]*domain.WebhookSubscription, len(rows))
+	for i, row := range rows {
+		subscriptions[i] = toDomainSubscription(row)
+	}
+	return subscriptions
+}
+
+func toDomainSubscription(row sqlc.WebhookSubscription) *domain.WebhookSubscription {
+	return &domain.WebhookSubscription{
+		ID:          row.ID,
+		URL:         row.Url,
+		Secret:      row.Secret,
+		Events:      strings.Split(row.Events, ","),
+		Description: row.Description,
+		Active:      row.Active,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func manageError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return domain.ErrTimeout
+	}
+	return err
+}
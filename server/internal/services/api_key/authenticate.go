@@ -0,0 +1,48 @@
+package api_key
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// lastUsedResolution evita escribir en la base en cada request de una misma key
+const lastUsedResolution = time.Minute
+
+var ErrInvalidAPIKey = errors.New("invalid or expired api key")
+
+// Authenticate valida una key en texto plano y devuelve la key asociada.
+// Cualquier falla (formato, key inexistente, revocada o vencida) devuelve ErrInvalidAPIKey.
+func (s Service) Authenticate(ctx context.Context, rawKey string) (*domain.APIKey, error) {
+	parts := strings.Split(rawKey, "_")
+	if len(parts) != 3 || parts[0] != keyScheme || parts[1] == "" || parts[2] == "" {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.Repo.GetByPrefix(ctx, parts[1])
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(parts[2])), []byte(key.KeyHash)) != 1 {
+		return nil, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !key.IsUsable(now) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > lastUsedResolution {
+		if err := s.Repo.UpdateLastUsed(ctx, key.ID); err != nil {
+			logger.FromContext(ctx).Warn("failed to update api key last_used_at", "api_key_id", key.ID, "error", err)
+		}
+	}
+
+	return &key.APIKey, nil
+}
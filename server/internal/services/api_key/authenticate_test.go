@@ -0,0 +1,113 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+// memoryRepo guarda las keys en memoria, indexadas por prefijo
+type memoryRepo struct {
+	keys     map[string]*domain.APIKeyWithHash
+	lastUsed int
+}
+
+func (r *memoryRepo) Create(ctx context.Context, name, prefix, keyHash string, permissions int64, expiresAt time.Time, createdBy *int64) (*domain.APIKey, error) {
+	key := &domain.APIKeyWithHash{
+		APIKey:  domain.APIKey{ID: int64(len(r.keys) + 1), Name: name, Prefix: prefix, Permissions: permissions, ExpiresAt: expiresAt},
+		KeyHash: keyHash,
+	}
+	r.keys[prefix] = key
+	return &key.APIKey, nil
+}
+
+func (r *memoryRepo) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKeyWithHash, error) {
+	key, ok := r.keys[prefix]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return key, nil
+}
+
+func (r *memoryRepo) UpdateLastUsed(ctx context.Context, id int64) error {
+	r.lastUsed++
+	return nil
+}
+
+func (r *memoryRepo) GetAll(ctx context.Context) ([]*domain.APIKey, error)          { return nil, nil }
+func (r *memoryRepo) GetByID(ctx context.Context, id int64) (*domain.APIKey, error) { return nil, nil }
+func (r *memoryRepo) Revoke(ctx context.Context, id int64) error                    { return nil }
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryRepo{keys: map[string]*domain.APIKeyWithHash{}}
+	s := Service{Repo: repo}
+
+	created, err := s.Create(ctx, &dto.CreateAPIKeyRequest{Name: "kiosco", Permissions: constants.PermissionSales}, nil)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	raw := created.Key
+	parts := strings.Split(raw, "_")
+	if len(parts) != 3 || parts[0] != keyScheme || parts[1] != created.APIKey.Prefix {
+		t.Fatalf("unexpected key format %q", raw)
+	}
+	if stored := repo.keys[parts[1]].KeyHash; stored == parts[2] || stored != hashSecret(parts[2]) {
+		t.Fatalf("the stored hash does not match the secret's SHA-256")
+	}
+
+	revoked, _ := s.Create(ctx, &dto.CreateAPIKeyRequest{Name: "vieja", Permissions: constants.PermissionSales}, nil)
+	now := time.Now()
+	repo.keys[revoked.APIKey.Prefix].RevokedAt = &now
+
+	expired, _ := s.Create(ctx, &dto.CreateAPIKeyRequest{Name: "vencida", Permissions: constants.PermissionSales}, nil)
+	repo.keys[expired.APIKey.Prefix].ExpiresAt = now.Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		raw  string
+		ok   bool
+	}{
+		{name: "key válida", raw: raw, ok: true},
+		{name: "secreto equivocado", raw: parts[0] + "_" + parts[1] + "_" + strings.Repeat("0", len(parts[2]))},
+		{name: "prefijo inexistente", raw: parts[0] + "_ffffffff_" + parts[2]},
+		{name: "otro esquema", raw: "abc_" + parts[1] + "_" + parts[2]},
+		{name: "faltan partes", raw: parts[0] + "_" + parts[1]},
+		{name: "sobran partes", raw: raw + "_x"},
+		{name: "secreto vacío", raw: parts[0] + "_" + parts[1] + "_"},
+		{name: "vacía", raw: ""},
+		{name: "revocada", raw: revoked.Key},
+		{name: "vencida", raw: expired.Key},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := s.Authenticate(ctx, tt.raw)
+			if tt.ok {
+				if err != nil || key.Prefix != parts[1] {
+					t.Fatalf("got (%v, %v), want the created key", key, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidAPIKey) {
+				t.Errorf("got %v, want ErrInvalidAPIKey", err)
+			}
+		})
+	}
+
+	// last_used_at se actualiza como mucho una vez por minuto
+	repo.lastUsed = 0
+	repo.keys[parts[1]].LastUsedAt = &now
+	if _, err := s.Authenticate(ctx, raw); err != nil {
+		t.Fatal(err)
+	}
+	if repo.lastUsed != 0 {
+		t.Errorf("last_used_at was updated %d times within a minute", repo.lastUsed)
+	}
+}
@@ -0,0 +1,82 @@
+package api_key
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+const (
+	// Formato de la key: gsk_<prefix>_<secret>. El prefijo se guarda en claro
+	// para poder buscar la key; del secreto solo se guarda el hash.
+	keyScheme     = "gsk"
+	prefixBytes   = 4
+	secretBytes   = 24
+	defaultExpiry = 365 * 24 * time.Hour
+)
+
+func (s Service) Create(ctx context.Context, req *dto.CreateAPIKeyRequest, createdBy *int64) (*dto.CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			"name cannot be empty")
+	}
+
+	// Las API keys nunca pueden administrar usuarios ni otras keys
+	if req.Permissions <= 0 || req.Permissions&^constants.PermissionManager != 0 {
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			"permissions must be a non-empty subset of clients, products, dashboard and sales")
+	}
+
+	expiresAt := time.Now().Add(defaultExpiry)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, domain.NewAppError(
+				domain.ErrCodeInvalidParams,
+				"expires_at must be in the future")
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	prefix, err := randomHex(prefixBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error generating api key: %w", err)
+	}
+	secret, err := randomHex(secretBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error generating api key: %w", err)
+	}
+
+	key, err := s.Repo.Create(ctx, name, prefix, hashSecret(secret), req.Permissions, expiresAt.UTC(), createdBy)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+
+	return &dto.CreateAPIKeyResponse{
+		APIKey: key,
+		Key:    fmt.Sprintf("%s_%s_%s", keyScheme, prefix, secret),
+	}, nil
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
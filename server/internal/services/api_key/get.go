@@ -0,0 +1,23 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func (s Service) GetAll(ctx context.Context) ([]*domain.APIKey, error) {
+	keys, err := s.Repo.GetAll(ctx)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+	return keys, nil
+}
+
+func (s Service) GetByID(ctx context.Context, id int64) (*domain.APIKey, error) {
+	key, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+	return key, nil
+}
@@ -0,0 +1,19 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func (s Service) Revoke(ctx context.Context, id int64) error {
+	// Verificar que exista para devolver 404 en vez de un no-op silencioso
+	if _, err := s.Repo.GetByID(ctx, id); err != nil {
+		return domain.ManageError(err)
+	}
+
+	if err := s.Repo.Revoke(ctx, id); err != nil {
+		return domain.ManageError(err)
+	}
+	return nil
+}
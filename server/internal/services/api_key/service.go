@@ -0,0 +1,14 @@
+package api_key
+
+import (
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the APIKeyService interface
+// at compile time.
+var _ ports.APIKeyService = &Service{}
+
+// Service is a struct that represents the service for the api key entity.
+type Service struct {
+	Repo ports.APIKeyRepository
+}
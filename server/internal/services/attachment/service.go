@@ -0,0 +1,288 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/auth"
+	"github.com/benitez96/gostore/internal/shared/constants"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// MaxFileNameLength es el largo de la columna attachments.file_name
+const MaxFileNameLength = 255
+
+// allowedTypes son los formatos aceptados, detectados por el contenido, con la extensión con que se guardan
+var allowedTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// ownerPermissions es el permiso necesario para ver y cargar los adjuntos de cada dueño
+var ownerPermissions = map[string]int64{
+	domain.AttachmentOwnerClient:  constants.PermissionClients,
+	domain.AttachmentOwnerSale:    constants.PermissionSales,
+	domain.AttachmentOwnerPayment: constants.PermissionSales,
+}
+
+// Make sure Service implements ports.AttachmentService
+// at compile time
+var _ ports.AttachmentService = &Service{}
+
+type Service struct {
+	Repo    ports.AttachmentRepository
+	Storage ports.FileStorage
+	Config  config.AttachmentsConfig
+}
+
+// MaxSize es el tamaño máximo de un archivo en bytes
+func (s *Service) MaxSize() int64 {
+	return int64(s.Config.MaxSizeMB) << 20
+}
+
+// Upload guarda el archivo y, si es una imagen, su miniatura. El tipo se detecta por el contenido,
+// no por el nombre ni por lo que declara el navegador.
+func (s *Service) Upload(ctx context.Context, ownerType string, ownerID int64, fileName string, content []byte) (*domain.FileAttachment, error) {
+	if err := checkAccess(ctx, ownerType); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.Repo.OwnerExists(ctx, ownerType, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.NewAppError(domain.ErrCodeNotFound, ownerType+" not found")
+	}
+
+	switch {
+	case len(content) == 0:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "file is empty")
+	case int64(len(content)) > s.MaxSize():
+		return nil, domain.NewAppError(domain.ErrCodeFileTooLarge, fmt.Sprintf("file must be at most %d MB", s.Config.MaxSizeMB))
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+	extension, ok := allowedTypes[contentType]
+	if !ok {
+		return nil, domain.NewAppError(domain.ErrCodeUnsupportedMediaType,
+			fmt.Sprintf("files of type %s are not allowed; use JPEG, PNG, GIF, WebP or PDF", contentType))
+	}
+
+	fileName = cleanFileName(fileName, extension)
+	key, err := newKey(ownerType, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &domain.FileAttachment{
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		StorageKey:  key + extension,
+	}
+	attachment.UserID, attachment.Author = auth.Author(ctx)
+
+	if err := s.Storage.Save(ctx, attachment.StorageKey, bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	if thumbnail, err := makeThumbnail(content, s.Config.ThumbnailSize); err != nil {
+		slog.Warn("attachment thumbnail not generated", "file", fileName, "content_type", contentType, "error", err)
+	} else if thumbnail != nil {
+		thumbnailKey := key + ".thumb.jpg"
+		if err := s.Storage.Save(ctx, thumbnailKey, bytes.NewReader(thumbnail)); err != nil {
+			slog.Warn("attachment thumbnail not saved", "file", fileName, "error", err)
+		} else {
+			attachment.ThumbnailKey = thumbnailKey
+		}
+	}
+
+	if err := s.Repo.Create(ctx, attachment); err != nil {
+		s.removeFiles(ctx, attachment)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*domain.FileAttachment, error) {
+	attachment, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, manageError(err)
+	}
+	if err := checkAccess(ctx, attachment.OwnerType); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *Service) GetByOwner(ctx context.Context, ownerType string, ownerID int64) ([]*domain.FileAttachment, error) {
+	if err := checkAccess(ctx, ownerType); err != nil {
+		return nil, err
+	}
+	return s.Repo.GetByOwner(ctx, ownerType, ownerID)
+}
+
+func (s *Service) Open(ctx context.Context, id int64, thumbnail bool) (*domain.FileAttachment, io.ReadCloser, error) {
+	attachment, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := attachment.StorageKey
+	if thumbnail {
+		if !attachment.HasThumbnail {
+			return nil, nil, domain.NewAppError(domain.ErrCodeNotFound, "attachment has no thumbnail")
+		}
+		key = attachment.ThumbnailKey
+	}
+
+	content, err := s.Storage.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			slog.Error("attachment file missing from storage", "attachment_id", id, "key", key)
+			return nil, nil, domain.NewAppError(domain.ErrCodeNotFound, "attachment file not found")
+		}
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	attachment, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.Repo.Delete(ctx, id); err != nil {
+		return manageError(err)
+	}
+	s.removeFiles(ctx, attachment)
+	return nil
+}
+
+// HandleEvent se suscribe al bus de eventos: cuando se borra un cliente, una venta o un pago
+// limpia sus adjuntos. Si falla quedan huérfanos hasta el próximo borrado.
+func (s *Service) HandleEvent(ctx context.Context, event domain.Event) {
+	switch event.Type {
+	case domain.EventClientDeleted, domain.EventSaleDeleted, domain.EventPaymentDeleted:
+	default:
+		return
+	}
+
+	if _, err := s.RemoveOrphans(ctx); err != nil {
+		logger.FromContext(ctx).Warn("attachments of deleted owner not removed", "event", event.Type, "event_id", event.ID, "error", err)
+	}
+}
+
+// RemoveOrphans borra los adjuntos que quedaron sin dueño, incluidos los de las ventas y pagos
+// que se borraron en cascada
+func (s *Service) RemoveOrphans(ctx context.Context) (int, error) {
+	orphans, err := s.Repo.GetOrphans(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, attachment := range orphans {
+		if err := s.Repo.Delete(ctx, attachment.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return removed, err
+		}
+		s.removeFiles(ctx, attachment)
+		removed++
+	}
+
+	if removed > 0 {
+		slog.Info("orphan attachments removed", "count", removed)
+	}
+	return removed, nil
+}
+
+// removeFiles borra el contenido y la miniatura; un archivo que no se pudo borrar solo se loguea
+func (s *Service) removeFiles(ctx context.Context, attachment *domain.FileAttachment) {
+	for _, key := range []string{attachment.StorageKey, attachment.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := s.Storage.Delete(ctx, key); err != nil {
+			slog.Warn("attachment file not removed", "attachment_id", attachment.ID, "key", key, "error", err)
+		}
+	}
+}
+
+// checkAccess exige el permiso del tipo de dueño: clientes para los adjuntos de clientes y
+// ventas para los de ventas y pagos. Sin actor en el contexto (procesos internos) no hay restricción.
+func checkAccess(ctx context.Context, ownerType string) error {
+	permission, ok := ownerPermissions[ownerType]
+	if !ok {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "owner must be a client, sale or payment")
+	}
+
+	actor, ok := auth.ActorFromContext(ctx)
+	if !ok || constants.HasPermission(actor.Permissions, permission) {
+		return nil
+	}
+	return domain.NewAppError(domain.ErrCodeForbidden, "insufficient permissions for "+ownerType+" attachments")
+}
+
+// cleanFileName deja solo el nombre del archivo, sin rutas ni caracteres de control, y le pone
+// la extensión del tipo detectado si no la tiene
+func cleanFileName(name, extension string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, filepath.Base(name))
+	name = strings.TrimSpace(name)
+
+	if name == "" || name == "." || name == "/" {
+		name = "adjunto"
+	}
+	if !strings.EqualFold(filepath.Ext(name), extension) && !(extension == ".jpg" && strings.EqualFold(filepath.Ext(name), ".jpeg")) {
+		name += extension
+	}
+
+	if utf8.RuneCountInString(name) > MaxFileNameLength {
+		base := []rune(strings.TrimSuffix(name, filepath.Ext(name)))
+		base = base[:min(len(base), MaxFileNameLength-utf8.RuneCountInString(extension))]
+		name = string(base) + extension
+	}
+	return name
+}
+
+// newKey arma una clave única por dueño, sin usar el nombre que mandó el usuario
+func newKey(ownerType string, ownerID int64) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%d/%s", ownerType, ownerID, hex.EncodeToString(random)), nil
+}
+
+// manageError traduce los errores del repositorio a errores de la API
+func manageError(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.NewAppError(domain.ErrCodeNotFound, "attachment not found")
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package attachment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // Registra el decodificador de GIF
+	"image/jpeg"
+	_ "image/png" // Registra el decodificador de PNG
+)
+
+// maxThumbnailPixels evita decodificar imágenes enormes (un PNG chico puede declarar miles de megapíxeles)
+const maxThumbnailPixels = 50_000_000
+
+// thumbnailSamples es cuántos puntos por lado se promedian de la imagen original para cada píxel de la miniatura
+const thumbnailSamples = 3
+
+// makeThumbnail achica la imagen para que su lado mayor mida size y la devuelve como JPEG.
+// Devuelve nil sin error si el formato no es una imagen que se pueda decodificar (PDF, WebP).
+func makeThumbnail(content []byte, size int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if config.Width*config.Height > maxThumbnailPixels {
+		return nil, fmt.Errorf("image too large for a thumbnail: %dx%d", config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, nil
+	}
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/bounds.Dx())
+		} else {
+			width, height = max(1, width*size/bounds.Dy()), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			dst.Set(x, y, sample(src, bounds, x, y, width, height))
+		}
+	}
+
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// sample promedia unos pocos puntos del área de la imagen original que cubre el píxel (x, y)
+// de la miniatura; las zonas transparentes quedan blancas porque JPEG no tiene transparencia
+func sample(src image.Image, bounds image.Rectangle, x, y, width, height int) color.Color {
+	var r, g, b, n uint32
+	for sy := 0; sy < thumbnailSamples; sy++ {
+		for sx := 0; sx < thumbnailSamples; sx++ {
+			px := bounds.Min.X + ((x*thumbnailSamples+sx)*bounds.Dx()+bounds.Dx()/2)/(width*thumbnailSamples)
+			py := bounds.Min.Y + ((y*thumbnailSamples+sy)*bounds.Dy()+bounds.Dy()/2)/(height*thumbnailSamples)
+			cr, cg, cb, ca := src.At(min(px, bounds.Max.X-1), min(py, bounds.Max.Y-1)).RGBA()
+			white := 0xffff - ca
+			r += cr + white
+			g += cg + white
+			b += cb + white
+			n++
+		}
+	}
+	return color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: 0xffff}
+}
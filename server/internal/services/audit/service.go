@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// MaxPageSize limita cuántos registros se devuelven por página
+const MaxPageSize = 200
+
+// Make sure Service implements ports.AuditService
+// at compile time
+var _ ports.AuditService = &Service{}
+
+type Service struct {
+	Repo ports.AuditRepository
+}
+
+func (s *Service) Record(ctx context.Context, entry *domain.AuditEntry) error {
+	return s.Repo.Create(ctx, entry)
+}
+
+func (s *Service) Get(ctx context.Context, actorType string, actorID int64, limit, offset int) ([]*domain.AuditEntry, error) {
+	switch actorType {
+	case "", domain.ActorTypeUser, domain.ActorTypeAPIKey:
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "actor_type must be user or api_key")
+	}
+	if limit < 1 || limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.Repo.Get(ctx, actorType, actorID, limit, offset)
+}
@@ -0,0 +1,94 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// Create toma un snapshot de la base, lo verifica y aplica la retención
+func (s *Service) Create(ctx context.Context) (*domain.Backup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backup, err := s.snapshot(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyRetention(); err != nil {
+		// El backup ya está hecho; un error de limpieza no debe invalidarlo
+		logger.FromContext(ctx).Error("error applying backup retention", "error", err)
+	}
+
+	return backup, nil
+}
+
+// snapshot genera el archivo con VACUUM INTO (consistente aunque haya escrituras en curso).
+// Se escribe primero a un .tmp y solo se renombra si pasa el integrity check.
+func (s *Service) snapshot(ctx context.Context, suffix string) (*domain.Backup, error) {
+	if err := os.MkdirAll(s.Dir, 0750); err != nil {
+		return nil, fmt.Errorf("error creating backup dir: %w", err)
+	}
+
+	now := time.Now()
+	name := fileName(now, suffix)
+	finalPath, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	tmpPath := finalPath + ".tmp"
+	os.Remove(tmpPath)
+
+	if _, err := s.DB.ExecContext(ctx, "VACUUM INTO ?", tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	if err := checkIntegrity(ctx, tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, finalPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, fmt.Errorf("error saving snapshot: %w", err)
+	}
+
+	info, err := os.Stat(finalPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+
+	logger.FromContext(ctx).Info("backup created", "name", name, "size", info.Size())
+
+	return &domain.Backup{
+		Name:      name,
+		Size:      info.Size(),
+		CreatedAt: now,
+	}, nil
+}
+
+// checkIntegrity abre el archivo en solo lectura y ejecuta PRAGMA integrity_check
+func checkIntegrity(ctx context.Context, path string) error {
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("error opening snapshot: %w", err)
+	}
+	defer db.Close()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&result); err != nil {
+		return fmt.Errorf("error checking snapshot integrity: %w", err)
+	}
+	if result != "ok" {
+		return fmt.Errorf("snapshot integrity check failed: %s", result)
+	}
+
+	return nil
+}
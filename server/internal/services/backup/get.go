@@ -0,0 +1,64 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// List devuelve los backups disponibles, del más nuevo al más viejo
+func (s *Service) List() ([]*domain.Backup, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*domain.Backup{}, nil
+		}
+		return nil, fmt.Errorf("error reading backup dir: %w", err)
+	}
+
+	backups := make([]*domain.Backup, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		createdAt, ok := parseTime(entry.Name())
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		backups = append(backups, &domain.Backup{
+			Name:      entry.Name(),
+			Size:      info.Size(),
+			CreatedAt: createdAt,
+		})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+
+	return backups, nil
+}
+
+// Open abre un backup para descargarlo; el llamador debe cerrarlo
+func (s *Service) Open(name string) (*os.File, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, domain.ManageError(domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("error opening backup: %w", err)
+	}
+
+	return f, nil
+}
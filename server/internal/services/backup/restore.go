@@ -0,0 +1,88 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// Restore reemplaza el contenido de la base por el del backup usando la API de
+// backup de SQLite sobre la conexión abierta, así el servidor sigue funcionando.
+// Antes se guarda un snapshot "prerestore" del estado actual.
+func (s *Service) Restore(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return domain.ManageError(domain.ErrNotFound)
+		}
+		return fmt.Errorf("error reading backup: %w", err)
+	}
+
+	if err := checkIntegrity(ctx, path); err != nil {
+		return err
+	}
+
+	safety, err := s.snapshot(ctx, "prerestore")
+	if err != nil {
+		return fmt.Errorf("error creating pre-restore snapshot: %w", err)
+	}
+
+	src, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("error opening backup: %w", err)
+	}
+	defer src.Close()
+
+	srcConn, err := src.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error opening backup: %w", err)
+	}
+	defer srcConn.Close()
+
+	dstConn, err := s.DB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error opening database: %w", err)
+	}
+	defer dstConn.Close()
+
+	err = dstConn.Raw(func(dstDriver any) error {
+		return srcConn.Raw(func(srcDriver any) error {
+			dst, ok := dstDriver.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("unexpected database driver %T", dstDriver)
+			}
+			srcSQLite, ok := srcDriver.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("unexpected backup driver %T", srcDriver)
+			}
+
+			b, err := dst.Backup("main", srcSQLite, "main")
+			if err != nil {
+				return err
+			}
+			if _, err := b.Step(-1); err != nil {
+				b.Finish()
+				return err
+			}
+			return b.Finish()
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error restoring backup %s (current data saved as %s): %w", name, safety.Name, err)
+	}
+
+	logger.FromContext(ctx).Warn("database restored from backup", "name", name, "pre_restore_snapshot", safety.Name)
+	return nil
+}
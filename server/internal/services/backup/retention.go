@@ -0,0 +1,61 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Las copias con sufijo (como la "prerestore" que guarda Restore) no cuentan para
+// la retención diaria/semanal: si no, el siguiente backup del día la borraría.
+// Se conservan las keepSpecial más recientes.
+const keepSpecial = 5
+
+// applyRetention conserva el backup más reciente de cada uno de los últimos
+// KeepDaily días y de cada una de las últimas KeepWeekly semanas; borra el resto.
+func (s *Service) applyRetention() error {
+	backups, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool)
+	days := make(map[string]bool)
+	weeks := make(map[string]bool)
+	special := 0
+
+	// List devuelve del más nuevo al más viejo, así que el primero de cada período es el que queda
+	for _, b := range backups {
+		if hasSuffix(b.Name) {
+			if special < keepSpecial {
+				special++
+				keep[b.Name] = true
+			}
+			continue
+		}
+
+		day := b.CreatedAt.Format("2006-01-02")
+		if !days[day] && len(days) < s.KeepDaily {
+			days[day] = true
+			keep[b.Name] = true
+		}
+
+		year, week := b.CreatedAt.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%02d", year, week)
+		if !weeks[weekKey] && len(weeks) < s.KeepWeekly {
+			weeks[weekKey] = true
+			keep[b.Name] = true
+		}
+	}
+
+	for _, b := range backups {
+		if keep[b.Name] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.Dir, b.Name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error removing old backup %s: %w", b.Name, err)
+		}
+	}
+
+	return nil
+}
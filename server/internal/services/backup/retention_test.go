@@ -0,0 +1,110 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestApplyRetention(t *testing.T) {
+	tests := []struct {
+		name       string
+		keepDaily  int
+		keepWeekly int
+		files      []string
+		want       []string
+	}{
+		{
+			name:      "el más nuevo de cada día",
+			keepDaily: 2,
+			files: []string{
+				"gostore-20260310-020000.db",
+				"gostore-20260310-140000.db",
+				"gostore-20260311-020000.db",
+				"gostore-20260312-020000.db",
+			},
+			want: []string{
+				"gostore-20260311-020000.db",
+				"gostore-20260312-020000.db",
+			},
+		},
+		{
+			name:       "una semana más allá de los días",
+			keepDaily:  1,
+			keepWeekly: 2,
+			files: []string{
+				"gostore-20260302-020000.db", // Semana 10
+				"gostore-20260303-020000.db",
+				"gostore-20260309-020000.db", // Semana 11
+				"gostore-20260310-020000.db",
+			},
+			want: []string{
+				"gostore-20260303-020000.db",
+				"gostore-20260310-020000.db",
+			},
+		},
+		{
+			name:      "la copia prerestore sobrevive al siguiente backup del día",
+			keepDaily: 7,
+			files: []string{
+				"gostore-20260310-020000.db",
+				"gostore-20260310-120000-prerestore.db",
+				"gostore-20260310-130000.db",
+			},
+			want: []string{
+				"gostore-20260310-120000-prerestore.db",
+				"gostore-20260310-130000.db",
+			},
+		},
+		{
+			name:      "las copias con sufijo tienen su propio tope",
+			keepDaily: 1,
+			files: []string{
+				"gostore-20260301-120000-prerestore.db",
+				"gostore-20260302-120000-prerestore.db",
+				"gostore-20260303-120000-prerestore.db",
+				"gostore-20260304-120000-prerestore.db",
+				"gostore-20260305-120000-prerestore.db",
+				"gostore-20260306-120000-prerestore.db",
+				"gostore-20260306-130000.db",
+			},
+			want: []string{
+				"gostore-20260302-120000-prerestore.db",
+				"gostore-20260303-120000-prerestore.db",
+				"gostore-20260304-120000-prerestore.db",
+				"gostore-20260305-120000-prerestore.db",
+				"gostore-20260306-120000-prerestore.db",
+				"gostore-20260306-130000.db",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, name := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			s := &Service{Dir: dir, KeepDaily: tt.keepDaily, KeepWeekly: tt.keepWeekly}
+			if err := s.applyRetention(); err != nil {
+				t.Fatalf("applyRetention: %v", err)
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, entry := range entries {
+				got = append(got, entry.Name())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
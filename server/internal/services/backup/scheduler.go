@@ -0,0 +1,63 @@
+package backup
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// RunScheduler crea un backup cada Interval hasta que se cancele el contexto.
+// El primer backup se programa desde el último que hay en disco: si ya pasó más de Interval
+// (o no hay ninguno) se hace enseguida, así un servidor que se reinicia seguido igual tiene backups.
+func (s *Service) RunScheduler(ctx context.Context) {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	var wait time.Duration
+	if last, ok := s.lastBackupTime(); ok {
+		wait = max(interval-time.Since(last), 0)
+	}
+
+	slog.Info("backup scheduler started", "dir", s.Dir, "interval", interval.String(), "next_in", wait.Round(time.Second).String())
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("backup scheduler stopped")
+			return
+		case <-timer.C:
+			if _, err := s.Create(ctx); err != nil {
+				slog.Error("scheduled backup failed", "error", err)
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// lastBackupTime devuelve la fecha de modificación del backup más reciente en disco
+func (s *Service) lastBackupTime() (time.Time, bool) {
+	backups, err := s.List()
+	if err != nil {
+		slog.Warn("backup dir not readable, creating a backup now", "dir", s.Dir, "error", err)
+		return time.Time{}, false
+	}
+
+	var last time.Time
+	for _, backup := range backups {
+		info, err := os.Stat(filepath.Join(s.Dir, backup.Name))
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last, !last.IsZero()
+}
@@ -0,0 +1,71 @@
+package backup
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the BackupService interface
+// at compile time.
+var _ ports.BackupService = &Service{}
+
+const (
+	filePrefix = "gostore-"
+	fileLayout = "20060102-150405"
+	fileExt    = ".db"
+)
+
+// Los nombres se validan contra este patrón antes de tocar el disco (evita path traversal)
+var backupNamePattern = regexp.MustCompile(`^gostore-\d{8}-\d{6}(-[a-z]+)?\.db$`)
+
+// Service genera snapshots consistentes de la base SQLite en línea, los verifica
+// con PRAGMA integrity_check y aplica la retención diaria/semanal.
+type Service struct {
+	DB         *sql.DB
+	Dir        string
+	KeepDaily  int
+	KeepWeekly int
+	Interval   time.Duration
+
+	mu sync.Mutex // Serializa creación, retención y restauración
+}
+
+func (s *Service) path(name string) (string, error) {
+	if !backupNamePattern.MatchString(name) {
+		return "", domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("invalid backup name: %s", name))
+	}
+	return filepath.Join(s.Dir, name), nil
+}
+
+// fileName arma el nombre del backup; suffix distingue copias especiales como "prerestore"
+func fileName(t time.Time, suffix string) string {
+	name := filePrefix + t.Format(fileLayout)
+	if suffix != "" {
+		name += "-" + suffix
+	}
+	return name + fileExt
+}
+
+// hasSuffix indica si el nombre es el de una copia especial (ver fileName)
+func hasSuffix(name string) bool {
+	return len(name) > len(filePrefix)+len(fileLayout)+len(fileExt)
+}
+
+// parseTime obtiene la fecha de creación a partir del nombre del archivo
+func parseTime(name string) (time.Time, bool) {
+	if !backupNamePattern.MatchString(name) {
+		return time.Time{}, false
+	}
+	stamp := name[len(filePrefix) : len(filePrefix)+len(fileLayout)]
+	t, err := time.ParseInLocation(fileLayout, stamp, time.Local)
+	return t, err == nil
+}
@@ -0,0 +1,42 @@
+package business_settings
+
+import "testing"
+
+func TestNormalizeCUIT(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "vacío", value: "", want: ""},
+		{name: "solo separadores", value: " - ", want: ""},
+		{name: "sin guiones", value: "20123456786", want: "20-12345678-6"},
+		{name: "con guiones", value: "30-71234567-1", want: "30-71234567-1"},
+		{name: "con puntos y espacios", value: "20.12345678.6 ", want: "20-12345678-6"},
+		{name: "dígito verificador 11 pasa a 0", value: "20111111120", want: "20-11111112-0"},
+		{name: "dígito verificador 10 pasa a 9", value: "20111111189", want: "20-11111118-9"},
+		{name: "dígito verificador incorrecto", value: "20123456787", wantErr: true},
+		{name: "faltan dígitos", value: "2012345678", wantErr: true},
+		{name: "sobran dígitos", value: "201234567861", wantErr: true},
+		{name: "letras", value: "2012345678a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeCUIT(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package business_settings
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the BusinessSettingsService interface
+// at compile time.
+var _ ports.BusinessSettingsService = &Service{}
+
+// MaxLogoSize es el tamaño máximo del logo; se embebe en cada PDF
+const MaxLogoSize = 512 * 1024
+
+// Formatos que entienden tanto wkhtmltopdf como fpdf
+var logoMimes = []string{"image/png", "image/jpeg"}
+
+// Anchos de papel de ticketera soportados, en mm
+var ticketWidths = []int{58, 80}
+
+// defaultTicketWidth es el ancho que se usa si no se indica uno
+const defaultTicketWidth = 80
+
+// defaultPrinterPort es el puerto RAW estándar de las ticketeras de red
+const defaultPrinterPort = "9100"
+
+type Service struct {
+	Repo ports.BusinessSettingsRepository
+}
+
+func (s *Service) Get(ctx context.Context) (*domain.BusinessSettings, error) {
+	return s.Repo.Get(ctx)
+}
+
+func (s *Service) Update(ctx context.Context, req dto.UpdateBusinessSettingsRequest) (*domain.BusinessSettings, error) {
+	settings := &domain.BusinessSettings{
+		Name:      strings.TrimSpace(req.Name),
+		LegalName: strings.TrimSpace(req.LegalName),
+		Address:   strings.TrimSpace(req.Address),
+		Phone:     strings.TrimSpace(req.Phone),
+		Footer:    strings.TrimSpace(req.Footer),
+
+		ContractTerms: strings.TrimSpace(req.ContractTerms),
+	}
+
+	if settings.Name == "" {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "name is required")
+	}
+
+	cuit, err := normalizeCUIT(req.CUIT)
+	if err != nil {
+		return nil, err
+	}
+	settings.CUIT = cuit
+
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"name", settings.Name, 100},
+		{"legal_name", settings.LegalName, 150},
+		{"address", settings.Address, 255},
+		{"phone", settings.Phone, 50},
+		{"footer", settings.Footer, 500},
+		{"contract_terms", settings.ContractTerms, 5000},
+	} {
+		if utf8.RuneCountInString(field.value) > field.max {
+			return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("%s must be at most %d characters", field.name, field.max))
+		}
+	}
+
+	settings.TicketWidth = req.TicketWidth
+	if settings.TicketWidth == 0 {
+		settings.TicketWidth = defaultTicketWidth
+	}
+	if !slices.Contains(ticketWidths, settings.TicketWidth) {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "ticket_width must be 58 or 80")
+	}
+
+	printer, err := normalizePrinterAddress(req.TicketPrinter)
+	if err != nil {
+		return nil, err
+	}
+	settings.TicketPrinter = printer
+
+	if err := s.Repo.Update(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return s.Repo.Get(ctx)
+}
+
+// SetLogo reemplaza el logo; el formato se detecta por el contenido
+func (s *Service) SetLogo(ctx context.Context, logo []byte) (*domain.BusinessSettings, error) {
+	if len(logo) == 0 {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "logo is empty")
+	}
+	if len(logo) > MaxLogoSize {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("logo must be at most %d KB", MaxLogoSize/1024))
+	}
+
+	mime := http.DetectContentType(logo)
+	valid := false
+	for _, allowed := range logoMimes {
+		valid = valid || mime == allowed
+	}
+	if !valid {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "logo must be a PNG or JPEG image")
+	}
+
+	if err := s.Repo.UpdateLogo(ctx, logo, mime); err != nil {
+		return nil, err
+	}
+
+	return s.Repo.Get(ctx)
+}
+
+func (s *Service) DeleteLogo(ctx context.Context) (*domain.BusinessSettings, error) {
+	if err := s.Repo.UpdateLogo(ctx, nil, ""); err != nil {
+		return nil, err
+	}
+
+	return s.Repo.Get(ctx)
+}
+
+// normalizePrinterAddress valida host[:puerto] y completa el puerto 9100 si falta.
+// Vacío es válido (sin ticketera de red).
+func normalizePrinterAddress(value string) (string, error) {
+	address := strings.TrimSpace(value)
+	if address == "" {
+		return "", nil
+	}
+
+	invalid := domain.NewAppError(domain.ErrCodeInvalidParams, "ticket_printer must be host or host:port")
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		host, port = address, defaultPrinterPort
+	}
+	if host == "" || strings.ContainsAny(host, " /") || len(address) > 255 {
+		return "", invalid
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", invalid
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+// normalizeCUIT valida el dígito verificador y devuelve el CUIT como XX-XXXXXXXX-X.
+// Vacío es válido (el comercio puede no cargarlo).
+func normalizeCUIT(value string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '.' {
+			return -1
+		}
+		return r
+	}, value)
+
+	if digits == "" {
+		return "", nil
+	}
+
+	invalid := domain.NewAppError(domain.ErrCodeInvalidParams, "cuit must have 11 digits and a valid check digit")
+	if len(digits) != 11 {
+		return "", invalid
+	}
+
+	weights := []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i, r := range digits {
+		if r < '0' || r > '9' {
+			return "", invalid
+		}
+		if i < len(weights) {
+			sum += int(r-'0') * weights[i]
+		}
+	}
+
+	check := 11 - sum%11
+	switch check {
+	case 11:
+		check = 0
+	case 10:
+		check = 9
+	}
+	if check != int(digits[10]-'0') {
+		return "", invalid
+	}
+
+	return digits[:2] + "-" + digits[2:10] + "-" + digits[10:], nil
+}
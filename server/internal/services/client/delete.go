@@ -1,6 +1,23 @@
 package client
 
+import (
+	"context"
+	"strconv"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
 func (s *Service) Delete(id string) error {
 	// Delete the client (database will cascade delete sales, quotas, payments, and sale_products)
-	return s.Repo.Delete(id)
+	if err := s.Repo.Delete(id); err != nil {
+		return err
+	}
+
+	if s.Events != nil {
+		clientID, _ := strconv.ParseInt(id, 10, 64)
+		s.Events.Publish(context.Background(), domain.NewEvent(domain.EventClientDeleted, domain.ClientDeletedData{
+			ClientID: clientID,
+		}))
+	}
+	return nil
 }
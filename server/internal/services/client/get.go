@@ -9,7 +9,13 @@ import (
 	"github.com/benitez96/gostore/internal/domain"
 )
 
-func (s Service) GetAll(search string, limit, offset int, stateIds []int64) (clients *domain.Paginated[*domain.ClientSummary], err error) {
+func (s Service) GetAll(search string, limit, offset int, stateIds []int64, sort string) (clients *domain.Paginated[*domain.ClientSummary], err error) {
+	switch sort {
+	case "", domain.ClientSortName, domain.ClientSortRisk, domain.ClientSortRiskDesc:
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams,
+			fmt.Sprintf("sort must be one of %s, %s or %s", domain.ClientSortName, domain.ClientSortRisk, domain.ClientSortRiskDesc))
+	}
 
 	var (
 		res   []*domain.ClientSummary
@@ -27,7 +33,7 @@ func (s Service) GetAll(search string, limit, offset int, stateIds []int64) (cli
 	go func() {
 		defer wg.Done()
 		var err error
-		res, err = s.Repo.GetAll(search, limit, offset, stateIds)
+		res, err = s.Repo.GetAll(search, limit, offset, stateIds, sort)
 		if err != nil {
 			errC = fmt.Errorf("unexpected error getting clients: %w", err)
 		}
@@ -10,4 +10,6 @@ var _ ports.ClientService = &Service{}
 type Service struct {
 	Repo ports.ClientRepository
 	SaleSvc ports.SaleService
+	// Opcional: evento de cliente borrado
+	Events ports.EventPublisher
 }
@@ -0,0 +1,53 @@
+package collector
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/auth"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+// AttributePayment completa el cobrador del pago antes de registrarlo.
+// Si lo registra un cobrador queda a su nombre; un cobrador sin permiso de ventas
+// solo puede cobrar cuotas de los clientes que tiene asignados.
+func (s *Service) AttributePayment(ctx context.Context, payment *domain.Payment) error {
+	actor, ok := auth.ActorFromContext(ctx)
+	isCollector := ok && actor.Type == domain.ActorTypeUser &&
+		constants.HasPermission(actor.Permissions, constants.PermissionCollector)
+
+	if payment.CollectorID == nil && isCollector {
+		collectorID := actor.ID
+		payment.CollectorID = &collectorID
+	}
+
+	if ok && !constants.HasPermission(actor.Permissions, constants.PermissionSales) {
+		if !isCollector || *payment.CollectorID != actor.ID {
+			return domain.NewAppError(domain.ErrCodeForbidden, "collectors can only register payments in their own name")
+		}
+
+		assigned, err := s.Repo.GetQuotaCollectorID(ctx, payment.QuotaID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.NewAppError(domain.ErrCodeNotFound, "quota not found")
+			}
+			return err
+		}
+		if assigned == nil || *assigned != actor.ID {
+			return domain.NewAppError(domain.ErrCodeForbidden, "the quota belongs to a client not assigned to this collector")
+		}
+		return nil
+	}
+
+	if payment.CollectorID != nil {
+		if _, err := s.Repo.GetByID(ctx, *payment.CollectorID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.NewAppError(domain.ErrCodeInvalidParams, "collector_id is not a collector")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
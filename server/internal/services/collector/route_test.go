@@ -0,0 +1,30 @@
+package collector
+
+import "testing"
+
+func TestCommission(t *testing.T) {
+	tests := []struct {
+		name  string
+		total float64
+		rate  float64
+		want  float64
+	}{
+		{name: "sin cobros", total: 0, rate: 10, want: 0},
+		{name: "sin comisión", total: 1500, rate: 0, want: 0},
+		{name: "exacta", total: 100, rate: 10, want: 10},
+		{name: "tasa con decimales", total: 1234.56, rate: 7.5, want: 92.59},
+		{name: "medio centavo redondea para arriba", total: 1.15, rate: 50, want: 0.58},
+		{name: "medio centavo con tasa chica", total: 0.05, rate: 10, want: 0.01},
+		{name: "un centavo", total: 0.01, rate: 50, want: 0.01},
+		{name: "total grande", total: 1_000_000, rate: 2.5, want: 25_000},
+		{name: "todo el cobro", total: 33.33, rate: 100, want: 33.33},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commission(tt.total, tt.rate); got != tt.want {
+				t.Errorf("commission(%v, %v) = %v, want %v", tt.total, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package collector
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/auth"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+// MaxZoneLength es el largo de la columna clients.zone
+const MaxZoneLength = 100
+
+// Make sure Service implements ports.CollectorService
+// at compile time
+var _ ports.CollectorService = &Service{}
+
+type Service struct {
+	Repo ports.CollectorRepository
+	// Opcional: garantes de los clientes suspendidos en la hoja de ruta
+	Guarantors ports.GuarantorRepository
+}
+
+func (s *Service) GetAll(ctx context.Context) ([]*domain.Collector, error) {
+	return s.Repo.GetAll(ctx)
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*domain.Collector, error) {
+	collector, err := s.Repo.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.NewAppError(domain.ErrCodeNotFound, "collector not found")
+	}
+	return collector, err
+}
+
+// checkAccess deja ver los datos de un cobrador a quien tiene permiso de ventas o al propio cobrador.
+// Sin actor en el contexto (procesos internos) no hay restricción.
+func checkAccess(ctx context.Context, collectorID int64) error {
+	actor, ok := auth.ActorFromContext(ctx)
+	if !ok || constants.HasPermission(actor.Permissions, constants.PermissionSales) {
+		return nil
+	}
+	if actor.Type == domain.ActorTypeUser && actor.ID == collectorID {
+		return nil
+	}
+	return domain.NewAppError(domain.ErrCodeForbidden, "collectors can only access their own route and totals")
+}
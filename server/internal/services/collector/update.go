@@ -0,0 +1,57 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+// Update cambia la comisión del cobrador
+func (s *Service) Update(ctx context.Context, id int64, req dto.UpdateCollectorRequest) (*domain.Collector, error) {
+	if req.CommissionRate == nil {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "commission_rate is required")
+	}
+	if rate := *req.CommissionRate; rate < 0 || rate > 100 {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "commission_rate must be between 0 and 100")
+	}
+
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repo.UpdateCommission(ctx, id, *req.CommissionRate); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// AssignClient asigna el cliente a un cobrador y una zona, o lo desasigna con collector_id null
+func (s *Service) AssignClient(ctx context.Context, clientID int64, req dto.AssignCollectorRequest) error {
+	zone := strings.TrimSpace(req.Zone)
+	if utf8.RuneCountInString(zone) > MaxZoneLength {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("zone must be at most %d characters", MaxZoneLength))
+	}
+
+	if req.CollectorID != nil {
+		if _, err := s.Repo.GetByID(ctx, *req.CollectorID); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.NewAppError(domain.ErrCodeInvalidParams, "collector_id is not a collector")
+			}
+			return err
+		}
+	}
+
+	if err := s.Repo.AssignClient(ctx, clientID, req.CollectorID, zone); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "client not found")
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package credit
+
+import (
+	"testing"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func TestEvaluate(t *testing.T) {
+	money := func(v float64) *float64 { return &v }
+	count := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name       string
+		status     domain.CreditStatus
+		policy     *domain.CreditPolicy
+		amount     float64
+		violations int
+	}{
+		{
+			name:   "sin política ni límites",
+			status: domain.CreditStatus{ClientID: 1, StateID: 1, Balance: 50000, ActiveSales: 5},
+			amount: 10000,
+		},
+		{
+			name:       "sin política pero con límites propios",
+			status:     domain.CreditStatus{ClientID: 1, StateID: 1, Balance: 800, Limits: domain.CreditLimits{MaxBalance: money(1000)}},
+			amount:     300,
+			violations: 1,
+		},
+		{
+			name:       "el estado no permite vender",
+			status:     domain.CreditStatus{ClientID: 1, StateID: 3},
+			policy:     &domain.CreditPolicy{StateID: 3, StateDescription: "Suspended"},
+			amount:     100,
+			violations: 1,
+		},
+		{
+			name:   "el límite propio reemplaza al de la política",
+			status: domain.CreditStatus{ClientID: 1, StateID: 1, Balance: 800, Limits: domain.CreditLimits{MaxBalance: money(5000)}},
+			policy: &domain.CreditPolicy{StateID: 1, AllowSales: true, MaxBalance: money(1000)},
+			amount: 300,
+		},
+		{
+			name:   "medio centavo de tolerancia",
+			status: domain.CreditStatus{ClientID: 1, StateID: 1, Balance: 700},
+			policy: &domain.CreditPolicy{StateID: 1, AllowSales: true, MaxBalance: money(1000)},
+			amount: 300.004,
+		},
+		{
+			name:       "tope de ventas activas",
+			status:     domain.CreditStatus{ClientID: 1, StateID: 1, ActiveSales: 2},
+			policy:     &domain.CreditPolicy{StateID: 1, AllowSales: true, MaxActiveSales: count(2)},
+			amount:     100,
+			violations: 1,
+		},
+		{
+			name:       "todas las reglas a la vez",
+			status:     domain.CreditStatus{ClientID: 1, StateID: 2, Balance: 900, ActiveSales: 3},
+			policy:     &domain.CreditPolicy{StateID: 2, MaxBalance: money(1000), MaxActiveSales: count(1)},
+			amount:     200,
+			violations: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := evaluate(&tt.status, tt.policy, tt.amount)
+			if len(check.Violations) != tt.violations {
+				t.Errorf("got violations %q, want %d", check.Violations, tt.violations)
+			}
+			if check.ClientID != tt.status.ClientID || check.Amount != tt.amount {
+				t.Errorf("check does not describe the evaluated sale: %+v", check)
+			}
+		})
+	}
+}
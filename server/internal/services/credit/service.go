@@ -0,0 +1,213 @@
+package credit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/auth"
+	"github.com/benitez96/gostore/internal/shared/constants"
+)
+
+// MaxReasonLength limita el motivo de una autorización
+const MaxReasonLength = 500
+
+// Make sure Service implements ports.CreditService
+// at compile time
+var _ ports.CreditService = &Service{}
+
+type Service struct {
+	Repo ports.CreditRepository
+}
+
+func (s *Service) Check(ctx context.Context, clientID int64, amount float64) (*domain.CreditCheck, error) {
+	status, err := s.Repo.GetStatus(ctx, clientID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.NewAppError(domain.ErrCodeNotFound, "client not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.Repo.GetPolicy(ctx, status.StateID)
+	if errors.Is(err, domain.ErrNotFound) {
+		policy = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return evaluate(status, policy, amount), nil
+}
+
+func (s *Service) Authorize(ctx context.Context, clientID int64, amount float64, overrideReason string) (ports.SaleCreditGuard, error) {
+	check, err := s.Check(ctx, clientID, amount)
+	if err != nil {
+		return nil, err
+	}
+	if check.Allowed() {
+		return &saleAuthorization{amount: amount}, nil
+	}
+
+	violations := strings.Join(check.Violations, "; ")
+	reason := strings.TrimSpace(overrideReason)
+	if reason == "" {
+		return nil, blocked(check)
+	}
+	if utf8.RuneCountInString(reason) > MaxReasonLength {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams,
+			fmt.Sprintf("override_reason must be at most %d characters", MaxReasonLength))
+	}
+
+	actor, ok := auth.ActorFromContext(ctx)
+	if !ok || !isManager(actor) {
+		return nil, domain.NewAppError(domain.ErrCodeForbidden,
+			fmt.Sprintf("only managers can override credit rules (%s)", violations))
+	}
+
+	userID := actor.ID
+	return &saleAuthorization{
+		amount: amount,
+		override: &domain.CreditOverride{
+			ClientID:   clientID,
+			UserID:     &userID,
+			UserName:   actor.Name,
+			Reason:     reason,
+			Violations: check.Violations,
+			Amount:     amount,
+		},
+	}, nil
+}
+
+// saleAuthorization es una venta aceptada por Authorize, que se vuelve a controlar al crearla
+type saleAuthorization struct {
+	amount   float64
+	override *domain.CreditOverride
+}
+
+// Recheck vuelve a evaluar la venta dentro de la transacción. Si ahora cumple las reglas la autorización
+// ya no hace falta; si no las cumple solo pasa con la autorización de un encargado, que queda con las
+// reglas salteadas según este último control.
+func (a *saleAuthorization) Recheck(status *domain.CreditStatus, policy *domain.CreditPolicy) error {
+	check := evaluate(status, policy, a.amount)
+	switch {
+	case check.Allowed():
+		a.override = nil
+	case a.override == nil:
+		return blocked(check)
+	default:
+		a.override.Violations = check.Violations
+	}
+	return nil
+}
+
+func (a *saleAuthorization) Override() *domain.CreditOverride {
+	return a.override
+}
+
+func (s *Service) GetPolicies(ctx context.Context) ([]*domain.CreditPolicy, error) {
+	return s.Repo.GetPolicies(ctx)
+}
+
+func (s *Service) UpdatePolicy(ctx context.Context, policy *domain.CreditPolicy) error {
+	if err := validateLimits(policy.MaxBalance, policy.MaxActiveSales); err != nil {
+		return err
+	}
+	if err := s.Repo.SavePolicy(ctx, policy); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "state not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateClientLimits cambia los límites propios del cliente; solo lo puede hacer un encargado
+func (s *Service) UpdateClientLimits(ctx context.Context, clientID int64, limits domain.CreditLimits) error {
+	if actor, ok := auth.ActorFromContext(ctx); ok && !isManager(actor) {
+		return domain.NewAppError(domain.ErrCodeForbidden, "only managers can change credit limits")
+	}
+	if err := validateLimits(limits.MaxBalance, limits.MaxActiveSales); err != nil {
+		return err
+	}
+	if err := s.Repo.UpdateClientLimits(ctx, clientID, limits); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "client not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) GetOverrides(ctx context.Context, clientID int64, limit, offset int) ([]*domain.CreditOverride, error) {
+	return s.Repo.GetOverrides(ctx, clientID, limit, offset)
+}
+
+// evaluate aplica los límites del cliente, o los de la política de su estado, a una venta nueva de amount.
+// policy nil es un estado sin política cargada: sin reglas propias del estado.
+func evaluate(status *domain.CreditStatus, policy *domain.CreditPolicy, amount float64) *domain.CreditCheck {
+	if policy == nil {
+		policy = &domain.CreditPolicy{StateID: status.StateID, AllowSales: true}
+	}
+	check := &domain.CreditCheck{
+		ClientID:       status.ClientID,
+		StateID:        status.StateID,
+		Balance:        status.Balance,
+		ActiveSales:    status.ActiveSales,
+		Amount:         amount,
+		AllowSales:     policy.AllowSales,
+		MaxBalance:     status.Limits.MaxBalance,
+		MaxActiveSales: status.Limits.MaxActiveSales,
+		Violations:     []string{},
+	}
+	if check.MaxBalance == nil {
+		check.MaxBalance = policy.MaxBalance
+	}
+	if check.MaxActiveSales == nil {
+		check.MaxActiveSales = policy.MaxActiveSales
+	}
+
+	if !policy.AllowSales {
+		state := policy.StateDescription
+		if state == "" {
+			state = fmt.Sprintf("%d", policy.StateID)
+		}
+		check.Violations = append(check.Violations, fmt.Sprintf("clients in state %s cannot buy on credit", state))
+	}
+	// Medio centavo de tolerancia por el redondeo de las cuotas
+	if check.MaxBalance != nil && status.Balance+amount > *check.MaxBalance+0.005 {
+		check.Violations = append(check.Violations, fmt.Sprintf("outstanding balance would be %.2f, above the limit of %.2f",
+			status.Balance+amount, *check.MaxBalance))
+	}
+	if check.MaxActiveSales != nil && status.ActiveSales+1 > *check.MaxActiveSales {
+		check.Violations = append(check.Violations, fmt.Sprintf("client already has %d active sales, the limit is %d",
+			status.ActiveSales, *check.MaxActiveSales))
+	}
+
+	return check
+}
+
+// blocked es el error de una venta que no cumple las reglas de crédito y no tiene autorización
+func blocked(check *domain.CreditCheck) error {
+	return domain.NewAppError(domain.ErrCodeCreditLimitExceeded,
+		fmt.Sprintf("sale blocked by credit rules: %s; a manager can authorize it with an override_reason", strings.Join(check.Violations, "; ")))
+}
+
+func validateLimits(maxBalance *float64, maxActiveSales *int64) error {
+	if maxBalance != nil && *maxBalance < 0 {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "max_balance cannot be negative")
+	}
+	if maxActiveSales != nil && *maxActiveSales < 0 {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "max_active_sales cannot be negative")
+	}
+	return nil
+}
+
+// isManager indica si el actor puede saltear las reglas de crédito: un usuario con acceso a reportes (encargado o admin).
+// Las API keys nunca pueden, porque la autorización tiene que quedar a nombre de una persona.
+func isManager(actor *domain.Actor) bool {
+	return actor.Type == domain.ActorTypeUser && constants.HasPermission(actor.Permissions, constants.PermissionDashboard)
+}
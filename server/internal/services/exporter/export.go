@@ -0,0 +1,106 @@
+package exporter
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+func (s *Service) ExportClients(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error {
+	table, err := newTableWriter(w, format, "Clientes", []string{
+		"ID", "Apellido", "Nombre", "DNI", "Email", "Teléfono", "Dirección", "Estado", "Alta",
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportBatches(ctx, table,
+		func(afterID int64) ([]*domain.ClientExport, error) {
+			return s.Repo.ExportClients(ctx, filter, afterID, batchSize)
+		},
+		func(c *domain.ClientExport) int64 { return c.ID },
+		func(c *domain.ClientExport) []any {
+			var createdAt any
+			if c.CreatedAt != nil {
+				createdAt = *c.CreatedAt
+			}
+			return []any{c.ID, c.Lastname, c.Name, c.Dni, c.Email, c.Phone, c.Address, c.State, createdAt}
+		},
+	)
+}
+
+func (s *Service) ExportSales(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error {
+	table, err := newTableWriter(w, format, "Ventas", []string{
+		"ID", "Fecha", "DNI", "Apellido", "Nombre", "Productos", "Monto", "Pagado", "Saldo", "Cuotas", "Pagada", "Estado",
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportBatches(ctx, table,
+		func(afterID int64) ([]*domain.SaleExport, error) {
+			return s.Repo.ExportSales(ctx, filter, afterID, batchSize)
+		},
+		func(s *domain.SaleExport) int64 { return s.ID },
+		func(s *domain.SaleExport) []any {
+			return []any{
+				s.ID, s.Date, s.ClientDni, s.ClientLastname, s.ClientName, s.Products,
+				s.Amount, s.PaidAmount, max(s.Amount-s.PaidAmount, 0), s.QuotaCount, yesNo(s.IsPaid), s.State,
+			}
+		},
+	)
+}
+
+func (s *Service) ExportQuotas(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error {
+	table, err := newTableWriter(w, format, "Cuotas", []string{
+		"ID", "Venta", "Cuota", "DNI", "Apellido", "Nombre", "Monto", "Vencimiento", "Pagado", "Saldo", "Pagada", "Estado",
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportBatches(ctx, table,
+		func(afterID int64) ([]*domain.QuotaExport, error) {
+			return s.Repo.ExportQuotas(ctx, filter, afterID, batchSize)
+		},
+		func(q *domain.QuotaExport) int64 { return q.ID },
+		func(q *domain.QuotaExport) []any {
+			return []any{
+				q.ID, q.SaleID, q.Number, q.ClientDni, q.ClientLastname, q.ClientName,
+				q.Amount, q.DueDate, q.PaidAmount, max(q.Amount-q.PaidAmount, 0), yesNo(q.IsPaid), q.State,
+			}
+		},
+	)
+}
+
+func (s *Service) ExportPayments(ctx context.Context, w io.Writer, format string, filter *dto.ExportFilter) error {
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "to date cannot be before from date")
+	}
+
+	table, err := newTableWriter(w, format, "Pagos", []string{
+		"ID", "Fecha", "Monto", "Venta", "Cuota", "DNI", "Apellido", "Nombre",
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportBatches(ctx, table,
+		func(afterID int64) ([]*domain.PaymentExport, error) {
+			return s.Repo.ExportPayments(ctx, filter, afterID, batchSize)
+		},
+		func(p *domain.PaymentExport) int64 { return p.ID },
+		func(p *domain.PaymentExport) []any {
+			return []any{p.ID, p.Date, p.Amount, p.SaleID, p.QuotaNumber, p.ClientDni, p.ClientLastname, p.ClientName}
+		},
+	)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Sí"
+	}
+	return "No"
+}
@@ -0,0 +1,68 @@
+package exporter
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the ExportService interface
+// at compile time.
+var _ ports.ExportService = &Service{}
+
+// batchSize es la cantidad de filas que se leen de la base por vez
+const batchSize = 1000
+
+// Service exporta clientes, ventas, cuotas y pagos a CSV o XLSX.
+// Lee por lotes y escribe a medida que avanza, sin cargar toda la tabla en memoria.
+type Service struct {
+	Repo ports.ExportRepository
+}
+
+// exportBatches recorre la consulta lote por lote y escribe cada fila.
+// Si algo falla a mitad de camino se descarta la planilla para liberar sus archivos temporales.
+func exportBatches[T any](
+	ctx context.Context,
+	table tableWriter,
+	fetch func(afterID int64) ([]T, error),
+	id func(T) int64,
+	record func(T) []any,
+) error {
+	if err := writeBatches(ctx, table, fetch, id, record); err != nil {
+		table.Abort()
+		return err
+	}
+	return table.Close()
+}
+
+func writeBatches[T any](
+	ctx context.Context,
+	table tableWriter,
+	fetch func(afterID int64) ([]T, error),
+	id func(T) int64,
+	record func(T) []any,
+) error {
+	var afterID int64
+	for {
+		// Si el cliente cortó la descarga no tiene sentido seguir leyendo
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, err := fetch(afterID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range batch {
+			if err := table.Write(record(item)); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < batchSize {
+			return nil
+		}
+		afterID = id(batch[len(batch)-1])
+	}
+}
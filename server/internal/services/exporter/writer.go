@@ -0,0 +1,194 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// ContentType devuelve el Content-Type del formato, o "" si no se soporta
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return ""
+}
+
+// tableWriter escribe una planilla fila por fila. Close termina el archivo;
+// Abort lo descarta cuando la exportación no puede terminar.
+type tableWriter interface {
+	Write(row []any) error
+	Close() error
+	Abort()
+}
+
+func newTableWriter(w io.Writer, format, sheet string, header []string) (tableWriter, error) {
+	var (
+		table tableWriter
+		err   error
+	)
+	switch format {
+	case FormatCSV:
+		table = newCSVWriter(w)
+	case FormatXLSX:
+		table, err = newXLSXWriter(w, sheet)
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("unsupported format: %s", format))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	row := make([]any, len(header))
+	for i, h := range header {
+		row[i] = h
+	}
+	if err := table.Write(row); err != nil {
+		table.Abort()
+		return nil, err
+	}
+	return table, nil
+}
+
+// csvWriter escribe CSV con BOM para que Excel reconozca los acentos
+type csvWriter struct {
+	buf *bufio.Writer
+	csv *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	buf := bufio.NewWriter(w)
+	buf.WriteString("\uFEFF")
+	return &csvWriter{buf: buf, csv: csv.NewWriter(buf)}
+}
+
+func (c *csvWriter) Write(row []any) error {
+	record := make([]string, len(row))
+	for i, v := range row {
+		record[i] = formatValue(v)
+	}
+	return c.csv.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return err
+	}
+	return c.buf.Flush()
+}
+
+// Abort no tiene nada que liberar: lo escrito ya salió por la respuesta
+func (c *csvWriter) Abort() {}
+
+// escapeFormula antepone un apóstrofo a los textos que una planilla interpretaría
+// como fórmula (por ejemplo un nombre de cliente que empieza con "=")
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func formatValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return escapeFormula(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case float64:
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	case time.Time:
+		return value.Format("2006-01-02")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// xlsxWriter usa el StreamWriter de excelize, que pasa a un archivo temporal
+// cuando la hoja crece y así no mantiene todas las filas en memoria
+type xlsxWriter struct {
+	w         io.Writer
+	file      *excelize.File
+	stream    *excelize.StreamWriter
+	dateStyle int
+	row       int
+}
+
+func newXLSXWriter(w io.Writer, sheet string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName("Sheet1", sheet); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	dateFormat := "dd/mm/yyyy"
+	dateStyle, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{w: w, file: file, stream: stream, dateStyle: dateStyle}, nil
+}
+
+func (x *xlsxWriter) Write(row []any) error {
+	x.row++
+	cells := make([]any, len(row))
+	for i, v := range row {
+		switch value := v.(type) {
+		case time.Time:
+			cells[i] = excelize.Cell{StyleID: x.dateStyle, Value: value}
+		case nil:
+			cells[i] = ""
+		case string:
+			cells[i] = escapeFormula(value)
+		default:
+			cells[i] = value
+		}
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.stream.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := x.file.WriteTo(x.w)
+	return err
+}
+
+// Abort cierra el archivo de excelize, que borra lo que el StreamWriter haya pasado a disco
+func (x *xlsxWriter) Abort() {
+	x.file.Close()
+}
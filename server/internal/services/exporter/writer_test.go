@@ -0,0 +1,28 @@
+package exporter
+
+import "testing"
+
+func TestEscapeFormula(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "vacío", value: "", want: ""},
+		{name: "texto común", value: "Pérez", want: "Pérez"},
+		{name: "fórmula", value: "=HYPERLINK(\"http://x\")", want: "'=HYPERLINK(\"http://x\")"},
+		{name: "suma", value: "+54 381 555-1234", want: "'+54 381 555-1234"},
+		{name: "resta", value: "-1+1", want: "'-1+1"},
+		{name: "arroba", value: "@SUM(A1)", want: "'@SUM(A1)"},
+		{name: "tabulación", value: "\t=1", want: "'\t=1"},
+		{name: "signo en el medio", value: "Juan = Pedro", want: "Juan = Pedro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeFormula(tt.value); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package guarantor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements ports.GuarantorService
+// at compile time
+var _ ports.GuarantorService = &Service{}
+
+type Service struct {
+	Repo ports.GuarantorRepository
+}
+
+func (s *Service) Create(ctx context.Context, guarantor *domain.Guarantor) error {
+	if err := validate(guarantor); err != nil {
+		return err
+	}
+	return manageError(s.Repo.Create(ctx, guarantor))
+}
+
+func (s *Service) Update(ctx context.Context, guarantor *domain.Guarantor) error {
+	if err := validate(guarantor); err != nil {
+		return err
+	}
+	return manageError(s.Repo.Update(ctx, guarantor))
+}
+
+// Delete borra el garante solo si ya no garantiza ninguna venta
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	guarantor, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(guarantor.SaleIDs) > 0 {
+		return domain.NewAppError(domain.ErrCodeInvalidParams,
+			fmt.Sprintf("guarantor is linked to %d sales; unlink them first", len(guarantor.SaleIDs)))
+	}
+	return manageError(s.Repo.Delete(ctx, id))
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*domain.Guarantor, error) {
+	guarantor, err := s.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, manageError(err)
+	}
+	return guarantor, nil
+}
+
+func (s *Service) GetAll(ctx context.Context, search string, limit, offset int) ([]*domain.Guarantor, error) {
+	return s.Repo.GetAll(ctx, strings.TrimSpace(search), limit, offset)
+}
+
+func (s *Service) GetBySaleID(ctx context.Context, saleID int64) ([]*domain.Guarantor, error) {
+	bySale, err := s.Repo.GetBySaleIDs(ctx, []int64{saleID})
+	if err != nil {
+		return nil, err
+	}
+	if bySale[saleID] == nil {
+		return []*domain.Guarantor{}, nil
+	}
+	return bySale[saleID], nil
+}
+
+func (s *Service) Link(ctx context.Context, saleID, guarantorID int64) error {
+	if _, err := s.GetByID(ctx, guarantorID); err != nil {
+		return err
+	}
+	if err := s.Repo.Link(ctx, saleID, guarantorID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "sale not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) Unlink(ctx context.Context, saleID, guarantorID int64) error {
+	if err := s.Repo.Unlink(ctx, saleID, guarantorID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "guarantor is not linked to the sale")
+		}
+		return err
+	}
+	return nil
+}
+
+// FollowUps lista las ventas con garante de los clientes suspendidos que tienen cuotas vencidas
+func (s *Service) FollowUps(ctx context.Context) ([]*domain.GuarantorFollowUp, error) {
+	followUps, err := s.Repo.GetFollowUps(ctx, domain.StateSuspended, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	saleIDs := make([]int64, len(followUps))
+	for i, followUp := range followUps {
+		saleIDs[i] = followUp.SaleID
+	}
+	bySale, err := s.Repo.GetBySaleIDs(ctx, saleIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, followUp := range followUps {
+		followUp.Guarantors = bySale[followUp.SaleID]
+	}
+
+	return followUps, nil
+}
+
+// validate normaliza los datos del garante y controla los obligatorios y los largos de las columnas
+func validate(guarantor *domain.Guarantor) error {
+	fields := []struct {
+		name     string
+		value    *string
+		max      int
+		required bool
+	}{
+		{"name", &guarantor.Name, 255, true},
+		{"lastname", &guarantor.Lastname, 255, true},
+		{"dni", &guarantor.Dni, 20, true},
+		{"phone", &guarantor.Phone, 50, false},
+		{"address", &guarantor.Address, 255, false},
+		{"relationship", &guarantor.Relationship, 100, false},
+	}
+
+	for _, field := range fields {
+		*field.value = strings.TrimSpace(*field.value)
+		if field.required && *field.value == "" {
+			return domain.NewAppError(domain.ErrCodeInvalidParams, field.name+" is required")
+		}
+		if utf8.RuneCountInString(*field.value) > field.max {
+			return domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("%s must be at most %d characters", field.name, field.max))
+		}
+	}
+	return nil
+}
+
+// manageError traduce los errores del repositorio a errores de la API
+func manageError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, domain.ErrNotFound):
+		return domain.NewAppError(domain.ErrCodeNotFound, "guarantor not found")
+	case errors.Is(err, domain.ErrDuplicateKey):
+		return domain.NewAppError(domain.ErrCodeDuplicateKey, "a guarantor with that dni already exists")
+	}
+	return err
+}
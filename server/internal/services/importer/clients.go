@@ -0,0 +1,144 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+var clientFields = []field{
+	{Name: "name", Aliases: []string{"nombre", "nombres"}, Required: true},
+	{Name: "lastname", Aliases: []string{"apellido", "apellidos"}, Required: true},
+	{Name: "dni", Aliases: []string{"documento", "nro documento", "cuit", "cuil"}, Required: true},
+	{Name: "email", Aliases: []string{"correo", "mail", "e mail"}},
+	{Name: "phone", Aliases: []string{"telefono", "tel", "celular"}},
+	{Name: "address", Aliases: []string{"direccion", "domicilio"}},
+}
+
+func (s *Service) ImportClients(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error) {
+	header, rows, err := readRows(file, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := resolveColumns(header, clientFields, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.Repo.GetClientDNIs(ctx)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+	taken := make(map[string]bool, len(existing))
+	for _, dni := range existing {
+		taken[normalizeDNI(dni)] = true
+	}
+
+	result := newResult(domain.ImportEntityClients, opts.DryRun, cols.describe(header))
+	seenInFile := make(map[string]int)
+	clients := make([]*domain.Client, 0, len(rows))
+
+	for i, row := range rows {
+		rowNumber := i + 2 // +1 por el encabezado, +1 porque las planillas cuentan desde 1
+		if isEmptyRow(row) {
+			continue
+		}
+		result.TotalRows++
+
+		client := &domain.Client{
+			Name:     cols.get(row, "name"),
+			Lastname: cols.get(row, "lastname"),
+			Dni:      normalizeDNI(cols.get(row, "dni")),
+			Email:    cols.get(row, "email"),
+			Phone:    cols.get(row, "phone"),
+			Address:  cols.get(row, "address"),
+		}
+
+		var rowErrors []domain.ImportRowError
+		addError := func(field, msg string) {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: rowNumber, Field: field, Message: msg})
+		}
+
+		if client.Name == "" {
+			addError("name", "name is required")
+		}
+		if client.Lastname == "" {
+			addError("lastname", "lastname is required")
+		}
+		switch {
+		case client.Dni == "":
+			addError("dni", "dni is required")
+		case len(client.Dni) > 20:
+			addError("dni", "dni is too long")
+		case taken[client.Dni]:
+			addError("dni", fmt.Sprintf("a client with dni %s already exists", client.Dni))
+		case seenInFile[client.Dni] != 0:
+			addError("dni", fmt.Sprintf("dni %s is repeated in row %d", client.Dni, seenInFile[client.Dni]))
+		}
+		if client.Email != "" {
+			if _, err := mail.ParseAddress(client.Email); err != nil {
+				addError("email", "invalid email")
+			}
+		}
+
+		if client.Dni != "" && seenInFile[client.Dni] == 0 {
+			seenInFile[client.Dni] = rowNumber
+		}
+
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		clients = append(clients, client)
+	}
+
+	result.ValidRows = len(clients)
+	result.Preview = clients[:min(len(clients), previewSize)]
+
+	if opts.DryRun || len(result.Errors) > 0 || len(clients) == 0 {
+		return result, nil
+	}
+
+	if err := s.Repo.InsertClients(ctx, clients); err != nil {
+		// Un DNI cargado por otro usuario durante la importación también revierte todo
+		if errors.Is(err, domain.ErrDuplicateKey) {
+			return nil, domain.NewAppError(domain.ErrCodeDuplicateKey, err.Error())
+		}
+		return nil, domain.ManageError(err)
+	}
+	result.Imported = len(clients)
+
+	return result, nil
+}
+
+// readRows lee el archivo y separa el encabezado de los datos
+func readRows(file io.Reader, format string) ([]string, [][]string, error) {
+	table, err := readTable(file, format)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(table) == 0 {
+		return nil, nil, domain.NewAppError(domain.ErrCodeInvalidParams, "file is empty")
+	}
+	if len(table)-1 > maxRows {
+		return nil, nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("file has more than %d rows, split it in smaller files", maxRows))
+	}
+	return table[0], table[1:], nil
+}
+
+func newResult(entity string, dryRun bool, cols map[string]string) *domain.ImportResult {
+	return &domain.ImportResult{
+		Entity:  entity,
+		DryRun:  dryRun,
+		Columns: cols,
+		Errors:  []domain.ImportRowError{},
+	}
+}
@@ -0,0 +1,120 @@
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// field es una columna que entiende el importador, con los encabezados que se reconocen solos
+type field struct {
+	Name     string
+	Aliases  []string
+	Required bool
+}
+
+// columns ubica cada campo en la fila de encabezado
+type columns map[string]int
+
+// resolveColumns usa el mapeo explícito si lo hay y si no busca por alias
+func resolveColumns(header []string, fields []field, mapping map[string]string) (columns, error) {
+	positions := make(map[string]int, len(header))
+	for i, h := range header {
+		key := normalizeHeader(h)
+		if _, exists := positions[key]; !exists && key != "" {
+			positions[key] = i
+		}
+	}
+
+	known := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		known[f.Name] = true
+	}
+	for name := range mapping {
+		if !known[name] {
+			return nil, domain.NewAppError(
+				domain.ErrCodeInvalidParams,
+				fmt.Sprintf("unknown field in mapping: %s", name))
+		}
+	}
+
+	cols := make(columns, len(fields))
+	var missing []string
+	for _, f := range fields {
+		if headerName, ok := mapping[f.Name]; ok {
+			pos, found := positions[normalizeHeader(headerName)]
+			if !found {
+				return nil, domain.NewAppError(
+					domain.ErrCodeInvalidParams,
+					fmt.Sprintf("column %q mapped to %s not found in file", headerName, f.Name))
+			}
+			cols[f.Name] = pos
+			continue
+		}
+
+		for _, alias := range append([]string{f.Name}, f.Aliases...) {
+			if pos, found := positions[normalizeHeader(alias)]; found {
+				cols[f.Name] = pos
+				break
+			}
+		}
+		if _, found := cols[f.Name]; !found && f.Required {
+			missing = append(missing, f.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("missing required columns: %s", strings.Join(missing, ", ")))
+	}
+
+	return cols, nil
+}
+
+// get devuelve el valor del campo en la fila (o "" si la columna no está)
+func (c columns) get(row []string, name string) string {
+	pos, ok := c[name]
+	if !ok || pos >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[pos])
+}
+
+// describe devuelve campo -> encabezado usado, para mostrar en la vista previa
+func (c columns) describe(header []string) map[string]string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make(map[string]string, len(c))
+	for _, name := range names {
+		out[name] = header[c[name]]
+	}
+	return out
+}
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ñ", "n",
+	"_", " ", "-", " ", ".", "",
+)
+
+func normalizeHeader(h string) string {
+	h = strings.ToLower(strings.TrimSpace(h))
+	h = accentReplacer.Replace(h)
+	return strings.Join(strings.Fields(h), " ")
+}
+
+// isEmptyRow indica si la fila no tiene ningún valor (filas en blanco al final de una planilla)
+func isEmptyRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
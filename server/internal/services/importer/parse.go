@@ -0,0 +1,114 @@
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseAmount acepta "1234.56", "1234,56", "1.234,56", "1,234.56" y "$ 1.234"
+func parseAmount(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "$")
+	s = strings.ReplaceAll(s, " ", "")
+	if s == "" {
+		return 0, nil
+	}
+
+	lastDot := strings.LastIndex(s, ".")
+	lastComma := strings.LastIndex(s, ",")
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		// El último separador es el decimal, el otro es de miles
+		if lastComma > lastDot {
+			s = strings.ReplaceAll(s, ".", "")
+			s = strings.Replace(s, ",", ".", 1)
+		} else {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	case lastComma >= 0:
+		// "1234,56" es decimal; "1,234,567" son miles
+		if strings.Count(s, ",") == 1 && (len(s)-lastComma-1 != 3 || s[:lastComma] == "0") {
+			s = strings.Replace(s, ",", ".", 1)
+		} else {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	case lastDot >= 0:
+		// "1.234.567" o "1.234" (formato argentino) son miles
+		if strings.Count(s, ".") > 1 || (len(s)-lastDot-1 == 3 && s[:lastDot] != "0") {
+			s = strings.ReplaceAll(s, ".", "")
+		}
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount")
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("amount cannot be negative")
+	}
+	return value, nil
+}
+
+// parseQuantity acepta enteros no negativos ("10", "10.0" de Excel)
+func parseQuantity(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil || value != float64(int(value)) {
+		return 0, fmt.Errorf("must be a whole number")
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("cannot be negative")
+	}
+	return int(value), nil
+}
+
+// normalizeDNI quita puntos, guiones y espacios ("30.123.456" -> "30123456")
+func normalizeDNI(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', ' ':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+}
+
+// dateLayouts son los formatos de fecha aceptados; día antes que mes como se usa en Argentina
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"02/01/2006",
+	"2/1/2006",
+	"02-01-2006",
+	"2-1-2006",
+	"02/01/06",
+	"2/1/06",
+}
+
+// excelEpoch es el día 0 de los números de serie de fecha de Excel
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.Local)
+
+// parseDate acepta los formatos de dateLayouts y números de serie de Excel ("45123")
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if serial, err := strconv.ParseFloat(s, 64); err == nil {
+		if serial < 1 || serial > 100000 {
+			return time.Time{}, fmt.Errorf("invalid date")
+		}
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date, use dd/mm/yyyy or yyyy-mm-dd")
+}
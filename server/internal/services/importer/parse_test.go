@@ -0,0 +1,80 @@
+package importer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{name: "vacío", value: "", want: 0},
+		{name: "entero", value: "1234", want: 1234},
+		{name: "miles con punto", value: "1.234", want: 1234},
+		{name: "miles con coma", value: "1,234", want: 1234},
+		{name: "varios miles con punto", value: "1.234.567", want: 1234567},
+		{name: "varios miles con coma", value: "1,234,567", want: 1234567},
+		{name: "formato argentino", value: "1.234,56", want: 1234.56},
+		{name: "formato inglés", value: "1,234.56", want: 1234.56},
+		{name: "millones con decimales", value: "1.234.567,89", want: 1234567.89},
+		{name: "decimal con coma", value: "1234,5", want: 1234.5},
+		{name: "decimal con punto", value: "1234.5", want: 1234.5},
+		{name: "dos decimales con coma", value: "12,34", want: 12.34},
+		{name: "tres decimales después del cero", value: "0,250", want: 0.25},
+		{name: "signo pesos y espacios", value: "$ 1.234", want: 1234},
+		{name: "negativo", value: "-5", wantErr: true},
+		{name: "texto", value: "mil", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAmount(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.Local) }
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "ISO", value: "2026-03-10", want: date(2026, 3, 10)},
+		{name: "día antes que mes", value: "10/03/2026", want: date(2026, 3, 10)},
+		{name: "sin ceros", value: "1/3/2026", want: date(2026, 3, 1)},
+		{name: "con guiones", value: "10-03-2026", want: date(2026, 3, 10)},
+		{name: "año corto", value: "10/03/26", want: date(2026, 3, 10)},
+		{name: "número de serie de Excel", value: "45000", want: date(2023, 3, 15)},
+		{name: "serie de Excel con hora", value: "45000.75", want: date(2023, 3, 15)},
+		{name: "serie fuera de rango", value: "0", wantErr: true},
+		{name: "mes inválido", value: "2026-13-01", wantErr: true},
+		{name: "día inexistente", value: "31/02/2026", wantErr: true},
+		{name: "mes antes que día", value: "03/25/2026", wantErr: true},
+		{name: "vacío", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"context"
+	"io"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+var productFields = []field{
+	{Name: "name", Aliases: []string{"nombre", "producto", "descripcion"}, Required: true},
+	{Name: "cost", Aliases: []string{"costo", "precio costo"}},
+	{Name: "price", Aliases: []string{"precio", "precio venta"}, Required: true},
+	{Name: "stock", Aliases: []string{"cantidad", "existencia"}},
+}
+
+func (s *Service) ImportProducts(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error) {
+	header, rows, err := readRows(file, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := resolveColumns(header, productFields, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newResult(domain.ImportEntityProducts, opts.DryRun, cols.describe(header))
+	products := make([]*domain.Product, 0, len(rows))
+
+	for i, row := range rows {
+		rowNumber := i + 2
+		if isEmptyRow(row) {
+			continue
+		}
+		result.TotalRows++
+
+		var rowErrors []domain.ImportRowError
+		addError := func(field, msg string) {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: rowNumber, Field: field, Message: msg})
+		}
+
+		product := &domain.Product{
+			Name: cols.get(row, "name"),
+		}
+		if product.Name == "" {
+			addError("name", "name is required")
+		}
+
+		if raw := cols.get(row, "price"); raw == "" {
+			addError("price", "price is required")
+		} else if price, err := parseAmount(raw); err != nil {
+			addError("price", err.Error()+": "+raw)
+		} else {
+			product.Price = price
+		}
+
+		if cost, err := parseAmount(cols.get(row, "cost")); err != nil {
+			addError("cost", err.Error()+": "+cols.get(row, "cost"))
+		} else {
+			product.Cost = cost
+		}
+
+		if stock, err := parseQuantity(cols.get(row, "stock")); err != nil {
+			addError("stock", "stock "+err.Error())
+		} else {
+			product.Stock = stock
+		}
+
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		products = append(products, product)
+	}
+
+	result.ValidRows = len(products)
+	result.Preview = products[:min(len(products), previewSize)]
+
+	if opts.DryRun || len(result.Errors) > 0 || len(products) == 0 {
+		return result, nil
+	}
+
+	if err := s.Repo.InsertProducts(ctx, products); err != nil {
+		return nil, domain.ManageError(err)
+	}
+	result.Imported = len(products)
+
+	return result, nil
+}
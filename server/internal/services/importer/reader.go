@@ -0,0 +1,100 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// FormatFromFilename deduce el formato por la extensión del archivo
+func FormatFromFilename(filename string) string {
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".xlsx"):
+		return FormatXLSX
+	case strings.HasSuffix(lower, ".csv"), strings.HasSuffix(lower, ".txt"):
+		return FormatCSV
+	}
+	return ""
+}
+
+// readTable devuelve todas las filas del archivo; la primera es el encabezado
+func readTable(file io.Reader, format string) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(file)
+	case FormatXLSX:
+		return readXLSX(file)
+	default:
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("unsupported format %q, use csv or xlsx", format))
+	}
+}
+
+func readCSV(file io.Reader) ([][]string, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")) // BOM de Excel
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = detectDelimiter(content)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("invalid csv: %v", err))
+	}
+	return rows, nil
+}
+
+// detectDelimiter elige ";" cuando el encabezado lo usa: Excel en español exporta así
+func detectDelimiter(content []byte) rune {
+	header := content
+	if i := bytes.IndexByte(content, '\n'); i >= 0 {
+		header = content[:i]
+	}
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+func readXLSX(file io.Reader) ([][]string, error) {
+	workbook, err := excelize.OpenReader(file)
+	if err != nil {
+		return nil, domain.NewAppError(
+			domain.ErrCodeInvalidParams,
+			fmt.Sprintf("invalid xlsx: %v", err))
+	}
+	defer workbook.Close()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "xlsx has no sheets")
+	}
+
+	// Valores crudos: las fechas llegan como número de serie de Excel y no en el formato
+	// regional de la planilla (que podría ser mm-dd-yy)
+	rows, err := workbook.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("error reading xlsx: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,283 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+// saleFields describe el formato de ventas históricas: una fila por cuota, agrupadas por ref.
+// Los datos de la venta se toman de su primera fila. Repetir una cuota agrega otro pago a esa cuota.
+var saleFields = []field{
+	{Name: "ref", Aliases: []string{"venta", "nro venta", "id venta", "referencia", "sale"}, Required: true},
+	{Name: "dni", Aliases: []string{"documento", "nro documento", "cliente dni"}, Required: true},
+	{Name: "date", Aliases: []string{"fecha", "fecha venta", "sale date"}, Required: true},
+	{Name: "product", Aliases: []string{"producto", "productos", "articulo", "descripcion", "description"}, Required: true},
+	{Name: "quantity", Aliases: []string{"cantidad"}},
+	{Name: "sale_amount", Aliases: []string{"total", "monto venta", "importe venta", "total venta"}},
+	{Name: "quota_number", Aliases: []string{"cuota", "nro cuota", "numero cuota"}, Required: true},
+	{Name: "quota_amount", Aliases: []string{"monto cuota", "importe cuota", "valor cuota"}, Required: true},
+	{Name: "due_date", Aliases: []string{"vencimiento", "fecha vencimiento"}, Required: true},
+	{Name: "paid_amount", Aliases: []string{"pagado", "monto pagado", "importe pagado", "pago"}},
+	{Name: "payment_date", Aliases: []string{"fecha pago", "fecha de pago"}},
+}
+
+// saleGroup junta las filas de una misma venta mientras se lee el archivo
+type saleGroup struct {
+	firstRow int
+	sale     *domain.ImportedSale
+	quotas   map[uint]*domain.Quota
+	product  string
+	quantity int
+}
+
+func (s *Service) ImportSales(ctx context.Context, file io.Reader, opts *dto.ImportOptions) (*domain.ImportResult, error) {
+	header, rows, err := readRows(file, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := resolveColumns(header, saleFields, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	clientIDs, err := s.Repo.GetClientIDsByDNI(ctx)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+	byDNI := make(map[string]int64, len(clientIDs))
+	for dni, id := range clientIDs {
+		byDNI[normalizeDNI(dni)] = id
+	}
+
+	refs, err := s.Repo.GetSaleLegacyRefs(ctx)
+	if err != nil {
+		return nil, domain.ManageError(err)
+	}
+	imported := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		imported[ref] = true
+	}
+
+	result := newResult(domain.ImportEntitySales, opts.DryRun, cols.describe(header))
+	groups := make(map[string]*saleGroup)
+	var order []string
+	today := time.Now()
+
+	for i, row := range rows {
+		rowNumber := i + 2
+		if isEmptyRow(row) {
+			continue
+		}
+		result.TotalRows++
+
+		var rowErrors []domain.ImportRowError
+		addError := func(field, msg string) {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: rowNumber, Field: field, Message: msg})
+		}
+
+		ref := cols.get(row, "ref")
+		dni := normalizeDNI(cols.get(row, "dni"))
+		if ref == "" {
+			result.Errors = append(result.Errors, domain.ImportRowError{Row: rowNumber, Field: "ref", Message: "ref is required"})
+			continue
+		}
+		if len(ref) > 64 {
+			addError("ref", "ref is too long")
+		}
+
+		group, exists := groups[ref]
+		if !exists {
+			group = &saleGroup{
+				firstRow: rowNumber,
+				sale:     &domain.ImportedSale{Ref: ref, ClientDNI: dni},
+				quotas:   make(map[uint]*domain.Quota),
+				product:  cols.get(row, "product"),
+				quantity: 1,
+			}
+			groups[ref] = group
+			order = append(order, ref)
+
+			switch clientID, found := byDNI[dni]; {
+			case dni == "":
+				addError("dni", "dni is required")
+			case !found:
+				addError("dni", fmt.Sprintf("no client with dni %s, import the clients first", dni))
+			default:
+				group.sale.ClientID = clientID
+			}
+			if imported[ref] {
+				addError("ref", fmt.Sprintf("sale %s was already imported", ref))
+			}
+
+			if date, err := parseDate(cols.get(row, "date")); err != nil {
+				addError("date", err.Error())
+			} else {
+				group.sale.Date = &date
+			}
+			if group.product == "" {
+				addError("product", "product is required")
+			}
+			if raw := cols.get(row, "quantity"); raw != "" {
+				quantity, err := parseQuantity(raw)
+				if err != nil || quantity == 0 {
+					addError("quantity", "quantity must be a whole number greater than zero")
+				} else {
+					group.quantity = quantity
+				}
+			}
+			if amount, err := parseAmount(cols.get(row, "sale_amount")); err != nil {
+				addError("sale_amount", err.Error())
+			} else {
+				group.sale.Amount = amount
+			}
+		} else if dni != "" && dni != group.sale.ClientDNI {
+			addError("dni", fmt.Sprintf("dni does not match row %d of the same sale", group.firstRow))
+		}
+
+		number, err := parseQuantity(cols.get(row, "quota_number"))
+		if err != nil || number == 0 {
+			addError("quota_number", "quota number must be a whole number greater than zero")
+		}
+
+		quota, exists := group.quotas[uint(number)]
+		if !exists && number > 0 {
+			quota = &domain.Quota{Number: uint(number)}
+			group.quotas[uint(number)] = quota
+
+			amount, err := parseAmount(cols.get(row, "quota_amount"))
+			switch {
+			case err != nil:
+				addError("quota_amount", err.Error())
+			case amount == 0:
+				addError("quota_amount", "quota amount is required")
+			default:
+				quota.Amount = amount
+			}
+
+			if dueDate, err := parseDate(cols.get(row, "due_date")); err != nil {
+				addError("due_date", err.Error())
+			} else {
+				quota.DueDate = &dueDate
+			}
+		}
+
+		paid, err := parseAmount(cols.get(row, "paid_amount"))
+		if err != nil {
+			addError("paid_amount", err.Error())
+		}
+		if paid > 0 {
+			paymentDate, err := parseDate(cols.get(row, "payment_date"))
+			switch {
+			case cols.get(row, "payment_date") == "":
+				addError("payment_date", "payment date is required when there is a paid amount")
+			case err != nil:
+				addError("payment_date", err.Error())
+			case paymentDate.After(today):
+				addError("payment_date", "payment date is in the future")
+			case quota != nil:
+				quota.Payments = append(quota.Payments, &domain.Payment{Amount: paid, Date: &paymentDate})
+			}
+		}
+
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		result.ValidRows++
+	}
+
+	sales := make([]*domain.ImportedSale, 0, len(order))
+	for _, ref := range order {
+		group := groups[ref]
+		if err := group.build(); err != nil {
+			result.Errors = append(result.Errors, domain.ImportRowError{Row: group.firstRow, Field: "quota_number", Message: err.Error()})
+			continue
+		}
+		sales = append(sales, group.sale)
+	}
+
+	result.Preview = sales[:min(len(sales), previewSize)]
+
+	if opts.DryRun || len(result.Errors) > 0 || len(sales) == 0 {
+		return result, nil
+	}
+
+	saleIDs, err := s.Repo.InsertSales(ctx, sales)
+	if err != nil {
+		if errors.Is(err, domain.ErrDuplicateKey) {
+			return nil, domain.NewAppError(domain.ErrCodeDuplicateKey, err.Error())
+		}
+		return nil, domain.ManageError(err)
+	}
+	result.Imported = len(saleIDs)
+
+	// Recalcular estados de ventas y clientes como si los pagos se hubieran cargado uno a uno.
+	// Si falla, los datos ya están importados y el worker corrige los estados en su próxima corrida.
+	for _, saleID := range saleIDs {
+		if err := s.StateUpdater.UpdateSaleStateAndPropagate(strconv.FormatInt(saleID, 10)); err != nil {
+			logger.FromContext(ctx).Error("error recalculating imported sale state", "sale_id", saleID, "error", err)
+		}
+	}
+
+	return result, nil
+}
+
+// build arma la venta a partir de las filas leídas: ordena las cuotas,
+// calcula si cada una está pagada y su estado según el vencimiento
+func (g *saleGroup) build() error {
+	numbers := make([]int, 0, len(g.quotas))
+	for number := range g.quotas {
+		numbers = append(numbers, int(number))
+	}
+	sort.Ints(numbers)
+
+	for i, number := range numbers {
+		if number != i+1 {
+			return fmt.Errorf("sale %s: quotas must be numbered 1 to %d without gaps", g.sale.Ref, len(numbers))
+		}
+	}
+
+	total := 0.0
+	allPaid := true
+	g.sale.Quotas = make([]*domain.Quota, 0, len(numbers))
+	for _, number := range numbers {
+		quota := g.quotas[uint(number)]
+
+		paid := 0.0
+		for _, p := range quota.Payments {
+			paid += p.Amount
+		}
+		// Mismo criterio que el StateUpdater al registrar un pago
+		quota.IsPaid = paid >= quota.Amount
+		quota.StateID = utils.DetermineQuotaState(quota.DueDate)
+		quota.ClientID = g.sale.ClientID
+
+		allPaid = allPaid && quota.IsPaid
+		total += quota.Amount
+		g.sale.Quotas = append(g.sale.Quotas, quota)
+	}
+
+	if g.sale.Amount == 0 {
+		g.sale.Amount = total
+	}
+	g.sale.IsPaid = allPaid
+	g.sale.Description = fmt.Sprintf("%s (%d)", g.product, g.quantity)
+	g.sale.Products = []*domain.SaleProduct{{
+		Name:     g.product,
+		Price:    g.sale.Amount / float64(g.quantity),
+		Quantity: int64(g.quantity),
+	}}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package importer
+
+import (
+	"github.com/benitez96/gostore/internal/ports"
+	stateUpdater "github.com/benitez96/gostore/internal/services/state-updater"
+)
+
+// Make sure Service implements the ImportService interface
+// at compile time.
+var _ ports.ImportService = &Service{}
+
+const (
+	// maxRows limita el tamaño de una importación para no bloquear la base demasiado tiempo
+	maxRows = 10000
+	// previewSize es la cantidad de filas válidas que se devuelven como vista previa
+	previewSize = 20
+)
+
+// Service importa clientes, productos y ventas históricas desde CSV o XLSX.
+// Valida todas las filas antes de escribir y solo importa si no hubo errores.
+type Service struct {
+	Repo         ports.ImportRepository
+	StateUpdater *stateUpdater.Service // Recalcula los estados de las ventas importadas
+}
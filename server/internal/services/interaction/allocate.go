@@ -0,0 +1,49 @@
+package interaction
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+// allocatePayments reparte los pagos entre las promesas en orden de fecha prometida: cada pago se
+// aplica a una sola promesa y lo que sobra pasa a la siguiente. Una promesa toma los pagos de su
+// cliente cargados desde que se hizo la promesa y fechados hasta el fin del día prometido.
+// Las promesas ya cumplidas o rotas también consumen sus pagos, así no se cuentan dos veces.
+// Devuelve lo aplicado a cada promesa.
+func allocatePayments(promises []*domain.PromiseToResolve, payments []*domain.PromisePayment) map[int64]float64 {
+	remaining := make([]float64, len(payments))
+	for i, payment := range payments {
+		remaining[i] = payment.Amount
+	}
+
+	ordered := slices.Clone(promises)
+	slices.SortStableFunc(ordered, func(a, b *domain.PromiseToResolve) int {
+		return cmp.Or(a.PromisedDate.Compare(b.PromisedDate), cmp.Compare(a.ID, b.ID))
+	})
+
+	applied := make(map[int64]float64, len(promises))
+	for _, promise := range ordered {
+		deadline := utils.StartOfDay(promise.PromisedDate).AddDate(0, 0, 1)
+		missing := promise.Amount
+
+		for i, payment := range payments {
+			if missing <= 0 {
+				break
+			}
+			if payment.ClientID != promise.ClientID || remaining[i] <= 0 ||
+				payment.CreatedAt.Before(promise.CreatedAt) || !payment.Date.Before(deadline) {
+				continue
+			}
+
+			amount := min(missing, remaining[i])
+			remaining[i] -= amount
+			missing -= amount
+			applied[promise.ID] += amount
+		}
+	}
+
+	return applied
+}
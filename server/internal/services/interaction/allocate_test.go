@@ -0,0 +1,77 @@
+package interaction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func TestAllocatePayments(t *testing.T) {
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.Local) }
+	promise := func(id int64, promised time.Time, amount float64) *domain.PromiseToResolve {
+		return &domain.PromiseToResolve{ID: id, ClientID: 1, Amount: amount, PromisedDate: promised, CreatedAt: created}
+	}
+	payment := func(id int64, date time.Time, amount float64) *domain.PromisePayment {
+		return &domain.PromisePayment{ID: id, ClientID: 1, Amount: amount, Date: date, CreatedAt: date}
+	}
+
+	tests := []struct {
+		name     string
+		promises []*domain.PromiseToResolve
+		payments []*domain.PromisePayment
+		want     map[int64]float64
+	}{
+		{
+			name:     "un pago cubre una sola promesa",
+			promises: []*domain.PromiseToResolve{promise(1, day(10), 1000), promise(2, day(15), 1000)},
+			payments: []*domain.PromisePayment{payment(1, day(5), 1000)},
+			want:     map[int64]float64{1: 1000},
+		},
+		{
+			name:     "se reparte por fecha prometida, no por orden de carga",
+			promises: []*domain.PromiseToResolve{promise(1, day(20), 500), promise(2, day(10), 500)},
+			payments: []*domain.PromisePayment{payment(1, day(5), 700)},
+			want:     map[int64]float64{2: 500, 1: 200},
+		},
+		{
+			name:     "el sobrante de un pago pasa a la siguiente promesa",
+			promises: []*domain.PromiseToResolve{promise(1, day(10), 300), promise(2, day(15), 300)},
+			payments: []*domain.PromisePayment{payment(1, day(5), 400), payment(2, day(12), 200)},
+			want:     map[int64]float64{1: 300, 2: 300},
+		},
+		{
+			name:     "un pago posterior al día prometido no cuenta",
+			promises: []*domain.PromiseToResolve{promise(1, day(10), 300)},
+			payments: []*domain.PromisePayment{payment(1, day(11), 300)},
+			want:     map[int64]float64{},
+		},
+		{
+			name:     "un pago anterior a la promesa no cuenta",
+			promises: []*domain.PromiseToResolve{promise(1, day(10), 300)},
+			payments: []*domain.PromisePayment{{ID: 1, ClientID: 1, Amount: 300, Date: day(5), CreatedAt: created.Add(-time.Hour)}},
+			want:     map[int64]float64{},
+		},
+		{
+			name:     "los pagos de otro cliente no cuentan",
+			promises: []*domain.PromiseToResolve{promise(1, day(10), 300)},
+			payments: []*domain.PromisePayment{{ID: 1, ClientID: 2, Amount: 300, Date: day(5), CreatedAt: day(5)}},
+			want:     map[int64]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allocatePayments(tt.promises, tt.payments)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for id, amount := range tt.want {
+				if got[id] != amount {
+					t.Errorf("promise %d: got %v, want %v", id, got[id], amount)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package interaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/auth"
+	"github.com/benitez96/gostore/internal/shared/constants"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+// MaxNotesLength es el largo máximo del texto libre de gestiones y promesas
+const MaxNotesLength = 2000
+
+// amountTolerance evita que los redondeos de centavos dejen una promesa sin cumplir
+const amountTolerance = 0.005
+
+var interactionTypes = map[string]bool{
+	domain.InteractionCall:     true,
+	domain.InteractionVisit:    true,
+	domain.InteractionWhatsApp: true,
+}
+
+var outcomes = map[string]bool{
+	domain.OutcomeContacted:    true,
+	domain.OutcomeNoAnswer:     true,
+	domain.OutcomePromise:      true,
+	domain.OutcomeRefused:      true,
+	domain.OutcomeWrongContact: true,
+	domain.OutcomeOther:        true,
+}
+
+// Make sure Service implements ports.InteractionService
+// at compile time
+var _ ports.InteractionService = &Service{}
+
+type Service struct {
+	Repo       ports.InteractionRepository
+	ClientRepo ports.ClientRepository
+}
+
+// Create registra una gestión de cobranza a nombre de quien la carga, con su promesa de pago si la hubo
+func (s *Service) Create(ctx context.Context, interaction *domain.ClientInteraction) error {
+	if err := s.checkAccess(ctx, interaction.ClientID); err != nil {
+		return err
+	}
+
+	interaction.Notes = strings.TrimSpace(interaction.Notes)
+	switch {
+	case !interactionTypes[interaction.Type]:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "type must be one of call, visit or whatsapp")
+	case !outcomes[interaction.Outcome]:
+		return domain.NewAppError(domain.ErrCodeInvalidParams,
+			"outcome must be one of contacted, no_answer, promise_to_pay, refused, wrong_contact or other")
+	case utf8.RuneCountInString(interaction.Notes) > MaxNotesLength:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("notes must be at most %d characters", MaxNotesLength))
+	case interaction.Outcome == domain.OutcomePromise && interaction.Promise == nil:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "a promise_to_pay outcome requires the promise")
+	case interaction.Outcome != domain.OutcomePromise && interaction.Promise != nil:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "only a promise_to_pay outcome can carry a promise")
+	}
+
+	interaction.UserID, interaction.Author = auth.Author(ctx)
+	if interaction.Promise != nil {
+		if err := validatePromise(interaction.Promise); err != nil {
+			return err
+		}
+		interaction.Promise.UserID, interaction.Promise.Author = interaction.UserID, interaction.Author
+	}
+
+	return manageError(s.Repo.Create(ctx, interaction))
+}
+
+func (s *Service) GetByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.ClientInteraction, error) {
+	if err := s.checkAccess(ctx, clientID); err != nil {
+		return nil, err
+	}
+	return s.Repo.GetByClientID(ctx, clientID, limit, offset)
+}
+
+// CreatePromise registra una promesa de pago sin una gestión asociada
+func (s *Service) CreatePromise(ctx context.Context, promise *domain.PaymentPromise) error {
+	if err := s.checkAccess(ctx, promise.ClientID); err != nil {
+		return err
+	}
+	if err := validatePromise(promise); err != nil {
+		return err
+	}
+
+	promise.UserID, promise.Author = auth.Author(ctx)
+	return manageError(s.Repo.CreatePromise(ctx, promise))
+}
+
+func (s *Service) GetPromisesByClientID(ctx context.Context, clientID int64, limit, offset int) ([]*domain.PaymentPromise, error) {
+	if err := s.checkAccess(ctx, clientID); err != nil {
+		return nil, err
+	}
+	return s.Repo.GetPromisesByClientID(ctx, clientID, limit, offset)
+}
+
+// ResolvePromises reparte los pagos entre las promesas del cliente y marca como cumplidas las
+// pendientes que quedaron cubiertas y como rotas las que terminaron el día prometido sin cubrirse;
+// a las demás les actualiza lo pagado a cuenta. Una cumplida que perdió pagos (se borró un pago)
+// vuelve a pendiente, o queda rota si ya venció. Las rotas no se reabren.
+func (s *Service) ResolvePromises(ctx context.Context, clientID int64) (int, error) {
+	promises, err := s.Repo.GetPromisesToResolve(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+	payments, err := s.Repo.GetPaymentsForPromises(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+	applied := allocatePayments(promises, payments)
+
+	now := time.Now()
+	resolved := 0
+	for _, promise := range promises {
+		if promise.Status == domain.PromiseBroken {
+			continue
+		}
+
+		paid := applied[promise.ID]
+		var status string
+		switch {
+		case paid >= promise.Amount-amountTolerance:
+			if promise.Status == domain.PromiseKept {
+				continue
+			}
+			status = domain.PromiseKept
+		case !now.Before(utils.StartOfDay(promise.PromisedDate).AddDate(0, 0, 1)):
+			status = domain.PromiseBroken
+		case promise.Status == domain.PromiseKept || paid != promise.PaidAmount:
+			status = domain.PromisePending
+		default:
+			continue
+		}
+
+		var resolvedAt *time.Time
+		if status != domain.PromisePending {
+			resolvedAt = &now
+			resolved++
+		}
+		if err := s.Repo.UpdatePromiseStatus(ctx, promise.ID, status, paid, resolvedAt); err != nil {
+			return resolved, err
+		}
+	}
+
+	return resolved, nil
+}
+
+// PromisesDue lista las promesas todavía pendientes cuyo día prometido es date
+func (s *Service) PromisesDue(ctx context.Context, date time.Time) ([]*domain.PaymentPromise, error) {
+	from := utils.StartOfDay(date)
+	return s.Repo.GetPromisesDue(ctx, from, from.AddDate(0, 0, 1))
+}
+
+// checkAccess controla que exista el cliente; quien no tiene permiso de clientes ni de ventas
+// solo puede gestionar los clientes que tiene asignados como cobrador
+func (s *Service) checkAccess(ctx context.Context, clientID int64) error {
+	client, err := s.ClientRepo.Get(strconv.FormatInt(clientID, 10))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound, "client not found")
+		}
+		return err
+	}
+
+	actor, ok := auth.ActorFromContext(ctx)
+	if !ok || constants.HasAnyPermission(actor.Permissions, constants.PermissionClients, constants.PermissionSales) {
+		return nil
+	}
+	if actor.Type == domain.ActorTypeUser && client.CollectorID != nil && *client.CollectorID == actor.ID {
+		return nil
+	}
+	return domain.NewAppError(domain.ErrCodeForbidden, "collectors can only follow up their assigned clients")
+}
+
+func validatePromise(promise *domain.PaymentPromise) error {
+	promise.Notes = strings.TrimSpace(promise.Notes)
+	promise.PromisedDate = utils.StartOfDay(promise.PromisedDate)
+	switch {
+	case promise.Amount <= 0:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "promise amount must be greater than zero")
+	case promise.PromisedDate.Before(utils.StartOfDay(time.Now())):
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "promised_date cannot be in the past")
+	case utf8.RuneCountInString(promise.Notes) > MaxNotesLength:
+		return domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("notes must be at most %d characters", MaxNotesLength))
+	}
+	return nil
+}
+
+// manageError traduce los errores del repositorio a errores de la API
+func manageError(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.NewAppError(domain.ErrCodeNotFound, "client not found")
+	}
+	return err
+}
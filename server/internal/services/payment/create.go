@@ -1,17 +1,21 @@
 package payment
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/benitez96/gostore/internal/domain"
 )
 
-func (s *Service) Create(payment *domain.Payment) error {
+func (s *Service) Create(ctx context.Context, payment *domain.Payment) error {
 	// Create the payment
 	if err := s.Repo.Create(payment); err != nil {
 		return err
 	}
 
+	s.publish(ctx, domain.EventPaymentCreated, payment)
+	s.resolvePromises(ctx, payment)
+
 	// Get the quota ID from the payment
 	quotaIDStr := fmt.Sprintf("%d", payment.QuotaID)
 
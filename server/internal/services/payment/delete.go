@@ -1,10 +1,13 @@
 package payment
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/benitez96/gostore/internal/domain"
 )
 
-func (s *Service) Delete(paymentID string) error {
+func (s *Service) Delete(ctx context.Context, paymentID string) error {
 	// First, get the payment to know which quota it belongs to
 	payment, err := s.Repo.GetByID(paymentID)
 	if err != nil {
@@ -19,6 +22,9 @@ func (s *Service) Delete(paymentID string) error {
 		return err
 	}
 
+	s.publish(ctx, domain.EventPaymentDeleted, payment)
+	s.resolvePromises(ctx, payment)
+
 	// Actualizar estados y propagar cambios
 	return s.StateUpdater.UpdateQuotaStateAndPropagate(quotaIDStr)
 }
@@ -1,9 +1,14 @@
 package payment
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/ports"
 	stateUpdater "github.com/benitez96/gostore/internal/services/state-updater"
+	"github.com/benitez96/gostore/internal/shared/logger"
 )
 
 // Make sure Service implements ports.PaymentService
@@ -16,9 +21,59 @@ type Service struct {
 	SaleRepo     ports.SaleRepository
 	ClientRepo   ports.ClientRepository
 	StateUpdater *stateUpdater.Service
+	Events       ports.EventPublisher // Opcional
+	// Opcional: marca como cumplidas las promesas de pago que cubre cada pago nuevo y
+	// reabre las que dejan de estar cubiertas al borrar uno
+	Promises ports.InteractionService
 }
 
 // GetByID obtiene un payment por su ID
 func (s *Service) GetByID(paymentID string) (*domain.Payment, error) {
 	return s.Repo.GetByID(paymentID)
 }
+
+// publish avisa del alta o baja de un pago, con la venta y el cliente de su cuota
+func (s *Service) publish(ctx context.Context, eventType string, payment *domain.Payment) {
+	if s.Events == nil {
+		return
+	}
+
+	data := domain.PaymentEventData{
+		ID:      payment.ID,
+		Amount:  payment.Amount,
+		Date:    payment.Date,
+		QuotaID: payment.QuotaID,
+	}
+
+	quota, err := s.QuotaRepo.GetByID(strconv.FormatInt(payment.QuotaID, 10))
+	if err != nil {
+		logger.FromContext(ctx).Warn("payment event without quota data", "payment_id", payment.ID, "error", err)
+	} else {
+		data.SaleID, _ = strconv.ParseInt(fmt.Sprint(quota.SaleID), 10, 64)
+		data.ClientID, _ = strconv.ParseInt(fmt.Sprint(quota.ClientID), 10, 64)
+	}
+
+	// El pago ya quedó guardado: los suscriptores corren aunque el cliente corte el request
+	s.Events.Publish(context.WithoutCancel(ctx), domain.NewEvent(eventType, data))
+}
+
+// resolvePromises vuelve a repartir los pagos entre las promesas del cliente de la cuota del pago,
+// tanto al cargarlo como al borrarlo. Un error no anula la operación: el worker de estados vuelve a
+// revisar las pendientes.
+func (s *Service) resolvePromises(ctx context.Context, payment *domain.Payment) {
+	if s.Promises == nil {
+		return
+	}
+
+	log := logger.FromContext(ctx)
+	quota, err := s.QuotaRepo.GetByID(strconv.FormatInt(payment.QuotaID, 10))
+	if err != nil {
+		log.Warn("payment promises not resolved", "payment_id", payment.ID, "error", err)
+		return
+	}
+	clientID, _ := strconv.ParseInt(fmt.Sprint(quota.ClientID), 10, 64)
+
+	if _, err := s.Promises.ResolvePromises(ctx, clientID); err != nil {
+		log.Warn("payment promises not resolved", "payment_id", payment.ID, "client_id", clientID, "error", err)
+	}
+}
@@ -0,0 +1,208 @@
+package pdf
+
+import (
+	"fmt"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/utils"
+	"github.com/go-pdf/fpdf"
+)
+
+// Cuatro tarjetas por hoja A4 (2x2) para recortar
+const (
+	collectionCardsPerPage = 4
+	collectionCardRows     = 20 // Cuotas que entran en una tarjeta
+	collectionCardW        = nativeContentW / 2
+	collectionCardH        = (nativePageHeight - 2*nativeMargin) / 2
+)
+
+// GenerateCollectionCardsPDF genera las tarjetas de cobro de las ventas indicadas, en ese orden
+func (rg *ReportGenerator) GenerateCollectionCardsPDF(saleIDs []int, saleService ports.SaleService, clientService ports.ClientService) ([]byte, error) {
+	if len(saleIDs) == 0 {
+		return nil, domain.NewAppError(domain.ErrCodeNotFound, "no hay ventas pendientes para generar tarjetas")
+	}
+
+	var cards []CollectionCard
+	for _, saleID := range saleIDs {
+		sale, client, err := getSaleAndClient(saleID, saleService, clientService)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, splitCollectionCard(collectionCard(int64(saleID), sale, client))...)
+	}
+
+	data := CollectionCardsData{
+		BaseData: rg.baseData("collection_cards"),
+	}
+	for start := 0; start < len(cards); start += collectionCardsPerPage {
+		end := min(start+collectionCardsPerPage, len(cards))
+		data.Pages = append(data.Pages, cards[start:end])
+	}
+
+	pdfContent, err := rg.renderer.RenderCollectionCards(data)
+	if err != nil {
+		return nil, fmt.Errorf("error convirtiendo a PDF: %w", err)
+	}
+
+	return pdfContent, nil
+}
+
+// collectionCard arma la tarjeta de cobro con todas las cuotas de la venta
+func collectionCard(saleID int64, sale *domain.Sale, client *domain.Client) CollectionCard {
+	// Usar la última cuota como precio base, igual que la ficha de venta
+	quotaPrice := 0.0
+	if len(sale.Quotas) > 0 {
+		quotaPrice = sale.Quotas[len(sale.Quotas)-1].Amount
+	}
+
+	return CollectionCard{
+		SaleID:              saleID,
+		ClientName:          client.Name,
+		ClientLastname:      client.Lastname,
+		ClientDni:           dashIfEmpty(client.Dni),
+		ClientPhone:         dashIfEmpty(client.Phone),
+		ClientAddress:       dashIfEmpty(client.Address),
+		SaleDate:            formatDate(sale.Date),
+		ProductDesc:         sale.Description,
+		NumQuotas:           len(sale.Quotas),
+		QuotaPriceFormatted: utils.FormatMoney(quotaPrice),
+		Quotas:              quotaRows(sale.Quotas),
+	}
+}
+
+// splitCollectionCard reparte las cuotas en tantas tarjetas como haga falta
+func splitCollectionCard(card CollectionCard) []CollectionCard {
+	parts := max(1, (len(card.Quotas)+collectionCardRows-1)/collectionCardRows)
+
+	cards := make([]CollectionCard, 0, parts)
+	for part := 1; part <= parts; part++ {
+		c := card
+		start := (part - 1) * collectionCardRows
+		end := min(start+collectionCardRows, len(card.Quotas))
+		c.Quotas = card.Quotas[start:end]
+		c.Part, c.Parts = part, parts
+		cards = append(cards, c)
+	}
+	return cards
+}
+
+// RenderCollectionCards dibuja las tarjetas de cobro, cuatro por hoja con líneas de corte
+func (r *NativeRenderer) RenderCollectionCards(data CollectionCardsData) ([]byte, error) {
+	doc := newNativeDocument("Tarjetas de Cobro")
+
+	for _, page := range data.Pages {
+		doc.AddPage()
+
+		// Líneas de corte
+		setDrawColor(doc, colorMuted)
+		doc.SetLineWidth(0.2)
+		doc.SetDashPattern([]float64{2, 2}, 0)
+		doc.Line(nativeMargin+collectionCardW, nativeMargin, nativeMargin+collectionCardW, nativePageHeight-nativeMargin)
+		doc.Line(nativeMargin, nativeMargin+collectionCardH, nativePageWidth-nativeMargin, nativeMargin+collectionCardH)
+		doc.SetDashPattern([]float64{}, 0)
+
+		for i, card := range page {
+			x := nativeMargin + float64(i%2)*collectionCardW
+			y := nativeMargin + float64(i/2)*collectionCardH
+			drawCollectionCard(doc, x, y, card, data.Business)
+		}
+	}
+
+	return outputNativeDocument(doc)
+}
+
+// drawCollectionCard dibuja una tarjeta en el espacio que empieza en x, y
+func drawCollectionCard(doc *fpdf.Fpdf, x, y float64, card CollectionCard, business BusinessInfo) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	const padding = 4.0
+	left := x + padding
+	width := collectionCardW - 2*padding
+	top := y + padding
+
+	// Comercio y número de venta
+	doc.SetXY(left, top)
+	doc.SetFont("Helvetica", "B", 8)
+	setTextColor(doc, colorPrimary)
+	doc.CellFormat(width/2, 4, tr(business.Name), "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 8)
+	setTextColor(doc, colorMuted)
+	sale := fmt.Sprintf("Venta N° %d", card.SaleID)
+	if card.Parts > 1 {
+		sale += fmt.Sprintf(" (%d/%d)", card.Part, card.Parts)
+	}
+	doc.CellFormat(width/2, 4, tr(sale), "", 1, "R", false, 0, "")
+
+	// Cliente
+	doc.SetX(left)
+	doc.SetFont("Helvetica", "B", 11)
+	setTextColor(doc, colorText)
+	doc.CellFormat(width, 6, tr(fmt.Sprintf("%s, %s", card.ClientLastname, card.ClientName)), "", 1, "L", false, 0, "")
+
+	drawCardField(doc, left, width, tr("DNI:"), tr(card.ClientDni+"    Tel.: "+card.ClientPhone))
+	drawCardField(doc, left, width, tr("Dirección:"), tr(card.ClientAddress))
+	drawCardField(doc, left, width, tr("Artículo:"), tr(card.ProductDesc))
+	drawCardField(doc, left, width, tr("Fecha:"), tr(fmt.Sprintf("%s    Cuotas: %d de $%s", card.SaleDate, card.NumQuotas, card.QuotaPriceFormatted)))
+
+	// Cuotas con un recuadro para la firma del cobrador
+	columns := []struct {
+		title string
+		width float64
+	}{
+		{"N°", 8},
+		{"Vence", 17},
+		{"Monto", 22},
+		{"Pagada", 17},
+		{"Firma", width - 64},
+	}
+
+	doc.Ln(2)
+	doc.SetX(left)
+	doc.SetFont("Helvetica", "B", 7.5)
+	setFillColor(doc, colorHeader)
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.2)
+	for _, col := range columns {
+		doc.CellFormat(col.width, 5, tr(col.title), "1", 0, "C", true, 0, "")
+	}
+	doc.Ln(-1)
+
+	doc.SetFont("Helvetica", "", 7.5)
+	for _, q := range card.Quotas {
+		doc.SetX(left)
+		paid := ""
+		if q.IsPaid {
+			paid = q.PayDate
+			if paid == "" {
+				paid = "PAGADA"
+			}
+		}
+		values := []string{fmt.Sprintf("%d", q.Number), q.DueDate, "$" + q.AmountFormatted, paid, ""}
+		for i, col := range columns {
+			doc.CellFormat(col.width, 4.2, tr(values[i]), "1", 0, "C", false, 0, "")
+		}
+		doc.Ln(-1)
+	}
+
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.4)
+	doc.RoundedRect(x+1.5, y+1.5, collectionCardW-3, collectionCardH-3, 2, "1234", "D")
+}
+
+// drawCardField escribe "label valor" en una línea, recortando el valor para que no se salga de la tarjeta
+func drawCardField(doc *fpdf.Fpdf, left, width float64, label, value string) {
+	doc.SetX(left)
+	doc.SetFont("Helvetica", "B", 8)
+	labelW := doc.GetStringWidth(label) + 1
+	doc.CellFormat(labelW, 4, label, "", 0, "L", false, 0, "")
+
+	doc.SetFont("Helvetica", "", 8)
+	available := width - labelW
+	if doc.GetStringWidth(value) > available {
+		for len(value) > 0 && doc.GetStringWidth(value+"...") > available {
+			value = value[:len(value)-1]
+		}
+		value += "..."
+	}
+	doc.CellFormat(available, 4, value, "", 1, "L", false, 0, "")
+}
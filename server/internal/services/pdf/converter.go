@@ -1,24 +1,30 @@
 package pdf
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"time"
+
+	"github.com/benitez96/gostore/internal/metrics"
 )
 
 // PDFConverter maneja la conversión de HTML a PDF
 type PDFConverter struct {
-	tempDir string
-	config  ReportConfig
+	tempDir     string
+	dockerImage string
+	config      ReportConfig
 }
 
 // NewPDFConverter crea una nueva instancia del conversor de PDF
-func NewPDFConverter(tempDir string, config ReportConfig) *PDFConverter {
+func NewPDFConverter(tempDir, dockerImage string, config ReportConfig) *PDFConverter {
 	return &PDFConverter{
-		tempDir: tempDir,
-		config:  config,
+		tempDir:     tempDir,
+		dockerImage: dockerImage,
+		config:      config,
 	}
 }
 
@@ -39,22 +45,35 @@ func (pc *PDFConverter) ConvertHTMLToPDF(htmlContent string) ([]byte, error) {
 	cmd := pc.buildWKHTMLToPDFCommand(htmlFile, pdfFile)
 
 	// Ejecutar el comando
+	start := time.Now()
 	output, err := cmd.CombinedOutput()
+	metrics.PDFConversionDuration.Observe(time.Since(start).Seconds())
 	if err != nil {
+		metrics.PDFConversionFailures.Inc()
 		return nil, fmt.Errorf("wkhtmltopdf error: %w, output: %s", err, string(output))
 	}
 
-	fmt.Printf("wkhtmltopdf output: %s\n", string(output))
+	slog.Debug("wkhtmltopdf finished", "output", string(output))
 
 	// Leer el PDF generado
 	pdfContent, err := os.ReadFile(pdfFile)
 	if err != nil {
+		metrics.PDFConversionFailures.Inc()
 		return nil, fmt.Errorf("error reading PDF file: %w", err)
 	}
 
 	return pdfContent, nil
 }
 
+// CheckAvailable verifica que docker responda y que la imagen de wkhtmltopdf esté construida
+func (pc *PDFConverter) CheckAvailable(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "docker", "image", "inspect", "--format", "{{.Id}}", pc.dockerImage)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("docker image %s not available: %w, output: %s", pc.dockerImage, err, string(output))
+	}
+	return nil
+}
+
 // buildWKHTMLToPDFCommand construye el comando para ejecutar wkhtmltopdf
 func (pc *PDFConverter) buildWKHTMLToPDFCommand(htmlFile, pdfFile string) *exec.Cmd {
 	args := []string{
@@ -65,7 +84,7 @@ func (pc *PDFConverter) buildWKHTMLToPDFCommand(htmlFile, pdfFile string) *exec.
 		"--read-only",                         // Sistema de archivos solo lectura
 		"--security-opt", "no-new-privileges", // No permitir escalación de privilegios
 		"--cap-drop", "ALL", // Remover todas las capabilities
-		"gostore-wkhtmltopdf", // Nombre de la imagen
+		pc.dockerImage, // Nombre de la imagen
 		"wkhtmltopdf",
 	}
 
@@ -0,0 +1,431 @@
+package pdf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/utils"
+	"github.com/go-pdf/fpdf"
+)
+
+// SaleDocumentKind es un documento que se firma con cada venta financiada
+type SaleDocumentKind string
+
+const (
+	SaleDocumentPagare   SaleDocumentKind = "pagare"
+	SaleDocumentContract SaleDocumentKind = "contrato"
+)
+
+// saleDocument describe cómo se genera cada documento. El HTML sale de
+// templates/documents/<kind>.html (reemplazable desde pdf.templates_dir)
+// y el backend nativo usa draw.
+type saleDocument struct {
+	title string
+	draw  func(doc *fpdf.Fpdf, data SaleDocumentData)
+}
+
+var saleDocuments = map[SaleDocumentKind]saleDocument{
+	SaleDocumentPagare:   {title: "Pagaré", draw: drawPagare},
+	SaleDocumentContract: {title: "Contrato de Venta", draw: drawContract},
+}
+
+// defaultContractTerms son las condiciones que se imprimen si no se cargaron otras en los datos del comercio.
+// Los párrafos se separan con una línea en blanco.
+const defaultContractTerms = `El comprador se obliga a abonar cada cuota en la fecha de vencimiento indicada, en el domicilio del vendedor o al cobrador autorizado.
+
+La falta de pago de dos cuotas consecutivas producirá la caducidad de los plazos y hará exigible la totalidad del saldo adeudado, sin necesidad de interpelación previa.
+
+La mercadería permanece en propiedad del vendedor hasta la cancelación total del precio. El comprador no podrá venderla, prendarla ni trasladarla fuera de su domicilio sin autorización del vendedor.
+
+El garante, si lo hubiera, se constituye en liso, llano y principal pagador de todas las obligaciones del comprador.
+
+Para cualquier cuestión derivada de este contrato las partes se someten a los tribunales ordinarios del domicilio del vendedor.`
+
+// GenerateSaleDocumentPDF genera el pagaré o el contrato de una venta
+func (rg *ReportGenerator) GenerateSaleDocumentPDF(saleID int, kind SaleDocumentKind, saleService ports.SaleService, clientService ports.ClientService) ([]byte, error) {
+	document, ok := saleDocuments[kind]
+	if !ok {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("unknown sale document %q", kind))
+	}
+
+	sale, client, err := getSaleAndClient(saleID, saleService, clientService)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lo que se documenta es lo que se financia: la suma de las cuotas
+	total := 0.0
+	for _, q := range sale.Quotas {
+		total += q.Amount
+	}
+	if len(sale.Quotas) == 0 {
+		total = sale.Amount
+	}
+
+	quotaPrice := 0.0
+	dueDate := "A la vista"
+	if len(sale.Quotas) > 0 {
+		last := sale.Quotas[len(sale.Quotas)-1]
+		quotaPrice = last.Amount
+		if last.DueDate != nil {
+			dueDate = formatDate(last.DueDate)
+		}
+	}
+
+	data := SaleDocumentData{
+		BaseData:            rg.baseData("sale_" + string(kind)),
+		Kind:                kind,
+		Title:               document.title,
+		SaleID:              int64(saleID),
+		SaleDate:            formatDate(sale.Date),
+		ProductDesc:         sale.Description,
+		Total:               total,
+		TotalFormatted:      utils.FormatMoney(total),
+		TotalInWords:        strings.ToUpper(utils.AmountInWords(total)),
+		NumQuotas:           len(sale.Quotas),
+		QuotaPriceFormatted: utils.FormatMoney(quotaPrice),
+		DueDate:             dueDate,
+		Client: PartyInfo{
+			Name:    fmt.Sprintf("%s %s", client.Name, client.Lastname),
+			Dni:     dashIfEmpty(client.Dni),
+			Phone:   dashIfEmpty(client.Phone),
+			Address: dashIfEmpty(client.Address),
+		},
+		Quotas: quotaRows(sale.Quotas),
+	}
+	// El pagaré y el contrato llevan la firma de un solo garante: el primero vinculado
+	if guarantors := guarantorParties(sale.Guarantors); len(guarantors) > 0 {
+		data.Guarantor = &guarantors[0]
+	}
+
+	terms := data.Business.ContractTerms
+	if strings.TrimSpace(terms) == "" {
+		terms = defaultContractTerms
+	}
+	data.Terms = splitParagraphs(terms)
+
+	pdfContent, err := rg.renderer.RenderSaleDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("error convirtiendo a PDF: %w", err)
+	}
+
+	return pdfContent, nil
+}
+
+// Payee es a quién se extiende el pagaré: la razón social si está cargada
+func (d SaleDocumentData) Payee() string {
+	if d.Business.LegalName != "" {
+		return d.Business.LegalName
+	}
+	return d.Business.Name
+}
+
+// PaymentPlace es dónde se paga el pagaré
+func (d SaleDocumentData) PaymentPlace() string {
+	if d.Business.Address != "" {
+		return d.Business.Address
+	}
+	return "el domicilio del acreedor"
+}
+
+// splitParagraphs separa el texto en párrafos por líneas en blanco
+func splitParagraphs(text string) []string {
+	var paragraphs []string
+	for _, p := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return paragraphs
+}
+
+// RenderSaleDocument dibuja el pagaré o el contrato de una venta
+func (r *NativeRenderer) RenderSaleDocument(data SaleDocumentData) ([]byte, error) {
+	document, ok := saleDocuments[data.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown sale document %q", data.Kind)
+	}
+
+	doc := newNativeDocument(data.Title)
+	// Los documentos no llevan recuadro de página: fpdf puede cortar solo
+	doc.SetAutoPageBreak(true, nativeMargin)
+	doc.AddPage()
+	document.draw(doc, data)
+	return outputNativeDocument(doc)
+}
+
+// drawDocumentHeader dibuja el logo, el nombre y los datos del comercio
+func drawDocumentHeader(doc *fpdf.Fpdf, business BusinessInfo) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	x, y := nativeMargin, doc.GetY()
+
+	nameX := x
+	if logo := registerLogo(doc, business); logo != nil {
+		logoW := logo.Width() * 10 / logo.Height()
+		doc.ImageOptions(nativeLogoName, x, y, logoW, 10, false, fpdf.ImageOptions{}, 0, "")
+		nameX += logoW + 3
+	}
+	doc.SetXY(nameX, y)
+	doc.SetFont("Helvetica", "B", 15)
+	setTextColor(doc, colorPrimary)
+	doc.CellFormat(nativeContentW-(nameX-x), 10, tr(business.Name), "", 1, "L", false, 0, "")
+
+	if details := businessDetails(business); details != "" {
+		doc.SetFont("Helvetica", "", 8)
+		setTextColor(doc, colorMuted)
+		doc.CellFormat(nativeContentW, 4, tr(details), "", 1, "L", false, 0, "")
+	}
+
+	setDrawColor(doc, colorBorder)
+	doc.SetLineWidth(0.3)
+	doc.Line(nativeMargin, doc.GetY()+2, nativePageWidth-nativeMargin, doc.GetY()+2)
+	doc.SetY(doc.GetY() + 6)
+	setTextColor(doc, colorText)
+}
+
+// drawSignature dibuja una línea de firma de width mm en x, y con la aclaración debajo
+func drawSignature(doc *fpdf.Fpdf, x, y, width float64, caption string, party *PartyInfo) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.4)
+	doc.Line(x, y, x+width, y)
+
+	doc.SetXY(x, y+1)
+	doc.SetFont("Helvetica", "B", 9)
+	setTextColor(doc, colorText)
+	doc.CellFormat(width, 4.5, tr(caption), "", 2, "C", false, 0, "")
+	if party != nil {
+		doc.SetFont("Helvetica", "", 8)
+		doc.CellFormat(width, 4, tr(party.Name), "", 2, "C", false, 0, "")
+		doc.CellFormat(width, 4, tr("DNI "+party.Dni), "", 2, "C", false, 0, "")
+	}
+}
+
+// drawPartyDetails escribe nombre, DNI, domicilio y teléfono de un firmante desde x
+func drawPartyDetails(doc *fpdf.Fpdf, x float64, title string, party PartyInfo) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	doc.SetX(x)
+	doc.SetFont("Helvetica", "B", 10)
+	doc.CellFormat(nativeContentW, 6, tr(title), "", 1, "L", false, 0, "")
+
+	fields := [][2]string{
+		{"Nombre:", party.Name},
+		{"DNI:", party.Dni},
+		{"Domicilio:", party.Address},
+		{"Teléfono:", party.Phone},
+	}
+	if party.Relationship != "" {
+		fields = append(fields, [2]string{"Vínculo:", party.Relationship})
+	}
+	for _, f := range fields {
+		doc.SetX(x + 4)
+		drawLabelValue(doc, tr(f[0]), tr(f[1]), 10, 5)
+	}
+}
+
+// drawPagare dibuja el pagaré con la firma del deudor y, si hay garante, la del avalista
+func drawPagare(doc *fpdf.Fpdf, data SaleDocumentData) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	drawDocumentHeader(doc, data.Business)
+	top := doc.GetY()
+	const padding = 6.0
+	x := nativeMargin + padding
+	width := nativeContentW - 2*padding
+
+	// Título, número, vencimiento y monto
+	doc.SetXY(x, top+padding)
+	doc.SetFont("Helvetica", "B", 22)
+	setTextColor(doc, colorPrimary)
+	doc.CellFormat(width/2, 10, tr("PAGARÉ"), "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 10)
+	setTextColor(doc, colorText)
+	doc.CellFormat(width/2, 5, tr(fmt.Sprintf("N° %d", data.SaleID)), "", 2, "R", false, 0, "")
+	doc.CellFormat(width/2, 5, tr("Vence el "+data.DueDate), "", 1, "R", false, 0, "")
+
+	doc.SetX(x)
+	doc.SetFont("Helvetica", "B", 16)
+	setTextColor(doc, colorAmount)
+	doc.CellFormat(width, 10, "Por $ "+data.TotalFormatted, "", 1, "R", false, 0, "")
+	setTextColor(doc, colorText)
+
+	doc.SetX(x)
+	doc.SetFont("Helvetica", "", 10)
+	doc.CellFormat(width, 6, tr(fmt.Sprintf("Lugar y fecha de emisión: %s, %s", dashIfEmpty(data.Business.Address), data.SaleDate)), "", 1, "L", false, 0, "")
+	doc.Ln(3)
+
+	// Texto del pagaré
+	doc.SetX(x)
+	doc.SetFont("Helvetica", "", 11)
+	doc.MultiCell(width, 6.5, tr(fmt.Sprintf(
+		"Pagaré sin protesto (art. 50 del Decreto-Ley 5965/63) a %s o a su orden la cantidad de PESOS %s ($ %s) "+
+			"por igual valor recibido en mercadería (%s) a mi entera satisfacción, pagadero en %s.",
+		data.Payee(), data.TotalInWords, data.TotalFormatted, data.ProductDesc, data.PaymentPlace(),
+	)), "", "J", false)
+	doc.Ln(4)
+
+	// Firmantes
+	drawPartyDetails(doc, x, "Firmante", data.Client)
+	if data.Guarantor != nil {
+		doc.Ln(2)
+		drawPartyDetails(doc, x, "Avalista (garante)", *data.Guarantor)
+	}
+
+	y := doc.GetY() + 22
+	if data.Guarantor != nil {
+		drawSignature(doc, x, y, 70, "Firma del deudor", &data.Client)
+		drawSignature(doc, x+width-70, y, 70, "Firma del avalista", data.Guarantor)
+	} else {
+		drawSignature(doc, x+width-70, y, 70, "Firma del deudor", &data.Client)
+	}
+	bottom := y + 15 + padding
+
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.5)
+	doc.RoundedRect(nativeMargin, top, nativeContentW, bottom-top, 3, "1234", "D")
+	doc.SetY(bottom + 4)
+	drawDocumentFooter(doc, data.Business)
+}
+
+// drawContract dibuja el contrato de venta con las partes, el plan de cuotas y las condiciones
+func drawContract(doc *fpdf.Fpdf, data SaleDocumentData) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	drawDocumentHeader(doc, data.Business)
+
+	doc.SetFont("Helvetica", "B", 15)
+	doc.CellFormat(nativeContentW, 8, "CONTRATO DE VENTA EN CUOTAS", "", 1, "C", false, 0, "")
+	doc.SetFont("Helvetica", "", 9)
+	setTextColor(doc, colorMuted)
+	doc.CellFormat(nativeContentW, 5, tr(fmt.Sprintf("Venta N° %d - %s", data.SaleID, data.SaleDate)), "", 1, "C", false, 0, "")
+	setTextColor(doc, colorText)
+	doc.Ln(4)
+
+	// Partes
+	seller := data.Payee()
+	if data.Business.CUIT != "" {
+		seller += ", CUIT " + data.Business.CUIT
+	}
+	if data.Business.Address != "" {
+		seller += ", con domicilio en " + data.Business.Address
+	}
+	parties := fmt.Sprintf("Entre %s, en adelante EL VENDEDOR, y %s, DNI %s, con domicilio en %s, en adelante EL COMPRADOR",
+		seller, data.Client.Name, data.Client.Dni, data.Client.Address)
+	if g := data.Guarantor; g != nil {
+		parties += fmt.Sprintf(", y %s, DNI %s, con domicilio en %s, en carácter de GARANTE", g.Name, g.Dni, g.Address)
+	}
+	parties += ", se celebra el presente contrato de venta en cuotas sujeto a las siguientes condiciones."
+	doc.SetFont("Helvetica", "", 10)
+	doc.MultiCell(nativeContentW, 5.5, tr(parties), "", "J", false)
+	doc.Ln(3)
+
+	// Objeto y precio
+	doc.SetFont("Helvetica", "B", 11)
+	doc.CellFormat(nativeContentW, 6, "Objeto y precio", "", 1, "L", false, 0, "")
+	drawLabelValue(doc, tr("Artículo:"), tr(data.ProductDesc), 10, 5.5)
+	doc.SetFont("Helvetica", "B", 10)
+	doc.CellFormat(doc.GetStringWidth("Precio financiado:")+1, 5.5, "Precio financiado:", "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 10)
+	doc.MultiCell(0, 5.5, tr(fmt.Sprintf("$ %s (PESOS %s)", data.TotalFormatted, data.TotalInWords)), "", "L", false)
+	drawLabelValue(doc, "Forma de pago:", tr(fmt.Sprintf("%d cuotas de $ %s", data.NumQuotas, data.QuotaPriceFormatted)), 10, 5.5)
+	doc.Ln(3)
+
+	// Plan de cuotas
+	if len(data.Quotas) > 0 {
+		doc.SetFont("Helvetica", "B", 11)
+		doc.CellFormat(nativeContentW, 6, "Plan de cuotas", "", 1, "L", false, 0, "")
+		drawDocumentQuotas(doc, data.Quotas)
+		doc.Ln(3)
+	}
+
+	// Condiciones
+	doc.SetFont("Helvetica", "B", 11)
+	doc.CellFormat(nativeContentW, 6, "Condiciones", "", 1, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 9.5)
+	for i, term := range data.Terms {
+		doc.MultiCell(nativeContentW, 5, tr(fmt.Sprintf("%d. %s", i+1, term)), "", "J", false)
+		doc.Ln(1)
+	}
+
+	// Firmas: si no entran en la hoja van en una nueva
+	if doc.GetY() > nativePageHeight-nativeMargin-40 {
+		doc.AddPage()
+	}
+	y := doc.GetY() + 22
+	signatures := []struct {
+		caption string
+		party   *PartyInfo
+	}{
+		{"EL VENDEDOR", nil},
+		{"EL COMPRADOR", &data.Client},
+	}
+	if data.Guarantor != nil {
+		signatures = append(signatures, struct {
+			caption string
+			party   *PartyInfo
+		}{"EL GARANTE", data.Guarantor})
+	}
+	slot := nativeContentW / float64(len(signatures))
+	for i, s := range signatures {
+		drawSignature(doc, nativeMargin+float64(i)*slot+5, y, slot-10, s.caption, s.party)
+	}
+	doc.SetY(y + 16)
+	drawDocumentFooter(doc, data.Business)
+}
+
+// drawDocumentQuotas dibuja el plan de cuotas en dos columnas para que ocupe menos
+func drawDocumentQuotas(doc *fpdf.Fpdf, quotas []QuotaRow) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	const rowH = 5.0
+	colW := []float64{15, 35, 40}
+	tableW := colW[0] + colW[1] + colW[2]
+	gap := nativeContentW - 2*tableW
+
+	header := func() {
+		doc.SetFont("Helvetica", "B", 9)
+		setFillColor(doc, colorHeader)
+		setDrawColor(doc, colorText)
+		doc.SetLineWidth(0.2)
+		for side := 0; side < 2; side++ {
+			doc.SetX(nativeMargin + float64(side)*(tableW+gap))
+			for i, title := range []string{"N°", "Vencimiento", "Monto"} {
+				doc.CellFormat(colW[i], rowH, tr(title), "1", 0, "C", true, 0, "")
+			}
+		}
+		doc.Ln(-1)
+	}
+
+	half := (len(quotas) + 1) / 2
+	header()
+	doc.SetFont("Helvetica", "", 9)
+	for row := 0; row < half; row++ {
+		if doc.GetY()+rowH > nativePageHeight-nativeMargin {
+			doc.AddPage()
+			header()
+			doc.SetFont("Helvetica", "", 9)
+		}
+		for side, index := range []int{row, row + half} {
+			if index >= len(quotas) {
+				continue
+			}
+			q := quotas[index]
+			doc.SetX(nativeMargin + float64(side)*(tableW+gap))
+			doc.CellFormat(colW[0], rowH, fmt.Sprintf("%d", q.Number), "1", 0, "C", false, 0, "")
+			doc.CellFormat(colW[1], rowH, q.DueDate, "1", 0, "C", false, 0, "")
+			doc.CellFormat(colW[2], rowH, "$ "+q.AmountFormatted, "1", 0, "R", false, 0, "")
+		}
+		doc.Ln(-1)
+	}
+}
+
+// drawDocumentFooter escribe el pie configurado en los datos del comercio
+func drawDocumentFooter(doc *fpdf.Fpdf, business BusinessInfo) {
+	if business.Footer == "" {
+		return
+	}
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	doc.SetFont("Helvetica", "", 8)
+	setTextColor(doc, colorMuted)
+	doc.MultiCell(nativeContentW, 4, tr(business.Footer), "", "C", false)
+	setTextColor(doc, colorText)
+}
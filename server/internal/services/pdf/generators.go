@@ -3,30 +3,82 @@ package pdf
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/utils"
 )
 
 // ReportGenerator maneja la generación de diferentes tipos de reportes
 type ReportGenerator struct {
-	templateManager *TemplateManager
-	converter       *PDFConverter
+	renderer Renderer
+	settings ports.BusinessSettingsService
 }
 
 // NewReportGenerator crea una nueva instancia del generador de reportes
-func NewReportGenerator(templateManager *TemplateManager, converter *PDFConverter) *ReportGenerator {
+func NewReportGenerator(renderer Renderer, settings ports.BusinessSettingsService) *ReportGenerator {
 	return &ReportGenerator{
-		templateManager: templateManager,
-		converter:       converter,
+		renderer: renderer,
+		settings: settings,
 	}
 }
 
+// defaultBusinessName es el nombre que se imprime si no se pueden leer los datos del comercio
+const defaultBusinessName = "ELECTRODOMESTICOS"
+
+// baseData arma los datos comunes de un reporte con los datos del comercio
+func (rg *ReportGenerator) baseData(reportType string) BaseData {
+	data := BaseData{
+		GeneratedAt: time.Now(),
+		ReportType:  reportType,
+		Business:    BusinessInfo{Name: defaultBusinessName},
+	}
+
+	if rg.settings == nil {
+		return data
+	}
+
+	settings, err := rg.settings.Get(context.Background())
+	if err != nil {
+		// Mejor un comprobante sin los datos del comercio que no poder imprimirlo
+		slog.Warn("error loading business settings for PDF", "report_type", reportType, "error", err)
+		return data
+	}
+
+	data.Business = BusinessInfo{
+		Name:      settings.Name,
+		LegalName: settings.LegalName,
+		CUIT:      settings.CUIT,
+		Address:   settings.Address,
+		Phone:     settings.Phone,
+		Footer:    settings.Footer,
+		Logo:      settings.Logo,
+		LogoMime:  settings.LogoMime,
+
+		ContractTerms: settings.ContractTerms,
+	}
+	return data
+}
+
 // GeneratePaymentReceipt genera un comprobante de pago en PDF
 func (rg *ReportGenerator) GeneratePaymentReceipt(payment *domain.Payment, client *domain.Client, quota *domain.Quota, sale *domain.Sale) ([]byte, error) {
+	data := rg.paymentReceiptData(payment, client, quota, sale)
+
+	// Generar el PDF con el backend configurado
+	pdfContent, err := rg.renderer.RenderPaymentReceipt(data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting to PDF: %w", err)
+	}
+
+	return pdfContent, nil
+}
+
+// paymentReceiptData arma los datos del comprobante (compartidos por el PDF A4 y el ticket)
+func (rg *ReportGenerator) paymentReceiptData(payment *domain.Payment, client *domain.Client, quota *domain.Quota, sale *domain.Sale) PaymentReceiptData {
 	// Usar fecha actual si payment.Date es nil
 	paymentDate := time.Now()
 	if payment.Date != nil {
@@ -34,14 +86,11 @@ func (rg *ReportGenerator) GeneratePaymentReceipt(payment *domain.Payment, clien
 	}
 
 	// Preparar datos para el template
-	data := PaymentReceiptData{
-		BaseData: BaseData{
-			GeneratedAt: time.Now(),
-			ReportType:  "payment_receipt",
-		},
+	return PaymentReceiptData{
+		BaseData:        rg.baseData("payment_receipt"),
 		PaymentID:       payment.ID,
 		Amount:          payment.Amount,
-		AmountFormatted: formatMoney(payment.Amount),
+		AmountFormatted: utils.FormatMoney(payment.Amount),
 		Date:            paymentDate,
 		ClientName:      fmt.Sprintf("%s %s", client.Name, client.Lastname),
 		ClientDni:       client.Dni,
@@ -50,20 +99,6 @@ func (rg *ReportGenerator) GeneratePaymentReceipt(payment *domain.Payment, clien
 		SaleDescription: sale.Description,
 		ReceiptNumber:   fmt.Sprintf("R-%d-%d", payment.ID, time.Now().Unix()),
 	}
-
-	// Generar HTML
-	htmlContent, err := rg.templateManager.GeneratePaymentReceiptHTML(data)
-	if err != nil {
-		return nil, fmt.Errorf("error generating HTML: %w", err)
-	}
-
-	// Convertir HTML a PDF
-	pdfContent, err := rg.converter.ConvertHTMLToPDF(htmlContent)
-	if err != nil {
-		return nil, fmt.Errorf("error converting to PDF: %w", err)
-	}
-
-	return pdfContent, nil
 }
 
 // GenerateDuplicate genera un duplicado del comprobante
@@ -75,6 +110,7 @@ func (rg *ReportGenerator) GenerateDuplicate(paymentID string) ([]byte, error) {
 
 // GenerateSaleSheet genera la ficha de venta en PDF
 type SaleSheetData struct {
+	BaseData
 	ClientName          string
 	ClientLastname      string
 	ClientInitials      string
@@ -87,6 +123,7 @@ type SaleSheetData struct {
 	QuotaPrice          float64
 	QuotaPriceFormatted string // Monto formateado para mostrar
 	Quotas              []QuotaRow
+	Guarantors          []PartyInfo
 }
 
 type QuotaRow struct {
@@ -142,6 +179,7 @@ func (rg *ReportGenerator) GenerateSaleSheetPDF(saleID int, saleService ports.Sa
 	}
 
 	data := SaleSheetData{
+		BaseData:            rg.baseData("sale_sheet"),
 		ClientName:          client.Name,
 		ClientLastname:      client.Lastname,
 		ClientInitials:      getInitials(client.Lastname),
@@ -152,9 +190,33 @@ func (rg *ReportGenerator) GenerateSaleSheetPDF(saleID int, saleService ports.Sa
 		ProductDesc:         sale.Description,
 		NumQuotas:           len(sale.Quotas),
 		QuotaPrice:          quotaPrice,
-		QuotaPriceFormatted: formatMoney(quotaPrice),
+		QuotaPriceFormatted: utils.FormatMoney(quotaPrice),
 	}
-	for _, q := range sale.Quotas {
+	data.Quotas = quotaRows(sale.Quotas)
+	data.Guarantors = guarantorParties(sale.Guarantors)
+
+	return rg.renderer.RenderSaleSheet(data)
+}
+
+// guarantorParties convierte los garantes de una venta en firmantes para los documentos
+func guarantorParties(guarantors []*domain.Guarantor) []PartyInfo {
+	parties := make([]PartyInfo, 0, len(guarantors))
+	for _, g := range guarantors {
+		parties = append(parties, PartyInfo{
+			Name:         fmt.Sprintf("%s %s", g.Name, g.Lastname),
+			Dni:          dashIfEmpty(g.Dni),
+			Phone:        dashIfEmpty(g.Phone),
+			Address:      dashIfEmpty(g.Address),
+			Relationship: g.Relationship,
+		})
+	}
+	return parties
+}
+
+// quotaRows convierte las cuotas de una venta en filas para los reportes
+func quotaRows(quotas []*domain.Quota) []QuotaRow {
+	rows := make([]QuotaRow, 0, len(quotas))
+	for _, q := range quotas {
 		row := QuotaRow{
 			Number: int(q.Number),
 			IsPaid: q.IsPaid,
@@ -166,16 +228,11 @@ func (rg *ReportGenerator) GenerateSaleSheetPDF(saleID int, saleService ports.Sa
 			}(),
 			DueDate:         formatDate(q.DueDate),
 			Amount:          q.Amount,
-			AmountFormatted: formatMoney(q.Amount),
+			AmountFormatted: utils.FormatMoney(q.Amount),
 		}
-		data.Quotas = append(data.Quotas, row)
-	}
-
-	htmlContent, err := rg.templateManager.GenerateSaleSheetHTML(data)
-	if err != nil {
-		return nil, err
+		rows = append(rows, row)
 	}
-	return rg.converter.ConvertHTMLToPDF(htmlContent)
+	return rows
 }
 
 func dashIfEmpty(s string) string {
@@ -202,32 +259,6 @@ func getInitials(lastname string) string {
 	return strings.ToUpper(lastname[:2])
 }
 
-// formatMoney formatea un monto con separador de miles (punto) y decimales (coma) - formato argentino
-func formatMoney(amount float64) string {
-	// Convertir a string con 2 decimales
-	formatted := fmt.Sprintf("%.2f", amount)
-
-	// Separar la parte entera de los decimales
-	parts := strings.Split(formatted, ".")
-	integerPart := parts[0]
-	decimalPart := parts[1]
-
-	// Agregar separadores de miles (puntos) a la parte entera
-	if len(integerPart) > 3 {
-		var result strings.Builder
-		for i, digit := range integerPart {
-			if i > 0 && (len(integerPart)-i)%3 == 0 {
-				result.WriteString(".")
-			}
-			result.WriteRune(digit)
-		}
-		integerPart = result.String()
-	}
-
-	// Retornar con formato argentino: separador de miles (.) y decimales (,)
-	return integerPart + "," + decimalPart
-}
-
 // TODO: Agregar más métodos para otros tipos de reportes cuando se necesiten
 // - GenerateSalesReport
 // - GenerateClientReport
@@ -241,8 +272,14 @@ type WorkerResult struct {
 	Error    error
 }
 
-// GenerateSalesBookPDFOptimized genera el libro de ventas usando un pool de workers para mejor rendimiento
-func (rg *ReportGenerator) GenerateSalesBookPDFOptimized(saleService ports.SaleService, clientService ports.ClientService) ([]byte, error) {
+// GenerateSalesBookPDFOptimized genera el libro de ventas usando un pool de workers para mejor rendimiento.
+// Si progress no es nil se llama cada vez que se termina de procesar una venta.
+func (rg *ReportGenerator) GenerateSalesBookPDFOptimized(
+	parent context.Context,
+	saleService ports.SaleService,
+	clientService ports.ClientService,
+	progress func(domain.ReportProgress),
+) ([]byte, error) {
 	// Obtener todas las ventas pendientes ordenadas alfabéticamente
 	pendingSales, err := saleService.GetPendingSalesOrderedByClient()
 	if err != nil {
@@ -264,7 +301,7 @@ func (rg *ReportGenerator) GenerateSalesBookPDFOptimized(saleService ports.SaleS
 		config = DefaultWorkerPoolConfig()
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	ctx, cancel := context.WithTimeout(parent, config.Timeout)
 	defer cancel()
 
 	// Canal para enviar trabajos a los workers
@@ -301,12 +338,22 @@ func (rg *ReportGenerator) GenerateSalesBookPDFOptimized(saleService ports.SaleS
 	salesData := make([]SaleEntry, len(pendingSales))
 	var processingErrors []error
 
+	processed := 0
 	for result := range results {
+		processed++
 		if result.Error != nil {
 			processingErrors = append(processingErrors, result.Error)
-			continue
+		} else {
+			salesData[result.Index] = result.SaleData
+		}
+		if progress != nil {
+			progress(domain.ReportProgress{Processed: processed, Total: len(pendingSales), Errors: len(processingErrors)})
 		}
-		salesData[result.Index] = result.SaleData
+	}
+
+	// Si se canceló o venció el tiempo el libro quedaría incompleto
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("generación del libro interrumpida: %w", err)
 	}
 
 	// Verificar si hay demasiados errores basándose en la configuración
@@ -327,22 +374,13 @@ func (rg *ReportGenerator) GenerateSalesBookPDFOptimized(saleService ports.SaleS
 	// Crear datos para el template del libro
 	now := time.Now()
 	bookData := SalesBookData{
-		BaseData: BaseData{
-			GeneratedAt: now,
-			ReportType:  "sales_book",
-		},
+		BaseData:      rg.baseData("sales_book"),
 		GeneratedDate: formatDate(&now),
 		Sales:         validSales,
 	}
 
-	// Generar HTML usando el template del libro de ventas
-	htmlContent, err := rg.templateManager.GenerateSalesBookHTML(bookData)
-	if err != nil {
-		return nil, fmt.Errorf("error generando HTML: %w", err)
-	}
-
-	// Convertir HTML a PDF
-	pdfContent, err := rg.converter.ConvertHTMLToPDF(htmlContent)
+	// Generar el PDF con el backend configurado
+	pdfContent, err := rg.renderer.RenderSalesBook(bookData)
 	if err != nil {
 		return nil, fmt.Errorf("error convirtiendo a PDF: %w", err)
 	}
@@ -421,26 +459,12 @@ func (rg *ReportGenerator) processSale(
 		ProductDesc:         sale.Description,
 		NumQuotas:           len(sale.Quotas),
 		QuotaPrice:          quotaPrice,
-		QuotaPriceFormatted: formatMoney(quotaPrice),
+		QuotaPriceFormatted: utils.FormatMoney(quotaPrice),
 	}
 
 	// Agregar cuotas
-	for _, q := range sale.Quotas {
-		row := QuotaRow{
-			Number: int(q.Number),
-			IsPaid: q.IsPaid,
-			PayDate: func() string {
-				if q.IsPaid && len(q.Payments) > 0 && q.Payments[0].Date != nil {
-					return formatDate(q.Payments[0].Date)
-				}
-				return ""
-			}(),
-			DueDate:         formatDate(q.DueDate),
-			Amount:          q.Amount,
-			AmountFormatted: formatMoney(q.Amount),
-		}
-		saleEntry.Quotas = append(saleEntry.Quotas, row)
-	}
+	saleEntry.Quotas = quotaRows(sale.Quotas)
+	saleEntry.Guarantors = guarantorParties(sale.Guarantors)
 
 	return WorkerResult{Index: index, SaleData: saleEntry, Error: nil}
 }
@@ -492,26 +516,12 @@ func (rg *ReportGenerator) GenerateSalesBookPDF(saleService ports.SaleService, c
 			ProductDesc:         sale.Description,
 			NumQuotas:           len(sale.Quotas),
 			QuotaPrice:          quotaPrice,
-			QuotaPriceFormatted: formatMoney(quotaPrice),
+			QuotaPriceFormatted: utils.FormatMoney(quotaPrice),
 		}
 
 		// Agregar cuotas
-		for _, q := range sale.Quotas {
-			row := QuotaRow{
-				Number: int(q.Number),
-				IsPaid: q.IsPaid,
-				PayDate: func() string {
-					if q.IsPaid && len(q.Payments) > 0 && q.Payments[0].Date != nil {
-						return formatDate(q.Payments[0].Date)
-					}
-					return ""
-				}(),
-				DueDate:         formatDate(q.DueDate),
-				Amount:          q.Amount,
-				AmountFormatted: formatMoney(q.Amount),
-			}
-			saleEntry.Quotas = append(saleEntry.Quotas, row)
-		}
+		saleEntry.Quotas = quotaRows(sale.Quotas)
+		saleEntry.Guarantors = guarantorParties(sale.Guarantors)
 
 		salesData = append(salesData, saleEntry)
 	}
@@ -519,22 +529,13 @@ func (rg *ReportGenerator) GenerateSalesBookPDF(saleService ports.SaleService, c
 	// Crear datos para el template del libro
 	now := time.Now()
 	bookData := SalesBookData{
-		BaseData: BaseData{
-			GeneratedAt: now,
-			ReportType:  "sales_book",
-		},
+		BaseData:      rg.baseData("sales_book"),
 		GeneratedDate: formatDate(&now),
 		Sales:         salesData,
 	}
 
-	// Generar HTML usando el template del libro de ventas
-	htmlContent, err := rg.templateManager.GenerateSalesBookHTML(bookData)
-	if err != nil {
-		return nil, fmt.Errorf("error generando HTML: %w", err)
-	}
-
-	// Convertir HTML a PDF
-	pdfContent, err := rg.converter.ConvertHTMLToPDF(htmlContent)
+	// Generar el PDF con el backend configurado
+	pdfContent, err := rg.renderer.RenderSalesBook(bookData)
 	if err != nil {
 		return nil, fmt.Errorf("error convirtiendo a PDF: %w", err)
 	}
@@ -0,0 +1,435 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// Medidas en mm para A4 con márgenes de 10mm (igual que DefaultReportConfig)
+const (
+	nativePageWidth  = 210.0
+	nativePageHeight = 297.0
+	nativeMargin     = 10.0
+	nativeContentW   = nativePageWidth - 2*nativeMargin
+)
+
+// Colores tomados de los estilos de los templates HTML
+var (
+	colorPrimary = [3]int{26, 35, 126}
+	colorAmount  = [3]int{0, 121, 107}
+	colorText    = [3]int{34, 34, 34}
+	colorMuted   = [3]int{102, 102, 102}
+	colorBorder  = [3]int{224, 224, 224}
+	colorHeader  = [3]int{240, 240, 240}
+	colorDanger  = [3]int{198, 40, 40}
+)
+
+// NativeRenderer dibuja los reportes directamente con fpdf, sin docker ni wkhtmltopdf
+type NativeRenderer struct{}
+
+// NewNativeRenderer crea un renderer en Go puro
+func NewNativeRenderer() *NativeRenderer {
+	return &NativeRenderer{}
+}
+
+// CheckAvailable siempre está disponible: no depende de procesos externos
+func (r *NativeRenderer) CheckAvailable(ctx context.Context) error {
+	return nil
+}
+
+// RenderPaymentReceipt genera el comprobante con original y duplicado en la misma hoja
+func (r *NativeRenderer) RenderPaymentReceipt(data PaymentReceiptData) ([]byte, error) {
+	doc := newNativeDocument("Recibo de Pago")
+	doc.AddPage()
+
+	y := drawReceiptCopy(doc, nativeMargin, data, false)
+
+	// Línea de corte entre original y duplicado
+	y += 8
+	setDrawColor(doc, colorMuted)
+	doc.SetLineWidth(0.3)
+	doc.SetDashPattern([]float64{2, 2}, 0)
+	doc.Line(nativeMargin, y, nativePageWidth-nativeMargin, y)
+	doc.SetDashPattern([]float64{}, 0)
+
+	drawReceiptCopy(doc, y+8, data, true)
+
+	return outputNativeDocument(doc)
+}
+
+// RenderSaleSheet genera la ficha de venta
+func (r *NativeRenderer) RenderSaleSheet(data SaleSheetData) ([]byte, error) {
+	doc := newNativeDocument("Ficha de Venta")
+	drawSaleSheet(doc, data)
+	return outputNativeDocument(doc)
+}
+
+// RenderSalesBook genera el libro de ventas, una ficha por página
+func (r *NativeRenderer) RenderSalesBook(data SalesBookData) ([]byte, error) {
+	doc := newNativeDocument("Libro de Ventas")
+	for _, sale := range data.Sales {
+		drawSaleSheet(doc, SaleSheetData{
+			BaseData:            data.BaseData,
+			ClientName:          sale.ClientName,
+			ClientLastname:      sale.ClientLastname,
+			ClientInitials:      sale.ClientInitials,
+			ClientDni:           sale.ClientDni,
+			ClientEmail:         sale.ClientEmail,
+			ClientPhone:         sale.ClientPhone,
+			SaleDate:            sale.SaleDate,
+			ProductDesc:         sale.ProductDesc,
+			NumQuotas:           sale.NumQuotas,
+			QuotaPrice:          sale.QuotaPrice,
+			QuotaPriceFormatted: sale.QuotaPriceFormatted,
+			Quotas:              sale.Quotas,
+			Guarantors:          sale.Guarantors,
+		})
+	}
+	return outputNativeDocument(doc)
+}
+
+func newNativeDocument(title string) *fpdf.Fpdf {
+	doc := fpdf.New("P", "mm", "A4", "")
+	doc.SetMargins(nativeMargin, nativeMargin, nativeMargin)
+	// Los saltos de página se manejan a mano para poder redibujar el recuadro
+	doc.SetAutoPageBreak(false, nativeMargin)
+	doc.SetTitle(title, true)
+	doc.SetCreator("GoStore", true)
+	return doc
+}
+
+func outputNativeDocument(doc *fpdf.Fpdf) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		return nil, fmt.Errorf("error rendering PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawReceiptCopy dibuja un ejemplar del recibo desde y, y devuelve dónde termina
+func drawReceiptCopy(doc *fpdf.Fpdf, y float64, data PaymentReceiptData, duplicate bool) float64 {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	const padding = 8.0
+	x := nativeMargin + padding
+	width := nativeContentW - 2*padding
+	top := y
+
+	titleColor := colorPrimary
+	if duplicate {
+		titleColor = [3]int{68, 68, 68}
+	}
+
+	// Empresa
+	y += padding
+	nameX := x
+	if logo := registerLogo(doc, data.Business); logo != nil {
+		logoW := logo.Width() * 8 / logo.Height()
+		doc.ImageOptions(nativeLogoName, x, y, logoW, 8, false, fpdf.ImageOptions{}, 0, "")
+		nameX += logoW + 3
+	}
+	doc.SetXY(nameX, y)
+	doc.SetFont("Helvetica", "B", 16)
+	setTextColor(doc, titleColor)
+	doc.CellFormat(width-(nameX-x), 8, tr(data.Business.Name), "", 0, "L", false, 0, "")
+
+	if details := businessDetails(data.Business); details != "" {
+		y += 9
+		doc.SetXY(x, y)
+		doc.SetFont("Helvetica", "", 8)
+		setTextColor(doc, colorMuted)
+		doc.CellFormat(width, 4, tr(details), "", 0, "L", false, 0, "")
+	}
+
+	// Número y fecha
+	y += 12
+	doc.SetXY(x, y)
+	doc.SetFont("Helvetica", "B", 13)
+	number := tr(fmt.Sprintf("RECIBO N° %s", data.ReceiptNumber))
+	numberW := doc.GetStringWidth(number)
+	doc.CellFormat(numberW, 7, number, "", 0, "L", false, 0, "")
+	setDrawColor(doc, titleColor)
+	doc.SetLineWidth(0.6)
+	doc.Line(x, y+7.5, x+numberW, y+7.5)
+	if duplicate {
+		doc.SetFont("Helvetica", "", 10)
+		setTextColor(doc, colorMuted)
+		doc.CellFormat(30, 7, "  (DUPLICADO)", "", 0, "L", false, 0, "")
+	}
+	doc.SetFont("Helvetica", "", 11)
+	setTextColor(doc, colorText)
+	doc.SetXY(x, y)
+	doc.CellFormat(width, 7, "Fecha: "+data.Date.Format("02/01/2006"), "", 0, "R", false, 0, "")
+
+	// Campos
+	y += 14
+	fields := []struct {
+		label  string
+		value  string
+		amount bool
+	}{
+		{"Recibí de:", data.ClientName, false},
+		{"La cantidad de:", "$" + data.AmountFormatted, true},
+		{"En concepto de:", data.SaleDescription, false},
+		{"Por la cuota N°:", fmt.Sprintf("%d", data.QuotaNumber), false},
+	}
+	const labelW = 40.0
+	for _, f := range fields {
+		doc.SetXY(x, y)
+		doc.SetFont("Helvetica", "", 11)
+		setTextColor(doc, colorText)
+		doc.CellFormat(labelW, 8, tr(f.label), "", 0, "L", false, 0, "")
+		if f.amount {
+			doc.SetFont("Helvetica", "B", 12)
+			setTextColor(doc, colorAmount)
+		}
+		doc.CellFormat(width-labelW, 8, tr(f.value), "", 0, "L", false, 0, "")
+		setDrawColor(doc, colorBorder)
+		doc.SetLineWidth(0.2)
+		doc.Line(x, y+8.5, x+width, y+8.5)
+		y += 10
+	}
+
+	// Firma
+	y += 14
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.5)
+	doc.Line(x+width-60, y, x+width, y)
+	doc.SetXY(x+width-60, y+1)
+	doc.SetFont("Helvetica", "", 9)
+	setTextColor(doc, colorText)
+	doc.CellFormat(60, 5, tr("Firma y Aclaración"), "", 0, "C", false, 0, "")
+	y += 6
+
+	if data.Business.Footer != "" {
+		y += 2
+		doc.SetXY(x, y)
+		doc.SetFont("Helvetica", "", 8)
+		setTextColor(doc, colorMuted)
+		doc.MultiCell(width, 4, tr(data.Business.Footer), "", "C", false)
+		y = doc.GetY()
+	}
+	y += padding
+
+	setDrawColor(doc, colorText)
+	doc.SetLineWidth(0.4)
+	doc.RoundedRect(nativeMargin, top, nativeContentW, y-top, 3, "1234", "D")
+
+	return y
+}
+
+// drawSaleSheet dibuja la ficha de una venta empezando en una página nueva
+func drawSaleSheet(doc *fpdf.Fpdf, data SaleSheetData) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	const padding = 7.0
+	x := nativeMargin + padding
+	width := nativeContentW - 2*padding
+	bottom := nativePageHeight - nativeMargin - padding
+
+	newPage := func() float64 {
+		doc.AddPage()
+		setDrawColor(doc, colorText)
+		doc.SetLineWidth(0.6)
+		doc.RoundedRect(nativeMargin, nativeMargin, nativeContentW, nativePageHeight-2*nativeMargin, 6, "1234", "D")
+		return nativeMargin + padding
+	}
+	y := newPage()
+
+	// Encabezado con los datos del comercio
+	nameX := x
+	if logo := registerLogo(doc, data.Business); logo != nil {
+		logoW := logo.Width() * 7 / logo.Height()
+		doc.ImageOptions(nativeLogoName, x, y, logoW, 7, false, fpdf.ImageOptions{}, 0, "")
+		nameX += logoW + 3
+	}
+	doc.SetXY(nameX, y)
+	doc.SetFont("Helvetica", "B", 12)
+	setTextColor(doc, colorText)
+	name := tr(data.Business.Name)
+	nameW := doc.GetStringWidth(name) + 3
+	doc.CellFormat(nameW, 7, name, "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 9)
+	setTextColor(doc, colorMuted)
+	doc.CellFormat(width-(nameX-x)-nameW, 7, tr(businessDetails(data.Business)), "", 0, "L", false, 0, "")
+	setDrawColor(doc, colorBorder)
+	doc.SetLineWidth(0.3)
+	doc.Line(x, y+9, x+width, y+9)
+	y += 13
+
+	// Iniciales del cliente en un recuadro a la derecha
+	doc.SetFont("Helvetica", "B", 22)
+	setTextColor(doc, colorText)
+	initialsW := doc.GetStringWidth(data.ClientInitials) + 12
+	doc.SetLineWidth(0.6)
+	doc.RoundedRect(x+width-initialsW, y, initialsW, 14, 3, "1234", "D")
+	doc.SetXY(x+width-initialsW, y)
+	doc.CellFormat(initialsW, 14, tr(data.ClientInitials), "", 0, "C", false, 0, "")
+
+	// Datos del cliente
+	infoW := width - initialsW - 4
+	doc.SetXY(x, y)
+	doc.SetFont("Helvetica", "B", 16)
+	doc.CellFormat(infoW, 9, tr(fmt.Sprintf("Cliente: %s, %s", data.ClientLastname, data.ClientName)), "", 2, "L", false, 0, "")
+	for _, row := range [][2]string{
+		{"DNI:", data.ClientDni},
+		{"Email:", data.ClientEmail},
+		{"Tel.:", data.ClientPhone},
+	} {
+		doc.SetX(x)
+		drawLabelValue(doc, tr(row[0]), tr(row[1]), 12, 7)
+	}
+	y = doc.GetY() + 4
+
+	// Fecha de venta alineada a la derecha
+	label := "Fecha de venta: "
+	doc.SetFont("Helvetica", "", 10)
+	dateW := doc.GetStringWidth(data.SaleDate)
+	doc.SetFont("Helvetica", "B", 10)
+	doc.SetXY(x+width-doc.GetStringWidth(label)-dateW-2, y)
+	drawLabelValue(doc, label, data.SaleDate, 10, 6)
+	y = doc.GetY() + 4
+
+	// Datos de la venta
+	doc.SetXY(x, y)
+	doc.SetFont("Helvetica", "B", 12)
+	descLabel := tr("Descripción: ")
+	descLabelW := doc.GetStringWidth(descLabel)
+	doc.CellFormat(descLabelW, 7, descLabel, "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 12)
+	doc.MultiCell(width-descLabelW, 7, tr(data.ProductDesc), "", "L", false)
+	doc.SetX(x)
+	drawLabelValue(doc, "N. Cuotas: ", fmt.Sprintf("%d", data.NumQuotas), 12, 7)
+	doc.SetX(x)
+	drawLabelValue(doc, "Precio Cuota: ", "$"+data.QuotaPriceFormatted, 12, 7)
+
+	// Garantes de la venta
+	for _, g := range data.Guarantors {
+		doc.SetX(x)
+		value := fmt.Sprintf("%s - DNI %s - Tel. %s - %s", g.Name, g.Dni, g.Phone, g.Address)
+		if g.Relationship != "" {
+			value += fmt.Sprintf(" (%s)", g.Relationship)
+		}
+		doc.SetFont("Helvetica", "B", 12)
+		labelW := doc.GetStringWidth("Garante: ") + 1
+		doc.SetFont("Helvetica", "", 12)
+		drawLabelValue(doc, "Garante: ", fitText(doc, tr(value), width-labelW), 12, 7)
+	}
+	y = doc.GetY() + 5
+
+	// Tabla de cuotas
+	columns := []struct {
+		title string
+		width float64
+	}{
+		{"Cuota Nº", 0.14},
+		{"Estado", 0.18},
+		{"Fecha de pago", 0.22},
+		{"Fecha vencimiento", 0.24},
+		{"Monto", 0.22},
+	}
+	const rowH = 8.0
+	drawHeader := func() {
+		doc.SetXY(x, y)
+		doc.SetFont("Helvetica", "B", 11)
+		setFillColor(doc, colorHeader)
+		setDrawColor(doc, colorText)
+		doc.SetLineWidth(0.3)
+		for _, c := range columns {
+			doc.CellFormat(width*c.width, rowH, tr(c.title), "1", 0, "C", true, 0, "")
+		}
+		y += rowH
+	}
+	drawHeader()
+
+	doc.SetFont("Helvetica", "", 11)
+	for _, q := range data.Quotas {
+		if y+rowH > bottom {
+			y = newPage()
+			drawHeader()
+			doc.SetFont("Helvetica", "", 11)
+		}
+		status, payDate := "", ""
+		if q.IsPaid {
+			status, payDate = "PAGADO", q.PayDate
+		}
+		values := []string{fmt.Sprintf("%d", q.Number), status, payDate, q.DueDate, "$" + q.AmountFormatted}
+		doc.SetXY(x, y)
+		for i, c := range columns {
+			doc.CellFormat(width*c.width, rowH, values[i], "1", 0, "C", false, 0, "")
+		}
+		y += rowH
+	}
+
+	if data.Business.Footer != "" {
+		doc.SetFont("Helvetica", "", 9)
+		footer := tr(data.Business.Footer)
+		if y+6+4*float64(len(doc.SplitText(footer, width))) > bottom {
+			y = newPage()
+		}
+		doc.SetXY(x, y+6)
+		setTextColor(doc, colorMuted)
+		doc.MultiCell(width, 4, footer, "", "C", false)
+	}
+}
+
+// nativeLogoName es el nombre con el que se registra el logo en cada documento
+const nativeLogoName = "business_logo"
+
+// registerLogo carga el logo del comercio; si la imagen no se puede leer se imprime sin logo
+func registerLogo(doc *fpdf.Fpdf, business BusinessInfo) *fpdf.ImageInfoType {
+	if len(business.Logo) == 0 {
+		return nil
+	}
+
+	imageType := "PNG"
+	if business.LogoMime == "image/jpeg" {
+		imageType = "JPG"
+	}
+
+	info := doc.RegisterImageOptionsReader(nativeLogoName, fpdf.ImageOptions{ImageType: imageType}, bytes.NewReader(business.Logo))
+	if doc.Err() {
+		slog.Warn("error loading business logo for PDF", "error", doc.Error())
+		doc.ClearError()
+		return nil
+	}
+	return info
+}
+
+// businessDetails arma la línea con razón social, CUIT, dirección y teléfono
+func businessDetails(business BusinessInfo) string {
+	return strings.Join(businessDetailParts(business), " · ")
+}
+
+// businessDetailParts devuelve los datos del comercio que estén cargados, en orden de impresión
+func businessDetailParts(business BusinessInfo) []string {
+	var parts []string
+	if business.LegalName != "" {
+		parts = append(parts, business.LegalName)
+	}
+	if business.CUIT != "" {
+		parts = append(parts, "CUIT "+business.CUIT)
+	}
+	if business.Address != "" {
+		parts = append(parts, business.Address)
+	}
+	if business.Phone != "" {
+		parts = append(parts, "Tel. "+business.Phone)
+	}
+	return parts
+}
+
+func setTextColor(doc *fpdf.Fpdf, c [3]int) { doc.SetTextColor(c[0], c[1], c[2]) }
+func setDrawColor(doc *fpdf.Fpdf, c [3]int) { doc.SetDrawColor(c[0], c[1], c[2]) }
+func setFillColor(doc *fpdf.Fpdf, c [3]int) { doc.SetFillColor(c[0], c[1], c[2]) }
+
+// drawLabelValue escribe "label valor" en la línea actual con el label en negrita
+func drawLabelValue(doc *fpdf.Fpdf, label, value string, size, height float64) {
+	doc.SetFont("Helvetica", "B", size)
+	doc.CellFormat(doc.GetStringWidth(label)+1, height, label, "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", size)
+	doc.CellFormat(0, height, value, "", 1, "L", false, 0, "")
+}
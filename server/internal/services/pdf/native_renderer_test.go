@@ -0,0 +1,134 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	pdfPage   = regexp.MustCompile(`/Type /Page\b[^s]`)
+	pdfStream = regexp.MustCompile(`(?s)stream\r?\n(.*?)\r?\nendstream`)
+)
+
+func testBusiness(t *testing.T) BusinessInfo {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 20; y++ {
+			img.Set(x, y, color.RGBA{26, 35, 126, 255})
+		}
+	}
+	var logo bytes.Buffer
+	if err := png.Encode(&logo, img); err != nil {
+		t.Fatal(err)
+	}
+	return BusinessInfo{
+		Name:     "Mueblería Peña",
+		CUIT:     "20-12345678-6",
+		Address:  "Av. Güemes 1234, Tucumán",
+		Footer:   "Gracias por su compra. Conserve este comprobante.",
+		Logo:     logo.Bytes(),
+		LogoMime: "image/png",
+	}
+}
+
+// pdfText descomprime los streams del documento para buscar el texto dibujado
+func pdfText(t *testing.T, doc []byte) []byte {
+	t.Helper()
+	var text []byte
+	for _, match := range pdfStream.FindAllSubmatch(doc, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(match[1]))
+		if err != nil {
+			continue // Imágenes u otros streams sin comprimir
+		}
+		content, _ := io.ReadAll(r)
+		text = append(text, content...)
+	}
+	return text
+}
+
+func assertPDF(t *testing.T, doc []byte, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if !bytes.HasPrefix(doc, []byte("%PDF")) {
+		t.Fatalf("output is not a PDF: %q", doc[:min(len(doc), 16)])
+	}
+}
+
+func TestNativeRendererPaymentReceipt(t *testing.T) {
+	doc, err := NewNativeRenderer().RenderPaymentReceipt(PaymentReceiptData{
+		BaseData:        BaseData{GeneratedAt: time.Now(), Business: testBusiness(t)},
+		PaymentID:       12,
+		Amount:          15000,
+		AmountFormatted: "15.000,00",
+		Date:            time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local),
+		ClientName:      "José Muñoz",
+		ClientDni:       "30123456",
+		QuotaNumber:     3,
+		SaleID:          7,
+		SaleDescription: "Colchón de dos plazas",
+		ReceiptNumber:   "0001-00000012",
+	})
+	assertPDF(t, doc, err)
+
+	if !bytes.Contains(doc, []byte("/Subtype /Image")) {
+		t.Error("logo is missing from the receipt")
+	}
+	// Los acentos y la ñ se traducen a cp1252, la codificación de las fuentes base
+	text := pdfText(t, doc)
+	for _, want := range []string{"Mu\xf1oz", "Colch\xf3n"} {
+		if !bytes.Contains(text, []byte(want)) {
+			t.Errorf("receipt text does not contain %q", want)
+		}
+	}
+}
+
+func TestNativeRendererSaleSheetPaginatesQuotas(t *testing.T) {
+	quotas := make([]QuotaRow, 60)
+	for i := range quotas {
+		quotas[i] = QuotaRow{
+			Number:          i + 1,
+			IsPaid:          i < 10,
+			PayDate:         "05/01/2026",
+			DueDate:         fmt.Sprintf("10/%02d/2026", i%12+1),
+			Amount:          2500,
+			AmountFormatted: "2.500,00",
+		}
+	}
+
+	doc, err := NewNativeRenderer().RenderSaleSheet(SaleSheetData{
+		BaseData:            BaseData{GeneratedAt: time.Now(), Business: testBusiness(t)},
+		ClientName:          "María",
+		ClientLastname:      "Núñez",
+		ClientInitials:      "MN",
+		ClientDni:           "28123456",
+		SaleDate:            "10/01/2026",
+		ProductDesc:         "Heladera con freezer y lavarropas automático",
+		NumQuotas:           len(quotas),
+		QuotaPrice:          2500,
+		QuotaPriceFormatted: "2.500,00",
+		Quotas:              quotas,
+		Guarantors:          []PartyInfo{{Name: "Raúl Ibáñez", Dni: "20111222", Relationship: "Hermano"}},
+	})
+	assertPDF(t, doc, err)
+
+	if pages := len(pdfPage.FindAll(doc, -1)); pages < 2 {
+		t.Errorf("got %d pages, want the quota table split across several", pages)
+	}
+	text := pdfText(t, doc)
+	for _, want := range []string{"N\xfa\xf1ez", "autom\xe1tico", "(60)"} {
+		if !bytes.Contains(text, []byte(want)) {
+			t.Errorf("sale sheet text does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/metrics"
+)
+
+// Renderer convierte los datos de cada reporte en un PDF
+type Renderer interface {
+	RenderPaymentReceipt(data PaymentReceiptData) ([]byte, error)
+	RenderSaleSheet(data SaleSheetData) ([]byte, error)
+	RenderSalesBook(data SalesBookData) ([]byte, error)
+	RenderCollectionCards(data CollectionCardsData) ([]byte, error)
+	RenderSaleDocument(data SaleDocumentData) ([]byte, error)
+	RenderRouteSheet(data RouteSheetData) ([]byte, error)
+	// CheckAvailable verifica que el backend pueda generar PDFs (usado por /readyz)
+	CheckAvailable(ctx context.Context) error
+}
+
+// NewRenderer crea el backend configurado en pdf.renderer
+func NewRenderer(cfg config.PDFConfig) (Renderer, error) {
+	switch cfg.Renderer {
+	case config.PDFRendererWkhtmltopdf, "":
+		converter := NewPDFConverter(cfg.TempDir, cfg.DockerImage, DefaultReportConfig())
+		return NewHTMLRenderer(NewTemplateManager(cfg.TemplatesDir), converter), nil
+	case config.PDFRendererNative:
+		return measuredRenderer{NewNativeRenderer()}, nil
+	default:
+		return nil, fmt.Errorf("unknown pdf renderer %q", cfg.Renderer)
+	}
+}
+
+// measuredRenderer registra cada PDF en las mismas métricas que PDFConverter,
+// que con el renderer nativo no se usa
+type measuredRenderer struct {
+	Renderer
+}
+
+func measure(render func() ([]byte, error)) ([]byte, error) {
+	start := time.Now()
+	doc, err := render()
+	metrics.PDFConversionDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.PDFConversionFailures.Inc()
+	}
+	return doc, err
+}
+
+func (m measuredRenderer) RenderPaymentReceipt(data PaymentReceiptData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderPaymentReceipt(data) })
+}
+
+func (m measuredRenderer) RenderSaleSheet(data SaleSheetData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderSaleSheet(data) })
+}
+
+func (m measuredRenderer) RenderSalesBook(data SalesBookData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderSalesBook(data) })
+}
+
+func (m measuredRenderer) RenderCollectionCards(data CollectionCardsData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderCollectionCards(data) })
+}
+
+func (m measuredRenderer) RenderSaleDocument(data SaleDocumentData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderSaleDocument(data) })
+}
+
+func (m measuredRenderer) RenderRouteSheet(data RouteSheetData) ([]byte, error) {
+	return measure(func() ([]byte, error) { return m.Renderer.RenderRouteSheet(data) })
+}
+
+// HTMLRenderer genera HTML desde los templates y lo convierte con wkhtmltopdf
+type HTMLRenderer struct {
+	templateManager *TemplateManager
+	converter       *PDFConverter
+}
+
+// NewHTMLRenderer crea un renderer basado en templates HTML
+func NewHTMLRenderer(templateManager *TemplateManager, converter *PDFConverter) *HTMLRenderer {
+	return &HTMLRenderer{
+		templateManager: templateManager,
+		converter:       converter,
+	}
+}
+
+// RenderPaymentReceipt genera el comprobante de pago
+func (r *HTMLRenderer) RenderPaymentReceipt(data PaymentReceiptData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GeneratePaymentReceiptHTML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error generating HTML: %w", err)
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// RenderSaleSheet genera la ficha de venta
+func (r *HTMLRenderer) RenderSaleSheet(data SaleSheetData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GenerateSaleSheetHTML(data)
+	if err != nil {
+		return nil, err
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// RenderSalesBook genera el libro de ventas
+func (r *HTMLRenderer) RenderSalesBook(data SalesBookData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GenerateSalesBookHTML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error generando HTML: %w", err)
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// RenderCollectionCards genera las tarjetas de cobro
+func (r *HTMLRenderer) RenderCollectionCards(data CollectionCardsData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GenerateCollectionCardsHTML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error generando HTML: %w", err)
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// RenderSaleDocument genera el pagaré o el contrato de una venta
+func (r *HTMLRenderer) RenderSaleDocument(data SaleDocumentData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GenerateSaleDocumentHTML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error generando HTML: %w", err)
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// RenderRouteSheet genera la hoja de ruta de un cobrador
+func (r *HTMLRenderer) RenderRouteSheet(data RouteSheetData) ([]byte, error) {
+	htmlContent, err := r.templateManager.GenerateRouteSheetHTML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error generando HTML: %w", err)
+	}
+	return r.converter.ConvertHTMLToPDF(htmlContent)
+}
+
+// CheckAvailable verifica que docker y la imagen de wkhtmltopdf estén disponibles
+func (r *HTMLRenderer) CheckAvailable(ctx context.Context) error {
+	return r.converter.CheckAvailable(ctx)
+}
@@ -0,0 +1,187 @@
+package pdf
+
+import (
+	"fmt"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/utils"
+	"github.com/go-pdf/fpdf"
+)
+
+// GenerateRouteSheetPDF genera la hoja de ruta de un cobrador para imprimir y llevar en el recorrido
+func (rg *ReportGenerator) GenerateRouteSheetPDF(sheet *domain.RouteSheet) ([]byte, error) {
+	data := RouteSheetData{
+		BaseData:       rg.baseData("route_sheet"),
+		CollectorName:  sheet.Collector.FullName(),
+		Date:           formatDate(&sheet.Date),
+		OverdueCount:   sheet.OverdueCount,
+		DueTodayCount:  sheet.DueTodayCount,
+		TotalFormatted: utils.FormatMoney(sheet.Total),
+	}
+
+	for i, stop := range sheet.Stops {
+		row := RouteStopRow{
+			Number:         i + 1,
+			ClientName:     stop.Name,
+			ClientLastname: stop.Lastname,
+			ClientDni:      dashIfEmpty(stop.Dni),
+			ClientPhone:    dashIfEmpty(stop.Phone),
+			ClientAddress:  dashIfEmpty(stop.Address),
+			Zone:           stop.Zone,
+			TotalFormatted: utils.FormatMoney(stop.Total),
+			Guarantors:     guarantorParties(stop.Guarantors),
+		}
+		for _, quota := range stop.Quotas {
+			status := "Vence hoy"
+			if quota.DaysOverdue == 1 {
+				status = "1 día de atraso"
+			} else if quota.DaysOverdue > 1 {
+				status = fmt.Sprintf("%d días de atraso", quota.DaysOverdue)
+			}
+			row.Quotas = append(row.Quotas, RouteQuotaRow{
+				SaleID:           quota.SaleID,
+				SaleDescription:  quota.SaleDescription,
+				Number:           quota.Number,
+				DueDate:          formatDate(&quota.DueDate),
+				Status:           status,
+				Overdue:          quota.DaysOverdue > 0,
+				BalanceFormatted: utils.FormatMoney(quota.Balance),
+			})
+		}
+		data.Stops = append(data.Stops, row)
+	}
+
+	pdfContent, err := rg.renderer.RenderRouteSheet(data)
+	if err != nil {
+		return nil, fmt.Errorf("error convirtiendo a PDF: %w", err)
+	}
+
+	return pdfContent, nil
+}
+
+// Columnas de las cuotas de cada parada; "Cobrado" queda en blanco para completar a mano
+var routeSheetColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Venta", 16, "C"},
+	{"Artículo", nativeContentW - 136, "L"},
+	{"Cuota", 14, "C"},
+	{"Vence", 20, "C"},
+	{"Estado", 34, "C"},
+	{"Saldo", 24, "R"},
+	{"Cobrado", 28, "C"},
+}
+
+const routeSheetRowH = 5.0
+
+// RenderRouteSheet dibuja la hoja de ruta; cada cliente queda entero en una misma página
+func (r *NativeRenderer) RenderRouteSheet(data RouteSheetData) ([]byte, error) {
+	doc := newNativeDocument("Hoja de Ruta")
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	doc.AddPage()
+
+	drawDocumentHeader(doc, data.Business)
+
+	doc.SetFont("Helvetica", "B", 14)
+	setTextColor(doc, colorPrimary)
+	doc.CellFormat(nativeContentW/2, 7, tr("HOJA DE RUTA"), "", 0, "L", false, 0, "")
+	doc.SetFont("Helvetica", "", 10)
+	setTextColor(doc, colorText)
+	doc.CellFormat(nativeContentW/2, 7, tr("Fecha: "+data.Date), "", 1, "R", false, 0, "")
+
+	drawLabelValue(doc, "Cobrador:", tr(data.CollectorName), 10, 6)
+	summary := fmt.Sprintf("%d clientes - %d cuotas vencidas - %d vencen hoy - Total a cobrar: $%s",
+		len(data.Stops), data.OverdueCount, data.DueTodayCount, data.TotalFormatted)
+	doc.SetFont("Helvetica", "", 9)
+	setTextColor(doc, colorMuted)
+	doc.CellFormat(nativeContentW, 5, tr(summary), "", 1, "L", false, 0, "")
+	doc.Ln(3)
+
+	if len(data.Stops) == 0 {
+		doc.SetFont("Helvetica", "I", 10)
+		doc.CellFormat(nativeContentW, 8, tr("No hay cuotas para cobrar en la fecha."), "", 1, "C", false, 0, "")
+	}
+
+	for _, stop := range data.Stops {
+		height := 16 + 4*float64(len(stop.Guarantors)) + float64(len(stop.Quotas)+1)*routeSheetRowH
+		if doc.GetY()+height > nativePageHeight-nativeMargin {
+			doc.AddPage()
+		}
+		drawRouteStop(doc, stop)
+	}
+
+	return outputNativeDocument(doc)
+}
+
+// drawRouteStop dibuja un cliente con su dirección y la tabla de cuotas a cobrar
+func drawRouteStop(doc *fpdf.Fpdf, stop RouteStopRow) {
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+
+	setFillColor(doc, colorHeader)
+	doc.SetFont("Helvetica", "B", 10)
+	setTextColor(doc, colorText)
+	title := fmt.Sprintf("%d. %s, %s", stop.Number, stop.ClientLastname, stop.ClientName)
+	zone := ""
+	if stop.Zone != "" {
+		zone = "Zona: " + stop.Zone
+	}
+	doc.CellFormat(nativeContentW*0.7, 6, tr(title), "", 0, "L", true, 0, "")
+	doc.SetFont("Helvetica", "", 9)
+	doc.CellFormat(nativeContentW*0.3, 6, tr(zone), "", 1, "R", true, 0, "")
+
+	drawCardField(doc, nativeMargin, nativeContentW, tr("Dirección:"), tr(stop.ClientAddress))
+	drawCardField(doc, nativeMargin, nativeContentW, tr("Tel.:"), tr(stop.ClientPhone+"    DNI: "+stop.ClientDni))
+	for _, g := range stop.Guarantors {
+		value := fmt.Sprintf("%s (%s)    Tel.: %s    Dir.: %s", g.Name, dashIfEmpty(g.Relationship), g.Phone, g.Address)
+		drawCardField(doc, nativeMargin, nativeContentW, "Garante:", tr(value))
+	}
+	doc.Ln(1)
+
+	doc.SetFont("Helvetica", "B", 8)
+	setDrawColor(doc, colorBorder)
+	doc.SetLineWidth(0.2)
+	for _, col := range routeSheetColumns {
+		doc.CellFormat(col.width, routeSheetRowH, tr(col.title), "1", 0, "C", false, 0, "")
+	}
+	doc.Ln(-1)
+
+	doc.SetFont("Helvetica", "", 8)
+	for _, q := range stop.Quotas {
+		values := []string{
+			fmt.Sprintf("%d", q.SaleID),
+			q.SaleDescription,
+			fmt.Sprintf("%d", q.Number),
+			q.DueDate,
+			q.Status,
+			"$" + q.BalanceFormatted,
+			"",
+		}
+		for i, col := range routeSheetColumns {
+			if i == 4 && q.Overdue {
+				setTextColor(doc, colorDanger)
+			}
+			doc.CellFormat(col.width, routeSheetRowH, fitText(doc, tr(values[i]), col.width-2), "1", 0, col.align, false, 0, "")
+			setTextColor(doc, colorText)
+		}
+		doc.Ln(-1)
+	}
+
+	doc.SetFont("Helvetica", "B", 8)
+	totalW := routeSheetColumns[len(routeSheetColumns)-1].width + routeSheetColumns[len(routeSheetColumns)-2].width
+	doc.CellFormat(nativeContentW-totalW, routeSheetRowH, tr("Total a cobrar"), "", 0, "R", false, 0, "")
+	doc.CellFormat(totalW-routeSheetColumns[len(routeSheetColumns)-1].width, routeSheetRowH, "$"+stop.TotalFormatted, "", 1, "R", false, 0, "")
+	doc.Ln(3)
+}
+
+// fitText recorta s (ya traducido) con "..." para que entre en width mm con la fuente actual
+func fitText(doc *fpdf.Fpdf, s string, width float64) string {
+	if doc.GetStringWidth(s) <= width {
+		return s
+	}
+	for len(s) > 0 && doc.GetStringWidth(s+"...") > width {
+		s = s[:len(s)-1]
+	}
+	return s + "..."
+}
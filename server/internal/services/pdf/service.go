@@ -1,11 +1,14 @@
 package pdf
 
 import (
+	"context"
 	"fmt"
-	"log"
 
+	"github.com/benitez96/gostore/internal/config"
 	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/escpos"
 	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/logger"
 )
 
 // Service es el servicio principal de PDF que coordina todos los componentes
@@ -19,24 +22,31 @@ type Service struct {
 
 // NewService crea una nueva instancia del servicio de PDF
 func NewService(
+	cfg config.PDFConfig,
 	paymentService ports.PaymentService,
 	quotaService ports.QuotaService,
 	clientService ports.ClientService,
 	saleService ports.SaleService,
-) *Service {
-	// Crear componentes
-	templateManager := NewTemplateManager()
-	config := DefaultReportConfig()
-	converter := NewPDFConverter("/tmp", config)
-	generator := NewReportGenerator(templateManager, converter)
+	settingsService ports.BusinessSettingsService,
+) (*Service, error) {
+	// Crear el backend de renderizado según la configuración
+	renderer, err := NewRenderer(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Service{
-		generator:      generator,
+		generator:      NewReportGenerator(renderer, settingsService),
 		paymentService: paymentService,
 		quotaService:   quotaService,
 		clientService:  clientService,
 		saleService:    saleService,
-	}
+	}, nil
+}
+
+// CheckRenderer verifica que la generación de PDFs esté disponible (usado por /readyz)
+func (s *Service) CheckRenderer(ctx context.Context) error {
+	return s.generator.renderer.CheckAvailable(ctx)
 }
 
 // GeneratePaymentReceipt genera un comprobante de pago en PDF
@@ -45,62 +55,115 @@ func (s *Service) GeneratePaymentReceipt(payment *domain.Payment, client *domain
 }
 
 // GeneratePaymentReceiptFromID genera un comprobante de pago en PDF a partir del ID del pago
-func (s *Service) GeneratePaymentReceiptFromID(paymentID string) ([]byte, error) {
+func (s *Service) GeneratePaymentReceiptFromID(ctx context.Context, paymentID string) ([]byte, error) {
+	log := logger.FromContext(ctx).With("payment_id", paymentID)
+
+	payment, client, quota, sale, err := s.loadPaymentReceipt(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generar el PDF
+	pdfContent, err := s.GeneratePaymentReceipt(payment, client, quota, sale)
+	if err != nil {
+		log.Error("error generating PDF", "error", err)
+		return nil, err
+	}
+
+	log.Info("payment receipt generated", "bytes", len(pdfContent))
+	return pdfContent, nil
+}
+
+// loadPaymentReceipt obtiene el pago y la cuota, el cliente y la venta a los que corresponde
+func (s *Service) loadPaymentReceipt(ctx context.Context, paymentID string) (*domain.Payment, *domain.Client, *domain.Quota, *domain.Sale, error) {
+	log := logger.FromContext(ctx).With("payment_id", paymentID)
+
 	// Obtener el payment desde la base de datos
 	payment, err := s.paymentService.GetByID(paymentID)
 	if err != nil {
-		log.Printf("Error getting payment: %v", err)
-		return nil, fmt.Errorf("error getting payment: %w", err)
+		log.Error("error getting payment", "error", err)
+		return nil, nil, nil, nil, fmt.Errorf("error getting payment: %w", err)
 	}
 
-	log.Printf("Payment found: ID=%d, Amount=%.2f, QuotaID=%d", payment.ID, payment.Amount, payment.QuotaID)
+	log.Debug("payment found", "amount", payment.Amount, "quota_id", payment.QuotaID)
 
 	// Obtener la quota desde la base de datos
 	quota, err := s.quotaService.GetByID(fmt.Sprintf("%d", payment.QuotaID))
 	if err != nil {
-		log.Printf("Error getting quota: %v", err)
-		return nil, fmt.Errorf("error getting quota: %w", err)
+		log.Error("error getting quota", "error", err)
+		return nil, nil, nil, nil, fmt.Errorf("error getting quota: %w", err)
 	}
 
-	log.Printf("Quota found: ID=%v, Number=%d, ClientID=%v, SaleID=%v", quota.ID, quota.Number, quota.ClientID, quota.SaleID)
+	log.Debug("quota found", "quota_id", quota.ID, "number", quota.Number, "client_id", quota.ClientID, "sale_id", quota.SaleID)
 
 	// Obtener el client desde la base de datos
 	clientIDStr, ok := quota.ClientID.(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid client ID type")
+		return nil, nil, nil, nil, fmt.Errorf("invalid client ID type")
 	}
 
 	client, err := s.clientService.Get(clientIDStr)
 	if err != nil {
-		log.Printf("Error getting client: %v", err)
-		return nil, fmt.Errorf("error getting client: %w", err)
+		log.Error("error getting client", "error", err)
+		return nil, nil, nil, nil, fmt.Errorf("error getting client: %w", err)
 	}
 
-	log.Printf("Client found: ID=%s, Name=%s %s", clientIDStr, client.Name, client.Lastname)
+	log.Debug("client found", "client_id", clientIDStr)
 
 	// Obtener la sale desde la base de datos
 	saleIDStr, ok := quota.SaleID.(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid sale ID type")
+		return nil, nil, nil, nil, fmt.Errorf("invalid sale ID type")
 	}
 
 	sale, err := s.saleService.GetByID(saleIDStr)
 	if err != nil {
-		log.Printf("Error getting sale: %v", err)
-		return nil, fmt.Errorf("error getting sale: %w", err)
+		log.Error("error getting sale", "error", err)
+		return nil, nil, nil, nil, fmt.Errorf("error getting sale: %w", err)
 	}
 
-	log.Printf("Sale found: ID=%s, Description=%s", saleIDStr, sale.Description)
+	log.Debug("sale found", "sale_id", saleIDStr)
 
-	// Generar el PDF
-	pdfContent, err := s.GeneratePaymentReceipt(payment, client, quota, sale)
+	return payment, client, quota, sale, nil
+}
+
+// GeneratePaymentTicket genera el comprobante de pago para ticketera térmica, en PDF angosto
+// o en comandos ESC/POS. Con width 0 se usa el ancho configurado en los datos del comercio.
+func (s *Service) GeneratePaymentTicket(ctx context.Context, paymentID string, format TicketFormat, width int) ([]byte, error) {
+	payment, client, quota, sale, err := s.loadPaymentReceipt(ctx, paymentID)
 	if err != nil {
-		log.Printf("Error generating PDF: %v", err)
 		return nil, err
 	}
 
-	log.Printf("PDF generated successfully, size: %d bytes", len(pdfContent))
-	return pdfContent, nil
+	content, err := s.generator.GeneratePaymentTicket(payment, client, quota, sale, format, width)
+	if err != nil {
+		logger.FromContext(ctx).Error("error generating payment ticket", "payment_id", paymentID, "format", format, "error", err)
+		return nil, err
+	}
+	return content, nil
+}
+
+// PrintPaymentTicket envía el comprobante de pago a la ticketera de red configurada
+func (s *Service) PrintPaymentTicket(ctx context.Context, paymentID string) error {
+	log := logger.FromContext(ctx).With("payment_id", paymentID)
+
+	_, printer := s.generator.ticketSettings()
+	if printer == "" {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "no ticket printer configured")
+	}
+
+	content, err := s.GeneratePaymentTicket(ctx, paymentID, TicketFormatESCPOS, 0)
+	if err != nil {
+		return err
+	}
+
+	if err := escpos.Print(ctx, printer, content); err != nil {
+		log.Error("error printing payment ticket", "printer", printer, "error", err)
+		return err
+	}
+
+	log.Info("payment ticket printed", "printer", printer, "bytes", len(content))
+	return nil
 }
 
 // GenerateDuplicate genera un duplicado del comprobante
@@ -113,6 +176,21 @@ func (s *Service) GenerateSaleSheetPDF(saleID int) ([]byte, error) {
 	return s.generator.GenerateSaleSheetPDF(saleID, s.saleService, s.clientService)
 }
 
+// GenerateSaleDocumentPDF genera el pagaré o el contrato de una venta
+func (s *Service) GenerateSaleDocumentPDF(saleID int, kind SaleDocumentKind) ([]byte, error) {
+	return s.generator.GenerateSaleDocumentPDF(saleID, kind, s.saleService, s.clientService)
+}
+
+// GenerateCollectionCardsPDF genera las tarjetas de cobro de las ventas indicadas
+func (s *Service) GenerateCollectionCardsPDF(saleIDs []int) ([]byte, error) {
+	return s.generator.GenerateCollectionCardsPDF(saleIDs, s.saleService, s.clientService)
+}
+
+// GenerateRouteSheetPDF genera la hoja de ruta de un cobrador
+func (s *Service) GenerateRouteSheetPDF(sheet *domain.RouteSheet) ([]byte, error) {
+	return s.generator.GenerateRouteSheetPDF(sheet)
+}
+
 // GenerateSalesBookPDF genera un libro de ventas con todas las ventas pendientes
 func (s *Service) GenerateSalesBookPDF() ([]byte, error) {
 	return s.generator.GenerateSalesBookPDF(s.saleService, s.clientService)
@@ -120,7 +198,12 @@ func (s *Service) GenerateSalesBookPDF() ([]byte, error) {
 
 // GenerateSalesBookPDFOptimized genera un libro de ventas usando pool de workers
 func (s *Service) GenerateSalesBookPDFOptimized() ([]byte, error) {
-	return s.generator.GenerateSalesBookPDFOptimized(s.saleService, s.clientService)
+	return s.generator.GenerateSalesBookPDFOptimized(context.Background(), s.saleService, s.clientService, nil)
+}
+
+// GenerateSalesBookWithProgress genera el libro de ventas informando el avance (usado por los reportes en segundo plano)
+func (s *Service) GenerateSalesBookWithProgress(ctx context.Context, progress func(domain.ReportProgress)) ([]byte, error) {
+	return s.generator.GenerateSalesBookPDFOptimized(ctx, s.saleService, s.clientService, progress)
 }
 
 // TODO: Agregar métodos para otros tipos de reportes
@@ -3,8 +3,10 @@ package pdf
 import (
 	"bytes"
 	"embed"
+	"errors"
 	"fmt"
 	"html/template"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -16,15 +18,15 @@ var templateFiles embed.FS
 
 // TemplateManager maneja la generación de templates HTML
 type TemplateManager struct {
+	// templatesDir permite reemplazar templates y estilos; lo que no esté ahí se lee de los embebidos
 	templatesDir string
-	useEmbed     bool
 }
 
-// NewTemplateManager crea una nueva instancia del gestor de templates
-func NewTemplateManager() *TemplateManager {
-	// Intentar usar archivos embebidos primero
+// NewTemplateManager crea una nueva instancia del gestor de templates.
+// Con templatesDir vacío se usan solo los templates embebidos.
+func NewTemplateManager(templatesDir string) *TemplateManager {
 	return &TemplateManager{
-		useEmbed: true,
+		templatesDir: templatesDir,
 	}
 }
 
@@ -123,6 +125,7 @@ func (tm *TemplateManager) GenerateSalesBookHTML(data SalesBookData) (string, er
 	for i, sale := range data.Sales {
 		// Convertir SaleEntry a SaleSheetData
 		saleData := SaleSheetData{
+			BaseData:            data.BaseData,
 			ClientName:          sale.ClientName,
 			ClientLastname:      sale.ClientLastname,
 			ClientInitials:      sale.ClientInitials,
@@ -135,6 +138,7 @@ func (tm *TemplateManager) GenerateSalesBookHTML(data SalesBookData) (string, er
 			QuotaPrice:          sale.QuotaPrice,
 			QuotaPriceFormatted: sale.QuotaPriceFormatted,
 			Quotas:              sale.Quotas,
+			Guarantors:          sale.Guarantors,
 		}
 
 		// Ejecutar el template para esta venta
@@ -162,46 +166,83 @@ func (tm *TemplateManager) GenerateSalesBookHTML(data SalesBookData) (string, er
 	return combinedHTML, nil
 }
 
-// readTemplateFile lee un archivo de template HTML
-func (tm *TemplateManager) readTemplateFile(filename string) (string, error) {
-	if tm.useEmbed {
-		filePath := fmt.Sprintf("templates/%s", filename)
-		log.Printf("Reading embedded template file: %s", filePath)
-		content, err := templateFiles.ReadFile(filePath)
-		if err != nil {
-			return "", fmt.Errorf("error reading embedded template file %s: %w", filePath, err)
-		}
-		return string(content), nil
+// GenerateCollectionCardsHTML genera el HTML de las tarjetas de cobro
+func (tm *TemplateManager) GenerateCollectionCardsHTML(data CollectionCardsData) (string, error) {
+	htmlTemplate, err := tm.readTemplateFile("collection_cards.html")
+	if err != nil {
+		return "", err
 	}
 
-	// Fallback a archivos del sistema
-	filePath := filepath.Join(tm.templatesDir, filename)
-	log.Printf("Reading template file: %s", filePath)
-	content, err := os.ReadFile(filePath)
+	commonCSS, err := tm.readCSSFile("styles/common.css")
 	if err != nil {
-		return "", fmt.Errorf("error reading template file %s: %w", filePath, err)
+		return "", err
 	}
-	return string(content), nil
+
+	finalHTML := tm.embedCSSInHTML(htmlTemplate, commonCSS, []string{"styles/common.css"})
+	return tm.executeTemplate("collection_cards", finalHTML, data)
+}
+
+// GenerateRouteSheetHTML genera el HTML de la hoja de ruta de un cobrador
+func (tm *TemplateManager) GenerateRouteSheetHTML(data RouteSheetData) (string, error) {
+	htmlTemplate, err := tm.readTemplateFile("route_sheet.html")
+	if err != nil {
+		return "", err
+	}
+
+	commonCSS, err := tm.readCSSFile("styles/common.css")
+	if err != nil {
+		return "", err
+	}
+
+	finalHTML := tm.embedCSSInHTML(htmlTemplate, commonCSS, []string{"styles/common.css"})
+	return tm.executeTemplate("route_sheet", finalHTML, data)
+}
+
+// GenerateSaleDocumentHTML genera el HTML de un documento de venta desde templates/documents/<kind>.html
+func (tm *TemplateManager) GenerateSaleDocumentHTML(data SaleDocumentData) (string, error) {
+	htmlTemplate, err := tm.readTemplateFile("documents/" + string(data.Kind) + ".html")
+	if err != nil {
+		return "", err
+	}
+
+	commonCSS, err := tm.readCSSFile("styles/common.css")
+	if err != nil {
+		return "", err
+	}
+
+	finalHTML := tm.embedCSSInHTML(htmlTemplate, commonCSS, []string{"styles/common.css"})
+	return tm.executeTemplate("sale_document_"+string(data.Kind), finalHTML, data)
+}
+
+// readTemplateFile lee un archivo de template HTML
+func (tm *TemplateManager) readTemplateFile(filename string) (string, error) {
+	return tm.readFile(filename)
 }
 
 // readCSSFile lee un archivo CSS
 func (tm *TemplateManager) readCSSFile(filename string) (string, error) {
-	if tm.useEmbed {
-		filePath := fmt.Sprintf("templates/%s", filename)
-		log.Printf("Reading embedded CSS file: %s", filePath)
-		content, err := templateFiles.ReadFile(filePath)
-		if err != nil {
-			return "", fmt.Errorf("error reading embedded CSS file %s: %w", filePath, err)
+	return tm.readFile(filename)
+}
+
+// readFile busca el archivo primero en templatesDir y si no está usa el embebido
+func (tm *TemplateManager) readFile(filename string) (string, error) {
+	if tm.templatesDir != "" {
+		filePath := filepath.Join(tm.templatesDir, filepath.FromSlash(filename))
+		content, err := os.ReadFile(filePath)
+		if err == nil {
+			log.Printf("Reading template override: %s", filePath)
+			return string(content), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("error reading template file %s: %w", filePath, err)
 		}
-		return string(content), nil
 	}
 
-	// Fallback a archivos del sistema
-	filePath := filepath.Join(tm.templatesDir, filename)
-	log.Printf("Reading CSS file: %s", filePath)
-	content, err := os.ReadFile(filePath)
+	filePath := fmt.Sprintf("templates/%s", filename)
+	log.Printf("Reading embedded template file: %s", filePath)
+	content, err := templateFiles.ReadFile(filePath)
 	if err != nil {
-		return "", fmt.Errorf("error reading CSS file %s: %w", filePath, err)
+		return "", fmt.Errorf("error reading embedded template file %s: %w", filePath, err)
 	}
 	return string(content), nil
 }
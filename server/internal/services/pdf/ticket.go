@@ -0,0 +1,278 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/escpos"
+	"github.com/go-pdf/fpdf"
+)
+
+// TicketFormat es la salida del comprobante para ticketera
+type TicketFormat string
+
+const (
+	TicketFormatPDF    TicketFormat = "pdf"    // PDF del ancho del papel, para imprimir desde el navegador
+	TicketFormatESCPOS TicketFormat = "escpos" // Comandos crudos para enviar a la impresora
+)
+
+// ticketColumns son los caracteres por línea de cada ancho de papel (fuente A de la impresora)
+var ticketColumns = map[int]int{
+	58: 32,
+	80: 48,
+}
+
+// defaultTicketWidth es el ancho que se usa si no se pueden leer los datos del comercio
+const defaultTicketWidth = 80
+
+// Medidas del PDF de ticket: Courier de 7.09pt ocupa 1.5mm por caracter, igual que la impresora
+const (
+	ticketCharWidth  = 1.5
+	ticketFontSize   = 7.09
+	ticketLineHeight = 3.5
+	ticketMarginY    = 4.0
+)
+
+// ticketLine es una línea del ticket; el mismo armado se usa para el PDF y para ESC/POS
+type ticketLine struct {
+	Text      string
+	Align     escpos.Align
+	Bold      bool
+	Large     bool   // Doble ancho y alto: entran la mitad de caracteres
+	Separator bool   // En el PDF se dibuja como línea punteada
+	QR        string // Contenido del QR (solo ESC/POS)
+}
+
+// GeneratePaymentTicket genera el comprobante de pago para ticketera en el formato pedido
+func (rg *ReportGenerator) GeneratePaymentTicket(payment *domain.Payment, client *domain.Client, quota *domain.Quota, sale *domain.Sale, format TicketFormat, width int) ([]byte, error) {
+	if width == 0 {
+		width, _ = rg.ticketSettings()
+	}
+	cols, ok := ticketColumns[width]
+	if !ok {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "width must be 58 or 80")
+	}
+
+	lines := paymentTicketLines(rg.paymentReceiptData(payment, client, quota, sale), cols)
+
+	switch format {
+	case TicketFormatPDF, "":
+		return renderTicketPDF(lines, width, cols)
+	case TicketFormatESCPOS:
+		return renderTicketESCPOS(lines), nil
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, "format must be pdf or escpos")
+	}
+}
+
+// ticketSettings devuelve el ancho de papel y la ticketera de red configurados
+func (rg *ReportGenerator) ticketSettings() (int, string) {
+	if rg.settings == nil {
+		return defaultTicketWidth, ""
+	}
+
+	settings, err := rg.settings.Get(context.Background())
+	if err != nil {
+		slog.Warn("error loading ticket settings", "error", err)
+		return defaultTicketWidth, ""
+	}
+
+	width := settings.TicketWidth
+	if _, ok := ticketColumns[width]; !ok {
+		width = defaultTicketWidth
+	}
+	return width, settings.TicketPrinter
+}
+
+// paymentTicketLines arma el comprobante de pago en líneas de cols caracteres
+func paymentTicketLines(data PaymentReceiptData, cols int) []ticketLine {
+	var lines []ticketLine
+	add := func(text string, align escpos.Align, bold, large bool) {
+		width := cols
+		if large {
+			width = cols / 2
+		}
+		for _, l := range wrapText(text, width) {
+			lines = append(lines, ticketLine{Text: l, Align: align, Bold: bold, Large: large})
+		}
+	}
+	separator := func() {
+		lines = append(lines, ticketLine{Text: strings.Repeat("-", cols), Separator: true})
+	}
+
+	// Empresa
+	add(data.Business.Name, escpos.AlignCenter, true, true)
+	for _, detail := range businessDetailParts(data.Business) {
+		add(detail, escpos.AlignCenter, false, false)
+	}
+	separator()
+
+	// Número y fecha
+	add("RECIBO N° "+data.ReceiptNumber, escpos.AlignCenter, true, false)
+	add("Fecha: "+data.Date.Format("02/01/2006"), escpos.AlignCenter, false, false)
+	separator()
+
+	// Campos
+	add("Recibí de: "+data.ClientName, escpos.AlignLeft, false, false)
+	if data.ClientDni != "" {
+		add("DNI: "+data.ClientDni, escpos.AlignLeft, false, false)
+	}
+	add("Concepto: "+data.SaleDescription, escpos.AlignLeft, false, false)
+	add(fmt.Sprintf("Cuota N°: %d", data.QuotaNumber), escpos.AlignLeft, false, false)
+	separator()
+
+	for _, l := range justifyText("TOTAL", "$"+data.AmountFormatted, cols) {
+		lines = append(lines, ticketLine{Text: l, Bold: true})
+	}
+	separator()
+
+	if data.Business.Footer != "" {
+		add(data.Business.Footer, escpos.AlignCenter, false, false)
+	}
+
+	// El QR permite verificar el comprobante con el celular
+	lines = append(lines, ticketLine{
+		Align: escpos.AlignCenter,
+		QR:    fmt.Sprintf("Recibo %s - $%s - %s", data.ReceiptNumber, data.AmountFormatted, data.Date.Format("02/01/2006")),
+	})
+
+	return lines
+}
+
+// renderTicketESCPOS convierte las líneas en comandos ESC/POS, terminando con el corte de papel
+func renderTicketESCPOS(lines []ticketLine) []byte {
+	b := escpos.NewBuilder()
+	for _, line := range lines {
+		b.Align(line.Align)
+		switch {
+		case line.QR != "":
+			b.Feed(1).QR(line.QR, 5)
+		default:
+			b.Bold(line.Bold).Large(line.Large).Line(line.Text).Large(false).Bold(false)
+		}
+	}
+	return b.Align(escpos.AlignLeft).Feed(4).Cut().Bytes()
+}
+
+// renderTicketPDF dibuja las líneas en una página del ancho del papel y el largo justo.
+// Siempre usa fpdf: wkhtmltopdf no maneja bien páginas tan angostas.
+func renderTicketPDF(lines []ticketLine, width, cols int) ([]byte, error) {
+	height := 2 * ticketMarginY
+	for _, line := range lines {
+		switch {
+		case line.QR != "":
+			// fpdf no genera QR; se imprime solo en ESC/POS
+		case line.Large:
+			height += 2 * ticketLineHeight
+		default:
+			height += ticketLineHeight
+		}
+	}
+
+	doc := fpdf.NewCustom(&fpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "mm",
+		Size:           fpdf.SizeType{Wd: float64(width), Ht: height},
+	})
+	marginX := (float64(width) - float64(cols)*ticketCharWidth) / 2
+	doc.SetMargins(marginX, ticketMarginY, marginX)
+	doc.SetAutoPageBreak(false, 0)
+	doc.SetTitle("Comprobante de Pago", true)
+	doc.SetCreator("GoStore", true)
+	doc.AddPage()
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	setTextColor(doc, colorText)
+
+	contentW := float64(cols) * ticketCharWidth
+	y := ticketMarginY
+	for _, line := range lines {
+		if line.QR != "" {
+			continue
+		}
+
+		doc.SetXY(marginX, y)
+		if line.Separator {
+			setDrawColor(doc, colorMuted)
+			doc.SetLineWidth(0.2)
+			doc.SetDashPattern([]float64{1, 1}, 0)
+			doc.Line(marginX, y+ticketLineHeight/2, marginX+contentW, y+ticketLineHeight/2)
+			doc.SetDashPattern([]float64{}, 0)
+			y += ticketLineHeight
+			continue
+		}
+
+		style, size, lineH := "", ticketFontSize, ticketLineHeight
+		if line.Bold {
+			style = "B"
+		}
+		if line.Large {
+			size, lineH = 2*ticketFontSize, 2*ticketLineHeight
+		}
+		doc.SetFont("Courier", style, size)
+		doc.CellFormat(contentW, lineH, tr(line.Text), "", 0, pdfAlign(line.Align), false, 0, "")
+		y += lineH
+	}
+
+	return outputNativeDocument(doc)
+}
+
+func pdfAlign(align escpos.Align) string {
+	switch align {
+	case escpos.AlignCenter:
+		return "C"
+	case escpos.AlignRight:
+		return "R"
+	default:
+		return "L"
+	}
+}
+
+// wrapText corta el texto en líneas de hasta width caracteres respetando las palabras
+func wrapText(text string, width int) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(text) {
+		// Palabras más largas que la línea se cortan a la fuerza
+		for utf8.RuneCountInString(word) > width {
+			if current != "" {
+				lines = append(lines, current)
+				current = ""
+			}
+			runes := []rune(word)
+			lines = append(lines, string(runes[:width]))
+			word = string(runes[width:])
+		}
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// justifyText pone left a la izquierda y right a la derecha de la misma línea; si no entran, usa dos
+func justifyText(left, right string, width int) []string {
+	gap := width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if gap >= 1 {
+		return []string{left + strings.Repeat(" ", gap) + right}
+	}
+
+	lines := wrapText(left, width)
+	pad := width - utf8.RuneCountInString(right)
+	if pad < 0 {
+		pad = 0
+	}
+	return append(lines, strings.Repeat(" ", pad)+right)
+}
@@ -1,13 +1,38 @@
 package pdf
 
 import (
+	"encoding/base64"
+	"html/template"
 	"time"
 )
 
 // BaseData contiene datos comunes para todos los reportes
 type BaseData struct {
-	GeneratedAt time.Time `json:"generated_at"`
-	ReportType  string    `json:"report_type"`
+	GeneratedAt time.Time    `json:"generated_at"`
+	ReportType  string       `json:"report_type"`
+	Business    BusinessInfo `json:"business"`
+}
+
+// BusinessInfo son los datos del comercio que se imprimen en los reportes
+type BusinessInfo struct {
+	Name      string `json:"name"`
+	LegalName string `json:"legal_name"`
+	CUIT      string `json:"cuit"`
+	Address   string `json:"address"`
+	Phone     string `json:"phone"`
+	Footer    string `json:"footer"`
+	Logo      []byte `json:"-"`
+	LogoMime  string `json:"-"`
+
+	ContractTerms string `json:"-"` // Condiciones de los contratos; vacío usa defaultContractTerms
+}
+
+// LogoURI devuelve el logo como data URI: wkhtmltopdf corre sin acceso a archivos locales
+func (b BusinessInfo) LogoURI() template.URL {
+	if len(b.Logo) == 0 {
+		return ""
+	}
+	return template.URL("data:" + b.LogoMime + ";base64," + base64.StdEncoding.EncodeToString(b.Logo))
 }
 
 // PaymentReceiptData contiene los datos específicos para comprobantes de pago
@@ -46,6 +71,95 @@ type SaleEntry struct {
 	QuotaPrice          float64
 	QuotaPriceFormatted string // Monto formateado para mostrar
 	Quotas              []QuotaRow
+	Guarantors          []PartyInfo
+}
+
+// CollectionCardsData contiene las tarjetas de cobro ya repartidas en páginas
+type CollectionCardsData struct {
+	BaseData
+	Pages [][]CollectionCard `json:"pages"`
+}
+
+// CollectionCard es la tarjeta que lleva el cobrador; si la venta tiene más cuotas
+// de las que entran, sigue en otra tarjeta (Part de Parts)
+type CollectionCard struct {
+	SaleID              int64
+	ClientName          string
+	ClientLastname      string
+	ClientDni           string
+	ClientPhone         string
+	ClientAddress       string
+	SaleDate            string
+	ProductDesc         string
+	NumQuotas           int
+	QuotaPriceFormatted string
+	Quotas              []QuotaRow
+	Part                int
+	Parts               int
+}
+
+// SaleDocumentData contiene los datos de los documentos que se firman con una venta (pagaré, contrato)
+type SaleDocumentData struct {
+	BaseData
+	Kind                SaleDocumentKind
+	Title               string
+	SaleID              int64
+	SaleDate            string
+	ProductDesc         string
+	Total               float64 // Suma de las cuotas
+	TotalFormatted      string
+	TotalInWords        string // En mayúsculas, como se escribe en el pagaré
+	NumQuotas           int
+	QuotaPriceFormatted string
+	DueDate             string // Vencimiento del pagaré: el de la última cuota
+	Client              PartyInfo
+	Guarantor           *PartyInfo // nil si la venta no tiene garante
+	Quotas              []QuotaRow
+	Terms               []string // Párrafos de las condiciones del contrato
+}
+
+// PartyInfo son los datos de una persona que firma un documento
+type PartyInfo struct {
+	Name         string
+	Dni          string
+	Phone        string
+	Address      string
+	Relationship string // Vínculo con el cliente (solo garantes)
+}
+
+// RouteSheetData es la hoja de ruta diaria de un cobrador
+type RouteSheetData struct {
+	BaseData
+	CollectorName  string
+	Date           string
+	Stops          []RouteStopRow
+	OverdueCount   int
+	DueTodayCount  int
+	TotalFormatted string
+}
+
+// RouteStopRow es un cliente a visitar con las cuotas que tiene que pagar
+type RouteStopRow struct {
+	Number         int // Orden de visita
+	ClientName     string
+	ClientLastname string
+	ClientDni      string
+	ClientPhone    string
+	ClientAddress  string
+	Zone           string
+	Quotas         []RouteQuotaRow
+	TotalFormatted string
+	Guarantors     []PartyInfo // Solo para clientes suspendidos
+}
+
+type RouteQuotaRow struct {
+	SaleID           int64
+	SaleDescription  string
+	Number           int64
+	DueDate          string
+	Status           string // "Vence hoy" o "N días de atraso"
+	Overdue          bool
+	BalanceFormatted string
 }
 
 // ReportConfig contiene configuración para la generación de reportes
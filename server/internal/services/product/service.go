@@ -6,4 +6,8 @@ import (
 
 type Service struct {
 	Repo ports.ProductRepository
+
+	// Opcionales: aviso de stock bajo al editar un producto
+	Events            ports.EventPublisher
+	LowStockThreshold int
 }
@@ -1,7 +1,9 @@
 package product
 
 import (
+	"context"
 	"errors"
+	"strconv"
 
 	"github.com/benitez96/gostore/internal/domain"
 )
@@ -20,5 +22,28 @@ func (s *Service) Update(id string, name string, cost, price float64, stock int)
 		return nil, errors.New("stock cannot be negative")
 	}
 
-	return s.Repo.Update(id, name, cost, price, stock)
+	// Stock anterior para detectar si con este cambio llegó al mínimo
+	previousStock := -1
+	if s.Events != nil {
+		if current, err := s.Repo.GetByID(id); err == nil {
+			previousStock = current.Stock
+		}
+	}
+
+	product, err := s.Repo.Update(id, name, cost, price, stock)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Events != nil && domain.CrossedLowStock(previousStock, stock, s.LowStockThreshold) {
+		productID, _ := strconv.ParseInt(id, 10, 64)
+		s.Events.Publish(context.Background(), domain.NewEvent(domain.EventProductLowStock, domain.ProductLowStockData{
+			ProductID: productID,
+			Name:      name,
+			Stock:     stock,
+			Threshold: s.LowStockThreshold,
+		}))
+	}
+
+	return product, nil
 }
@@ -0,0 +1,55 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// Dispatch envía los comprobantes pendientes cuyo próximo intento ya llegó
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+
+	deliveries, err := s.Repo.GetDue(ctx, time.Now(), dispatchBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+
+		target, err := s.Repo.GetTarget(ctx, delivery.PaymentID)
+		if errors.Is(err, domain.ErrNotFound) {
+			// El pago se borró entre que se encoló y ahora
+			delivery.Status = domain.ReceiptDeliveryStatusFailed
+			delivery.LastError = "payment not found"
+			if err := s.Repo.UpdateAttempt(ctx, delivery); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err != nil {
+			return sent, err
+		}
+
+		ok, err := s.deliver(ctx, delivery, target)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	if sent > 0 {
+		log.Info("payment receipts sent", "count", sent)
+	}
+
+	return sent, nil
+}
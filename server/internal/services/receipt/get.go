@@ -0,0 +1,28 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// GetByPaymentID devuelve el historial de envíos del comprobante de un pago
+func (s *Service) GetByPaymentID(ctx context.Context, paymentID int64) ([]*domain.ReceiptDelivery, error) {
+	return s.Repo.GetByPaymentID(ctx, paymentID)
+}
+
+// Retry vuelve a encolar un envío que agotó sus intentos
+func (s *Service) Retry(ctx context.Context, id int64) (*domain.ReceiptDelivery, error) {
+	if err := s.Repo.Retry(ctx, id, time.Now()); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewAppError(domain.ErrCodeNotFound,
+				fmt.Sprintf("failed receipt delivery with ID %d not found", id))
+		}
+		return nil, err
+	}
+
+	return s.Repo.GetByID(ctx, id)
+}
@@ -0,0 +1,131 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+const (
+	sendTimeout = time.Minute // Incluye la generación del PDF
+	// Reintentos: 1m, 2m, 4m... hasta 6h
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+)
+
+// Send registra el envío y lo intenta en el momento. Si falla, el envío queda
+// pendiente y el worker lo reintenta; el resultado se ve en el status devuelto.
+func (s *Service) Send(ctx context.Context, paymentID int64) (*domain.ReceiptDelivery, error) {
+	// El próximo intento queda reservado para después de este envío, así el worker no lo toma en paralelo
+	delivery, target, err := s.enqueue(ctx, paymentID, time.Now().Add(sendTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.deliver(ctx, delivery, target); err != nil {
+		return nil, err
+	}
+
+	return s.Repo.GetByID(ctx, delivery.ID)
+}
+
+// Enqueue deja el envío pendiente para el próximo Dispatch
+func (s *Service) Enqueue(ctx context.Context, paymentID int64) (*domain.ReceiptDelivery, error) {
+	delivery, _, err := s.enqueue(ctx, paymentID, time.Now())
+	return delivery, err
+}
+
+func (s *Service) enqueue(ctx context.Context, paymentID int64, nextAttempt time.Time) (*domain.ReceiptDelivery, *domain.ReceiptTarget, error) {
+	if s.Notifier == nil {
+		return nil, nil, domain.NewAppError(domain.ErrCodeInvalidParams,
+			"email delivery is not configured: set notify.smtp.host")
+	}
+
+	target, err := s.Repo.GetTarget(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.NewAppError(domain.ErrCodeNotFound,
+				fmt.Sprintf("payment with ID %d not found", paymentID))
+		}
+		return nil, nil, err
+	}
+	if target.Email == "" {
+		return nil, nil, domain.NewAppError(domain.ErrCodeInvalidParams,
+			fmt.Sprintf("client with ID %d has no email", target.ClientID))
+	}
+
+	delivery := &domain.ReceiptDelivery{
+		PaymentID:     paymentID,
+		ClientID:      target.ClientID,
+		Recipient:     target.Email,
+		NextAttemptAt: nextAttempt,
+	}
+	if err := s.Repo.Create(ctx, delivery); err != nil {
+		return nil, nil, err
+	}
+
+	return delivery, target, nil
+}
+
+// deliver hace un intento de envío, guarda el resultado e indica si se envió.
+// Solo devuelve error si no se pudo guardar el resultado.
+func (s *Service) deliver(ctx context.Context, delivery *domain.ReceiptDelivery, target *domain.ReceiptTarget) (bool, error) {
+	sendErr := s.send(ctx, delivery, target)
+	if sendErr == nil {
+		return true, s.Repo.MarkSent(ctx, delivery.ID, time.Now())
+	}
+
+	delivery.Attempts++
+	delivery.LastError = sendErr.Error()
+	delivery.NextAttemptAt = time.Now().Add(utils.Backoff(delivery.Attempts, baseBackoff, maxBackoff))
+	if delivery.Attempts >= s.Config.MaxAttempts {
+		delivery.Status = domain.ReceiptDeliveryStatusFailed
+	}
+	logger.FromContext(ctx).Warn("receipt delivery failed",
+		"delivery_id", delivery.ID,
+		"payment_id", delivery.PaymentID,
+		"attempt", delivery.Attempts,
+		"error", sendErr,
+	)
+
+	return false, s.Repo.UpdateAttempt(ctx, delivery)
+}
+
+func (s *Service) send(ctx context.Context, delivery *domain.ReceiptDelivery, target *domain.ReceiptTarget) error {
+	if s.Notifier == nil {
+		return errors.New("email delivery is not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	pdf, err := s.PDF.GeneratePaymentReceiptFromID(ctx, strconv.FormatInt(delivery.PaymentID, 10))
+	if err != nil {
+		return fmt.Errorf("generating receipt: %w", err)
+	}
+
+	return s.Notifier.Send(ctx, &domain.Message{
+		Channel: domain.ChannelEmail,
+		To:      delivery.Recipient,
+		Subject: "Comprobante de pago",
+		Body: fmt.Sprintf(
+			"Hola %s,\n\nTe enviamos adjunto el comprobante de tu pago de $%s del %s, correspondiente a la cuota %d de %s.\n\n¡Gracias!",
+			target.ClientName,
+			utils.FormatMoney(target.Amount),
+			target.Date.Format("02/01/2006"),
+			target.QuotaNumber,
+			target.SaleDescription,
+		),
+		Attachments: []domain.Attachment{{
+			Filename:    fmt.Sprintf("comprobante_pago_%d.pdf", delivery.PaymentID),
+			ContentType: "application/pdf",
+			Data:        pdf,
+		}},
+	})
+}
@@ -0,0 +1,22 @@
+package receipt
+
+import (
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the ReceiptService interface
+// at compile time.
+var _ ports.ReceiptService = &Service{}
+
+// dispatchBatch es la cantidad de comprobantes que se envían por corrida
+const dispatchBatch = 20
+
+// Service envía por email el comprobante PDF de un pago. Los envíos quedan
+// registrados en receipt_deliveries y los fallidos se reintentan con backoff.
+type Service struct {
+	Repo     ports.ReceiptRepository
+	PDF      ports.PDFService
+	Notifier ports.Notifier // Canal de email; nil si no está configurado
+	Config   config.ReceiptsConfig
+}
@@ -0,0 +1,38 @@
+package receipt
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunWorker envía los comprobantes pendientes cada DispatchInterval hasta que se cancele el contexto
+func (s *Service) RunWorker(ctx context.Context) {
+	interval := time.Duration(s.Config.DispatchInterval)
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	slog.Info("receipt worker started", "interval", interval.String(), "auto_send", s.Config.AutoSend)
+
+	s.runDispatch(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("receipt worker stopped")
+			return
+		case <-ticker.C:
+			s.runDispatch(ctx)
+		}
+	}
+}
+
+func (s *Service) runDispatch(ctx context.Context) {
+	if _, err := s.Dispatch(ctx); err != nil && ctx.Err() == nil {
+		slog.Error("receipt dispatch failed", "error", err)
+	}
+}
@@ -0,0 +1,98 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+const (
+	sendTimeout = 30 * time.Second
+	// Reintentos: 1m, 2m, 4m... hasta 6h
+	baseBackoff = time.Minute
+	maxBackoff  = 6 * time.Hour
+)
+
+// Dispatch envía los recordatorios pendientes cuyo próximo intento ya llegó.
+// Antes de enviar vuelve a verificar que la cuota siga impaga y que el cliente no haya pedido la baja.
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+
+	reminders, err := s.Repo.GetDue(ctx, time.Now(), dispatchBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		if err := ctx.Err(); err != nil {
+			return sent, err
+		}
+
+		target, err := s.Repo.GetTarget(ctx, reminder.QuotaID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return sent, err
+		}
+		if target == nil || target.IsPaid || target.OptOut {
+			reminder.Status = domain.ReminderStatusCancelled
+			if err := s.Repo.UpdateAttempt(ctx, reminder); err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		sendErr := s.send(ctx, reminder)
+		if sendErr == nil {
+			if err := s.Repo.MarkSent(ctx, reminder.ID, time.Now()); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		reminder.Attempts++
+		reminder.LastError = sendErr.Error()
+		reminder.NextAttemptAt = time.Now().Add(utils.Backoff(reminder.Attempts, baseBackoff, maxBackoff))
+		if reminder.Attempts >= s.Config.MaxAttempts {
+			reminder.Status = domain.ReminderStatusFailed
+		}
+		log.Warn("reminder send failed",
+			"reminder_id", reminder.ID,
+			"channel", reminder.Channel,
+			"attempt", reminder.Attempts,
+			"error", sendErr,
+		)
+
+		if err := s.Repo.UpdateAttempt(ctx, reminder); err != nil {
+			return sent, err
+		}
+	}
+
+	if sent > 0 {
+		log.Info("reminders sent", "count", sent)
+	}
+
+	return sent, nil
+}
+
+func (s *Service) send(ctx context.Context, reminder *domain.Reminder) error {
+	notifier, ok := s.Notifiers[reminder.Channel]
+	if !ok {
+		return fmt.Errorf("no notifier configured for channel %s", reminder.Channel)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	return notifier.Send(ctx, &domain.Message{
+		Channel: reminder.Channel,
+		To:      reminder.Recipient,
+		Subject: reminder.Subject,
+		Body:    reminder.Body,
+	})
+}
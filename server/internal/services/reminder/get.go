@@ -0,0 +1,53 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func (s *Service) GetAll(ctx context.Context, status string, limit, offset int) ([]*domain.Reminder, error) {
+	switch status {
+	case "", domain.ReminderStatusPending, domain.ReminderStatusSent, domain.ReminderStatusFailed, domain.ReminderStatusCancelled:
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("invalid status: %s", status))
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 50
+	}
+	return s.Repo.GetAll(ctx, status, limit, max(offset, 0))
+}
+
+// GetByQuotaID devuelve el historial de recordatorios de una cuota
+func (s *Service) GetByQuotaID(ctx context.Context, quotaID int64) ([]*domain.Reminder, error) {
+	return s.Repo.GetByQuotaID(ctx, quotaID)
+}
+
+// Retry vuelve a encolar un recordatorio que agotó sus intentos
+func (s *Service) Retry(ctx context.Context, id int64) (*domain.Reminder, error) {
+	if err := s.Repo.Retry(ctx, id, time.Now()); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewAppError(domain.ErrCodeNotFound,
+				fmt.Sprintf("failed reminder with ID %d not found", id))
+		}
+		return nil, err
+	}
+
+	return s.Repo.GetByID(ctx, id)
+}
+
+// SetClientOptOut activa o desactiva los recordatorios de un cliente
+func (s *Service) SetClientOptOut(ctx context.Context, clientID int64, optOut bool) error {
+	if err := s.Repo.SetClientOptOut(ctx, clientID, optOut); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewAppError(domain.ErrCodeNotFound,
+				fmt.Sprintf("client with ID %d not found", clientID))
+		}
+		return err
+	}
+
+	return nil
+}
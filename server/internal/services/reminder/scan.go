@@ -0,0 +1,118 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+// Scan busca cuotas impagas cuyo recordatorio corresponde hoy y los encola una vez por canal.
+// Los recordatorios que ya están en el outbox no se vuelven a encolar.
+func (s *Service) Scan(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+
+	if len(s.Notifiers) == 0 {
+		return 0, nil
+	}
+
+	quotas, err := s.Repo.GetUnpaidQuotas(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	today := utils.StartOfDay(now)
+	enqueued := 0
+
+	for _, quota := range quotas {
+		if quota.DueDate == nil {
+			continue
+		}
+		offset, ok := s.offsetDueToday(utils.StartOfDay(*quota.DueDate), today)
+		if !ok {
+			continue
+		}
+
+		quotaID := quota.ID.(int64)
+		target, err := s.Repo.GetTarget(ctx, quotaID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+			return enqueued, err
+		}
+		if target.OptOut {
+			continue
+		}
+
+		subject, body, err := s.render(target, offset, today)
+		if err != nil {
+			// Una plantilla rota afecta a todas las cuotas: se corta acá
+			return enqueued, err
+		}
+
+		for channel := range s.Notifiers {
+			recipient := target.Recipient(channel)
+			if recipient == "" {
+				continue
+			}
+
+			inserted, err := s.Repo.Enqueue(ctx, &domain.Reminder{
+				QuotaID:       target.QuotaID,
+				ClientID:      target.ClientID,
+				Channel:       channel,
+				OffsetDays:    offset,
+				Recipient:     recipient,
+				Subject:       subject,
+				Body:          body,
+				NextAttemptAt: now,
+			})
+			if err != nil {
+				return enqueued, err
+			}
+			if inserted {
+				enqueued++
+			}
+		}
+	}
+
+	if enqueued > 0 {
+		log.Info("reminders enqueued", "count", enqueued)
+	}
+
+	return enqueued, nil
+}
+
+// offsetDueToday devuelve el recordatorio que toca hoy para una cuota que vence en dueDate:
+// negativo para los días antes del vencimiento, positivo para los días de atraso.
+// Si por el margen para ponerse al día corresponden varios, se manda solo el más cercano
+// a hoy; los anteriores quedan descartados para no mandar avisos contradictorios.
+func (s *Service) offsetDueToday(dueDate, today time.Time) (int, bool) {
+	best, found := 0, false
+
+	consider := func(offset int) {
+		target := dueDate.AddDate(0, 0, offset)
+		if today.Before(target) || !today.Before(target.AddDate(0, 0, catchUpDays)) {
+			return
+		}
+		if !found || offset > best {
+			best, found = offset, true
+		}
+	}
+
+	// Un "vence en N días" no tiene sentido si la cuota ya venció
+	if today.Before(dueDate) {
+		for _, days := range s.Config.DaysBefore {
+			consider(-days)
+		}
+	}
+	for _, days := range s.Config.DaysAfter {
+		consider(days)
+	}
+
+	return best, found
+}
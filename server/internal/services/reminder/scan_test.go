@@ -0,0 +1,70 @@
+package reminder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func TestOffsetDueToday(t *testing.T) {
+	s := &Service{Config: config.RemindersConfig{DaysBefore: []int{3, 1}, DaysAfter: []int{1, 7}}}
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.Local) }
+	due := day(10)
+
+	tests := []struct {
+		name   string
+		today  time.Time
+		want   int
+		wantOK bool
+	}{
+		{name: "antes de cualquier aviso", today: day(5)},
+		{name: "tres días antes", today: day(7), want: -3, wantOK: true},
+		{name: "el de tres días puesto al día", today: day(8), want: -3, wantOK: true},
+		{name: "un día antes gana al de tres días", today: day(9), want: -1, wantOK: true},
+		{name: "el día del vencimiento", today: day(10)},
+		{name: "un día de atraso", today: day(11), want: 1, wantOK: true},
+		{name: "fuera del margen para ponerse al día", today: day(14)},
+		{name: "una semana de atraso", today: day(17), want: 7, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := s.offsetDueToday(due, tt.today)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got (%d, %v), want (%d, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRenderUsesDaysFromToday(t *testing.T) {
+	s := &Service{}
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.Local) }
+	target := &domain.ReminderTarget{ClientName: "Ana", QuotaNumber: 2, Amount: 1000, DueDate: day(10)}
+
+	tests := []struct {
+		name   string
+		offset int
+		today  time.Time
+		want   string
+	}{
+		{name: "a tiempo", offset: -3, today: day(7), want: "(en 3 días)"},
+		{name: "aviso previo puesto al día", offset: -3, today: day(8), want: "(en 2 días)"},
+		{name: "atraso puesto al día", offset: 1, today: day(12), want: "(2 días de atraso)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, body, err := s.render(target, tt.offset, tt.today)
+			if err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			if !strings.Contains(body, tt.want) {
+				t.Errorf("body %q does not contain %q", body, tt.want)
+			}
+		})
+	}
+}
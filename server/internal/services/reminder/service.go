@@ -0,0 +1,25 @@
+package reminder
+
+import (
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the ReminderService interface
+// at compile time.
+var _ ports.ReminderService = &Service{}
+
+const (
+	// catchUpDays permite encolar un recordatorio atrasado si el servidor estuvo apagado el día que correspondía
+	catchUpDays = 3
+	// dispatchBatch es la cantidad de recordatorios que se envían por corrida
+	dispatchBatch = 50
+)
+
+// Service encola recordatorios de vencimiento (Scan) y los envía por los
+// notifiers configurados (Dispatch), con reintentos y backoff exponencial.
+type Service struct {
+	Repo      ports.ReminderRepository
+	Notifiers map[string]ports.Notifier // Indexados por canal (email, whatsapp, sms)
+	Config    config.RemindersConfig
+}
@@ -0,0 +1,87 @@
+package reminder
+
+import (
+	"bytes"
+	"math"
+	"text/template"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+// Plantillas de fábrica; se pueden reemplazar desde reminders.templates en la configuración
+const (
+	defaultUpcomingSubject = "Recordatorio: tu cuota {{.QuotaNumber}} vence el {{.DueDate}}"
+	defaultUpcomingBody    = "Hola {{.ClientName}}, te recordamos que la cuota {{.QuotaNumber}} de {{.SaleDescription}} " +
+		"por ${{.Balance}} vence el {{.DueDate}} (en {{.Days}} días). ¡Gracias!"
+	defaultOverdueSubject = "Tu cuota {{.QuotaNumber}} está vencida"
+	defaultOverdueBody    = "Hola {{.ClientName}}, la cuota {{.QuotaNumber}} de {{.SaleDescription}} venció el {{.DueDate}} " +
+		"y tiene un saldo de ${{.Balance}} ({{.Days}} días de atraso). Si ya pagaste, ignorá este mensaje."
+)
+
+// templateData son los campos disponibles en las plantillas
+type templateData struct {
+	ClientName      string
+	ClientLastname  string
+	QuotaNumber     int64
+	Amount          string
+	Balance         string
+	DueDate         string
+	Days            int
+	SaleDescription string
+}
+
+// render arma asunto y cuerpo del recordatorio para la cuota. Days es la distancia real
+// entre today y el vencimiento: un aviso que se pone al día no coincide con su offset.
+func (s *Service) render(target *domain.ReminderTarget, offsetDays int, today time.Time) (string, string, error) {
+	subject, body := s.Config.Templates.OverdueSubject, s.Config.Templates.OverdueBody
+	defaultSubject, defaultBody := defaultOverdueSubject, defaultOverdueBody
+	days := int(math.Round(today.Sub(utils.StartOfDay(target.DueDate)).Hours() / 24))
+	if offsetDays < 0 {
+		subject, body = s.Config.Templates.UpcomingSubject, s.Config.Templates.UpcomingBody
+		defaultSubject, defaultBody = defaultUpcomingSubject, defaultUpcomingBody
+		days = -days
+	}
+	if subject == "" {
+		subject = defaultSubject
+	}
+	if body == "" {
+		body = defaultBody
+	}
+
+	data := templateData{
+		ClientName:      target.ClientName,
+		ClientLastname:  target.ClientLastname,
+		QuotaNumber:     target.QuotaNumber,
+		Amount:          utils.FormatMoney(target.Amount),
+		Balance:         utils.FormatMoney(max(target.Amount-target.PaidAmount, 0)),
+		DueDate:         target.DueDate.In(time.Local).Format("02/01/2006"),
+		Days:            days,
+		SaleDescription: target.SaleDescription,
+	}
+
+	renderedSubject, err := execute("subject", subject, data)
+	if err != nil {
+		return "", "", err
+	}
+	renderedBody, err := execute("body", body, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return renderedSubject, renderedBody, nil
+}
+
+func execute(name, text string, data templateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
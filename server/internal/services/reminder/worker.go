@@ -0,0 +1,62 @@
+package reminder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunWorker busca recordatorios cada ScanInterval y envía los pendientes cada DispatchInterval
+// hasta que se cancele el contexto
+func (s *Service) RunWorker(ctx context.Context) {
+	scanInterval := time.Duration(s.Config.ScanInterval)
+	if scanInterval <= 0 {
+		scanInterval = time.Hour
+	}
+	dispatchInterval := time.Duration(s.Config.DispatchInterval)
+	if dispatchInterval <= 0 {
+		dispatchInterval = time.Minute
+	}
+
+	channels := make([]string, 0, len(s.Notifiers))
+	for channel := range s.Notifiers {
+		channels = append(channels, channel)
+	}
+	slog.Info("reminder worker started",
+		"channels", channels,
+		"scan_interval", scanInterval.String(),
+		"dispatch_interval", dispatchInterval.String(),
+	)
+
+	s.runScan(ctx)
+	s.runDispatch(ctx)
+
+	scanTicker := time.NewTicker(scanInterval)
+	defer scanTicker.Stop()
+	dispatchTicker := time.NewTicker(dispatchInterval)
+	defer dispatchTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("reminder worker stopped")
+			return
+		case <-scanTicker.C:
+			s.runScan(ctx)
+		case <-dispatchTicker.C:
+			s.runDispatch(ctx)
+		}
+	}
+}
+
+func (s *Service) runScan(ctx context.Context) {
+	if _, err := s.Scan(ctx); err != nil && ctx.Err() == nil {
+		slog.Error("reminder scan failed", "error", err)
+	}
+}
+
+func (s *Service) runDispatch(ctx context.Context) {
+	if _, err := s.Dispatch(ctx); err != nil && ctx.Err() == nil {
+		slog.Error("reminder dispatch failed", "error", err)
+	}
+}
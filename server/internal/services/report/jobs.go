@@ -0,0 +1,198 @@
+package report
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// Submit registra el job y lo lanza en segundo plano; si no hay lugar libre queda en cola
+func (s *Service) Submit(ctx context.Context, reportType, requestedBy string) (*domain.ReportJob, error) {
+	if !slices.Contains(domain.ReportTypes, reportType) {
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("unknown report type %q", reportType))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.init()
+
+	if s.stopped {
+		return nil, errors.New("report service is shutting down")
+	}
+
+	active := 0
+	for _, j := range s.jobs {
+		if j.Status == domain.ReportJobStatusQueued || j.Status == domain.ReportJobStatusRunning {
+			active++
+		}
+	}
+	if active >= cap(s.slots)+s.Config.MaxQueued {
+		return nil, domain.NewAppError(domain.ErrCodeTooManyRequests, "too many reports in progress, try again later")
+	}
+
+	jobCtx, cancel := context.WithCancel(context.Background())
+	j := &job{
+		ReportJob: domain.ReportJob{
+			ID:          generateJobID(),
+			Type:        reportType,
+			Status:      domain.ReportJobStatusQueued,
+			RequestedBy: requestedBy,
+			CreatedAt:   time.Now(),
+		},
+		cancel: cancel,
+	}
+	s.jobs[j.ID] = j
+
+	s.running.Add(1)
+	go s.run(jobCtx, j)
+
+	slog.Info("report job queued", "job_id", j.ID, "type", reportType, "requested_by", requestedBy)
+
+	snapshot := j.ReportJob
+	return &snapshot, nil
+}
+
+// Get devuelve el estado actual del job
+func (s *Service) Get(ctx context.Context, id string) (*domain.ReportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil, notFound(id)
+	}
+
+	snapshot := j.ReportJob
+	return &snapshot, nil
+}
+
+// Open abre el PDF de un job terminado
+func (s *Service) Open(ctx context.Context, id string) (io.ReadCloser, *domain.ReportJob, error) {
+	s.mu.Lock()
+	j, ok := s.jobs[id]
+	if !ok {
+		s.mu.Unlock()
+		return nil, nil, notFound(id)
+	}
+	snapshot := j.ReportJob
+	path := j.path
+	s.mu.Unlock()
+
+	if snapshot.Status != domain.ReportJobStatusDone {
+		return nil, nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("report job %s is %s", id, snapshot.Status))
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, notFound(id)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return file, &snapshot, nil
+}
+
+// run espera un lugar libre, genera el reporte y guarda el PDF en disco
+func (s *Service) run(ctx context.Context, j *job) {
+	defer s.running.Done()
+	defer j.cancel()
+
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		s.finish(j, "", 0, ctx.Err())
+		return
+	}
+
+	s.mu.Lock()
+	now := time.Now()
+	j.Status = domain.ReportJobStatusRunning
+	j.StartedAt = &now
+	s.mu.Unlock()
+
+	content, err := s.generate(ctx, j)
+	if err != nil {
+		s.finish(j, "", 0, err)
+		return
+	}
+
+	path, err := s.store(j.ID, content)
+	s.finish(j, path, len(content), err)
+}
+
+func (s *Service) generate(ctx context.Context, j *job) ([]byte, error) {
+	progress := func(p domain.ReportProgress) {
+		s.mu.Lock()
+		j.Processed, j.Total, j.Errors = p.Processed, p.Total, p.Errors
+		s.mu.Unlock()
+	}
+
+	switch j.Type {
+	case domain.ReportTypeSalesBook:
+		return s.Generator.GenerateSalesBookWithProgress(ctx, progress)
+	default:
+		return nil, fmt.Errorf("unknown report type %q", j.Type)
+	}
+}
+
+func (s *Service) store(id string, content []byte) (string, error) {
+	if err := os.MkdirAll(s.Config.Dir, 0o750); err != nil {
+		return "", fmt.Errorf("error creating reports dir: %w", err)
+	}
+
+	path := filepath.Join(s.Config.Dir, id+".pdf")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return "", fmt.Errorf("error writing report: %w", err)
+	}
+	return path, nil
+}
+
+// finish marca el job como terminado; el resultado (o el error) vence a los ResultTTL
+func (s *Service) finish(j *job, path string, size int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(s.Config.ResultTTL))
+	j.FinishedAt = &now
+	j.ExpiresAt = &expiresAt
+
+	if err != nil {
+		j.Status = domain.ReportJobStatusFailed
+		j.Error = err.Error()
+		slog.Error("report job failed", "job_id", j.ID, "type", j.Type, "error", err)
+		return
+	}
+
+	j.Status = domain.ReportJobStatusDone
+	j.path = path
+	j.Size = size
+
+	var duration time.Duration
+	if j.StartedAt != nil {
+		duration = now.Sub(*j.StartedAt)
+	}
+	slog.Info("report job finished", "job_id", j.ID, "type", j.Type, "bytes", size, "errors", j.Errors, "duration", duration.String())
+}
+
+func generateJobID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return "rep_" + hex.EncodeToString(b)
+}
+
+func notFound(id string) error {
+	return domain.NewAppError(domain.ErrCodeNotFound, fmt.Sprintf("report job %s not found", id))
+}
@@ -0,0 +1,47 @@
+package report
+
+import (
+	"context"
+	"sync"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the ReportJobService interface
+// at compile time.
+var _ ports.ReportJobService = &Service{}
+
+// Service genera reportes PDF en segundo plano. Los jobs viven en memoria y
+// el PDF resultante se guarda en Config.Dir hasta que vence (Config.ResultTTL).
+type Service struct {
+	Generator ports.ReportGenerator
+	Config    config.ReportsConfig
+
+	mu      sync.Mutex
+	jobs    map[string]*job
+	slots   chan struct{} // Limita los reportes que se generan a la vez
+	running sync.WaitGroup
+	stopped bool
+}
+
+// job acompaña al ReportJob con lo que no se expone por la API
+type job struct {
+	domain.ReportJob
+	path   string
+	cancel context.CancelFunc
+}
+
+// init crea el estado interno en el primer uso; se llama con mu tomado
+func (s *Service) init() {
+	if s.jobs != nil {
+		return
+	}
+	s.jobs = make(map[string]*job)
+	limit := s.Config.MaxConcurrent
+	if limit < 1 {
+		limit = 1
+	}
+	s.slots = make(chan struct{}, limit)
+}
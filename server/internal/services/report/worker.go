@@ -0,0 +1,77 @@
+package report
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cleanupInterval es cada cuánto se borran los reportes vencidos
+const cleanupInterval = time.Minute
+
+// RunWorker borra los reportes vencidos hasta que se cancele el contexto.
+// Al terminar cancela los reportes en curso y espera a que se detengan.
+func (s *Service) RunWorker(ctx context.Context) {
+	slog.Info("report worker started", "dir", s.Config.Dir, "max_concurrent", s.Config.MaxConcurrent, "result_ttl", time.Duration(s.Config.ResultTTL).String())
+
+	// Los jobs viven en memoria: los PDFs de una ejecución anterior ya no se pueden descargar
+	s.removeOrphans()
+
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.stop()
+			slog.Info("report worker stopped")
+			return
+		case <-ticker.C:
+			s.cleanup(time.Now())
+		}
+	}
+}
+
+// cleanup olvida los jobs vencidos y borra sus archivos
+func (s *Service) cleanup(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, j := range s.jobs {
+		if j.ExpiresAt == nil || j.ExpiresAt.After(now) {
+			continue
+		}
+		if j.path != "" {
+			if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+				slog.Error("error removing expired report", "job_id", id, "error", err)
+			}
+		}
+		delete(s.jobs, id)
+	}
+}
+
+func (s *Service) removeOrphans() {
+	paths, err := filepath.Glob(filepath.Join(s.Config.Dir, "rep_*.pdf"))
+	if err != nil {
+		return
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil {
+			slog.Error("error removing stale report", "path", path, "error", err)
+		}
+	}
+}
+
+// stop rechaza nuevos pedidos y cancela los que están en cola o generándose
+func (s *Service) stop() {
+	s.mu.Lock()
+	s.stopped = true
+	for _, j := range s.jobs {
+		j.cancel()
+	}
+	s.mu.Unlock()
+
+	s.running.Wait()
+}
@@ -1,22 +1,114 @@
 package sale
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
+	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/ports"
+	"github.com/benitez96/gostore/internal/shared/logger"
 )
 
-func (s Service) Create(dto *dto.CreateSaleDto) (int64, error) {
-	saleID, err := s.Sr.CreateSaleWithProductsAndQuotas(dto)
+func (s Service) Create(ctx context.Context, saleDto *dto.CreateSaleDto) (*dto.CreateSaleResponse, error) {
+	log := logger.FromContext(ctx)
+
+	// Reglas de crédito sobre lo financiado; un encargado las puede saltear indicando el motivo.
+	// Se vuelven a controlar dentro de la transacción que crea la venta.
+	var credit ports.SaleCreditGuard
+	if s.Credit != nil {
+		var err error
+		credit, err = s.Credit.Authorize(ctx, int64(saleDto.ClientID), float64(saleDto.Quotas)*saleDto.QuotaPrice, saleDto.OverrideReason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	saleID, err := s.Sr.CreateSaleWithProductsAndQuotas(saleDto, credit)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	// Actualizar estados después de crear la venta y sus cuotas
+	if credit != nil && credit.Override() != nil {
+		override := credit.Override()
+		log.Warn("credit rules overridden",
+			"sale_id", saleID,
+			"client_id", override.ClientID,
+			"user", override.UserName,
+			"reason", override.Reason,
+			"violations", strings.Join(override.Violations, "; "),
+		)
+	}
+
+	// Actualizar estados después de crear la venta y sus cuotas; si falla la venta ya quedó creada
+	// y el worker de estados la corrige en su próxima pasada
 	if err := s.StateUpdater.UpdateSaleStateAndPropagate(fmt.Sprintf("%d", saleID)); err != nil {
-		// Log el error pero no fallar la creación
-		// TODO: Agregar logging aquí
+		log.Error("sale state not updated", "sale_id", saleID, "error", err)
+	}
+
+	if s.Events != nil {
+		// La venta ya quedó creada: los suscriptores corren aunque el cliente corte el request
+		eventCtx := context.WithoutCancel(ctx)
+		s.Events.Publish(eventCtx, domain.NewEvent(domain.EventSaleCreated, domain.SaleEventData{
+			ID:         saleID,
+			ClientID:   int64(saleDto.ClientID),
+			Amount:     saleDto.Amount,
+			Date:       saleDto.Date,
+			Quotas:     saleDto.Quotas,
+			QuotaPrice: saleDto.QuotaPrice,
+		}))
+		s.publishLowStock(eventCtx, saleDto.Products)
+	}
+
+	response := &dto.CreateSaleResponse{ID: saleID, Warnings: []string{}}
+	if warning := s.riskWarning(ctx, saleDto.ClientID); warning != "" {
+		response.Warnings = append(response.Warnings, warning)
+	}
+
+	return response, nil
+}
+
+// riskWarning avisa, sin bloquear la venta, si el cliente tiene un puntaje de riesgo alto
+func (s Service) riskWarning(ctx context.Context, clientID int) string {
+	client, err := s.ClientRepo.Get(fmt.Sprintf("%d", clientID))
+	if err != nil {
+		logger.FromContext(ctx).Warn("risk score check failed", "client_id", clientID, "error", err)
+		return ""
 	}
+	if client.RiskScore < domain.RiskHighScore {
+		return ""
+	}
+	return fmt.Sprintf("client has a high risk score (%d/100) based on their payment history", client.RiskScore)
+}
 
-	return saleID, nil
+// publishLowStock avisa de los productos que con esta venta llegaron al stock mínimo
+func (s Service) publishLowStock(ctx context.Context, products []*dto.ProductDto) {
+	if s.Products == nil {
+		return
+	}
+
+	sold := make(map[int64]int)
+	for _, p := range products {
+		if p.ID != 0 {
+			sold[p.ID] += p.Quantity
+		}
+	}
+
+	for productID, quantity := range sold {
+		product, err := s.Products.GetByID(fmt.Sprintf("%d", productID))
+		if err != nil {
+			logger.FromContext(ctx).Warn("low stock check failed", "product_id", productID, "error", err)
+			continue
+		}
+
+		if domain.CrossedLowStock(product.Stock+quantity, product.Stock, s.LowStockThreshold) {
+			s.Events.Publish(ctx, domain.NewEvent(domain.EventProductLowStock, domain.ProductLowStockData{
+				ProductID: productID,
+				Name:      product.Name,
+				Stock:     product.Stock,
+				Threshold: s.LowStockThreshold,
+			}))
+		}
+	}
 }
@@ -1,8 +1,10 @@
 package sale
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/utils"
 )
 
@@ -18,6 +20,13 @@ func (s *Service) Delete(saleID string) error {
 		return err
 	}
 
+	if s.Events != nil {
+		s.Events.Publish(context.Background(), domain.NewEvent(domain.EventSaleDeleted, domain.SaleDeletedData{
+			ID:       sale.ID.(int64),
+			ClientID: sale.ClientID.(int64),
+		}))
+	}
+
 	// Get all remaining sales for this client to check their states
 	clientSales, err := s.Sr.GetByClientID(fmt.Sprintf("%d", sale.ClientID))
 	if err != nil {
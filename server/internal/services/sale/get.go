@@ -1,6 +1,7 @@
 package sale
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync"
@@ -105,6 +106,15 @@ func (s Service) GetByID(id string) (*domain.Sale, error) {
 	}
 	pwg.Wait()
 
+	if s.Guarantors != nil {
+		saleID, _ := strconv.ParseInt(id, 10, 64)
+		bySale, err := s.Guarantors.GetBySaleIDs(context.Background(), []int64{saleID})
+		if err != nil {
+			return nil, err
+		}
+		sale.Guarantors = bySale[saleID]
+	}
+
 	// Armar resultado final
 	sale.Products = products
 	sale.Quotas = quotas
@@ -17,6 +17,17 @@ type Service struct {
 	Pr           ports.PaymentRepository
 	ClientRepo   ports.ClientRepository
 	StateUpdater *stateUpdater.Service
+
+	// Opcionales: eventos de venta creada, borrada y de stock bajo
+	Events            ports.EventPublisher
+	Products          ports.ProductRepository
+	LowStockThreshold int
+
+	// Opcional: garantes de la venta en GetByID
+	Guarantors ports.GuarantorRepository
+
+	// Opcional: límites de crédito y reglas por estado al crear una venta
+	Credit ports.CreditService
 }
 
 func NewService(sr ports.SaleRepository, spr ports.SaleProductRepository, qr ports.QuotaRepository, pr ports.PaymentRepository, clientRepo ports.ClientRepository, stateUpdater *stateUpdater.Service) *Service {
@@ -1,6 +1,7 @@
 package state_updater
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,6 +15,7 @@ type Service struct {
 	SaleRepo    ports.SaleRepository
 	ClientRepo  ports.ClientRepository
 	PaymentRepo ports.PaymentRepository
+	Events      ports.EventPublisher // Opcional: publica client.state_changed
 }
 
 // safeToString safely converts an interface{} value to string
@@ -173,8 +175,29 @@ func (s *Service) updateClientState(clientID string) error {
 	// Determinar el estado del cliente basándose en sus ventas
 	newStateID := utils.DetermineClientState(sales)
 
+	// Estado anterior, solo hace falta para avisar si cambió
+	previousStateID := 0
+	if s.Events != nil {
+		if client, err := s.ClientRepo.Get(clientID); err == nil && client.State != nil {
+			previousStateID, _ = strconv.Atoi(safeToString(client.State.ID))
+		}
+	}
+
 	// Actualizar el estado del cliente
-	return s.ClientRepo.UpdateState(clientID, newStateID)
+	if err := s.ClientRepo.UpdateState(clientID, newStateID); err != nil {
+		return err
+	}
+
+	if s.Events != nil && previousStateID != 0 && previousStateID != newStateID {
+		id, _ := strconv.ParseInt(clientID, 10, 64)
+		s.Events.Publish(context.Background(), domain.NewEvent(domain.EventClientStateChanged, domain.ClientStateChangedData{
+			ClientID:        id,
+			PreviousStateID: previousStateID,
+			StateID:         newStateID,
+		}))
+	}
+
+	return nil
 }
 
 // determineSaleState determina el estado de una venta basándose en sus cuotas
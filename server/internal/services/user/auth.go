@@ -10,6 +10,7 @@ import (
 
 	"github.com/benitez96/gostore/internal/domain"
 	"github.com/benitez96/gostore/internal/dto"
+	"github.com/benitez96/gostore/internal/shared/logger"
 )
 
 func (s Service) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
@@ -76,7 +77,7 @@ func (s Service) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginRe
 		err = s.Repo.UpdateUserLastLogin(ctx, user.ID)
 		if err != nil {
 			// No fallar el login por esto, solo loguearlo
-			fmt.Printf("Failed to update last login for user %d: %v\n", user.ID, err)
+			logger.FromContext(ctx).Warn("failed to update last login", "user_id", user.ID, "error", err)
 		}
 
 		// Login exitoso con token
@@ -99,7 +100,7 @@ func (s Service) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginRe
 	err = s.Repo.UpdateUserLastLogin(ctx, user.ID)
 	if err != nil {
 		// No fallar el login por esto, solo loguearlo
-		fmt.Printf("Failed to update last login for user %d: %v\n", user.ID, err)
+		logger.FromContext(ctx).Warn("failed to update last login", "user_id", user.ID, "error", err)
 	}
 
 	// Login exitoso sin token (backward compatibility)
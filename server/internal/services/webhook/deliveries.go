@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+// GetDeliveries devuelve el log de entregas de una suscripción, opcionalmente filtrado por status
+func (s *Service) GetDeliveries(ctx context.Context, subscriptionID int64, status string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	switch status {
+	case "", domain.WebhookDeliveryStatusPending, domain.WebhookDeliveryStatusDelivered, domain.WebhookDeliveryStatusFailed:
+	default:
+		return nil, domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("invalid status: %s", status))
+	}
+	if _, err := s.GetByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 50
+	}
+	return s.Repo.GetDeliveries(ctx, subscriptionID, status, limit, max(offset, 0))
+}
+
+// Redeliver encola otra vez el mismo evento (mismo event_id, para que el receptor
+// pueda deduplicar) como una entrega nueva; la original queda en el log.
+func (s *Service) Redeliver(ctx context.Context, deliveryID int64) (*domain.WebhookDelivery, error) {
+	original, err := s.Repo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.NewAppError(domain.ErrCodeNotFound,
+				fmt.Sprintf("webhook delivery with ID %d not found", deliveryID))
+		}
+		return nil, err
+	}
+
+	delivery := &domain.WebhookDelivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		NextAttemptAt:  time.Now(),
+	}
+	if err := s.Repo.Enqueue(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
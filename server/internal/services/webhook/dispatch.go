@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+	"github.com/benitez96/gostore/internal/utils"
+)
+
+const (
+	// Reintentos: 30s, 1m, 2m... hasta 6h
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// Dispatch envía las entregas pendientes cuyo próximo intento ya llegó
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+
+	deliveries, err := s.Repo.GetDue(ctx, time.Now(), dispatchBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[int64]*domain.WebhookSubscription)
+	delivered := 0
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return delivered, err
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = s.Repo.GetSubscriptionByID(ctx, delivery.SubscriptionID)
+			if errors.Is(err, domain.ErrNotFound) {
+				// Se borró la suscripción; sus entregas se borran en cascada
+				continue
+			}
+			if err != nil {
+				return delivered, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		sendErr := s.send(ctx, subscription, delivery)
+		if sendErr == nil {
+			if err := s.Repo.MarkDelivered(ctx, delivery); err != nil {
+				return delivered, err
+			}
+			delivered++
+			continue
+		}
+
+		delivery.Attempts++
+		delivery.LastError = sendErr.Error()
+		delivery.NextAttemptAt = time.Now().Add(utils.Backoff(delivery.Attempts, baseBackoff, maxBackoff))
+		if delivery.Attempts >= s.Config.MaxAttempts {
+			delivery.Status = domain.WebhookDeliveryStatusFailed
+		}
+		log.Warn("webhook delivery failed",
+			"delivery_id", delivery.ID,
+			"subscription_id", subscription.ID,
+			"event", delivery.EventType,
+			"attempt", delivery.Attempts,
+			"error", sendErr,
+		)
+
+		if err := s.Repo.UpdateAttempt(ctx, delivery); err != nil {
+			return delivered, err
+		}
+	}
+
+	if delivered > 0 {
+		log.Info("webhooks delivered", "count", delivered)
+	}
+
+	return delivered, nil
+}
+
+// send hace el POST firmado y deja en delivery el status y el cuerpo de la respuesta.
+// Cualquier respuesta que no sea 2xx cuenta como fallida.
+func (s *Service) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) error {
+	delivery.ResponseStatus = nil
+	delivery.ResponseBody = ""
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GoStore-Webhooks/1.0")
+	req.Header.Set("X-GoStore-Event", delivery.EventType)
+	req.Header.Set("X-GoStore-Delivery", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set("X-GoStore-Timestamp", timestamp)
+	req.Header.Set("X-GoStore-Signature", "sha256="+sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	status := resp.StatusCode
+	delivery.ResponseStatus = &status
+	delivery.ResponseBody = string(body)
+
+	if status < 200 || status > 299 {
+		return fmt.Errorf("receiver responded %d", status)
+	}
+
+	return nil
+}
+
+// sign calcula HMAC-SHA256(secret, "<timestamp>.<payload>") en hexadecimal.
+// El receptor debe recalcularlo con el mismo secret y compararlo con X-GoStore-Signature;
+// incluir el timestamp le permite rechazar entregas repetidas o viejas.
+func sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) client() *http.Client {
+	if s.Client != nil {
+		return s.Client
+	}
+	return &http.Client{Timeout: time.Duration(s.Config.Timeout)}
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		payload   string
+		want      string
+	}{
+		{
+			name:      "evento",
+			secret:    "whsec_test",
+			timestamp: "1760000000",
+			payload:   `{"type":"payment.created"}`,
+			want:      "db8525ebb7d5c754e792a58ba3f0ad9813f7960acc7871b6a23c9d0819dfb772",
+		},
+		{
+			name:      "todo vacío",
+			timestamp: "0",
+			want:      "b849d5a581847b281957065739df36df2463d1977ea8d6e1e4e6cf33fadc68c3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sign(tt.secret, tt.timestamp, []byte(tt.payload)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSendSignsRequest verifica la firma como lo haría el receptor
+func TestSendSignsRequest(t *testing.T) {
+	const secret = "whsec_receptor"
+	payload := []byte(`{"id":"abc","type":"sale.created"}`)
+
+	var verified bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(r.Header.Get("X-GoStore-Timestamp") + "."))
+		mac.Write(body)
+		want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		verified = hmac.Equal([]byte(r.Header.Get("X-GoStore-Signature")), []byte(want))
+
+		if r.Header.Get("X-GoStore-Event") != "sale.created" || r.Header.Get("X-GoStore-Delivery") != "9" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	s := &Service{Client: receiver.Client()}
+	delivery := &domain.WebhookDelivery{ID: 9, EventType: "sale.created", Payload: payload}
+	err := s.send(context.Background(), &domain.WebhookSubscription{URL: receiver.URL, Secret: secret}, delivery)
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if !verified {
+		t.Error("the receiver could not verify the signature")
+	}
+	if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusNoContent {
+		t.Errorf("response status = %v, want 204", delivery.ResponseStatus)
+	}
+}
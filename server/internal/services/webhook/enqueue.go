@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/shared/logger"
+)
+
+// HandleEvent se suscribe al bus: guarda una entrega pendiente por cada
+// suscripción activa interesada. No envía nada, de eso se encarga Dispatch.
+func (s *Service) HandleEvent(ctx context.Context, event domain.Event) {
+	log := logger.FromContext(ctx).With("event", event.Type, "event_id", event.ID)
+
+	subscriptions, err := s.Repo.GetActiveSubscriptions(ctx)
+	if err != nil {
+		log.Error("webhook subscriptions unavailable, event dropped", "error", err)
+		return
+	}
+
+	var payload []byte
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				log.Error("webhook payload encoding failed", "error", err)
+				return
+			}
+		}
+
+		delivery := &domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			NextAttemptAt:  time.Now(),
+		}
+		if err := s.Repo.Enqueue(ctx, delivery); err != nil {
+			log.Error("webhook delivery not enqueued", "subscription_id", subscription.ID, "error", err)
+		}
+	}
+}
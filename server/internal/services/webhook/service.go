@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/benitez96/gostore/internal/config"
+	"github.com/benitez96/gostore/internal/ports"
+)
+
+// Make sure Service implements the WebhookService interface
+// at compile time.
+var _ ports.WebhookService = &Service{}
+
+const (
+	// dispatchBatch es la cantidad de entregas que se envían por corrida
+	dispatchBatch = 50
+	// maxResponseBody es lo que se guarda de la respuesta del receptor en el log
+	maxResponseBody = 1024
+)
+
+// Service administra las suscripciones a webhooks y entrega los eventos del bus:
+// HandleEvent los encola en SQLite y Dispatch los envía firmados, con reintentos
+// y backoff exponencial.
+type Service struct {
+	Repo   ports.WebhookRepository
+	Client *http.Client // Si es nil se usa uno con Config.Timeout
+	Config config.WebhooksConfig
+}
@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/dto"
+)
+
+func (s *Service) Create(ctx context.Context, req *dto.CreateWebhookRequest) (*domain.WebhookSubscription, error) {
+	subscription := &domain.WebhookSubscription{
+		URL:         strings.TrimSpace(req.URL),
+		Secret:      req.Secret,
+		Events:      req.Events,
+		Description: strings.TrimSpace(req.Description),
+		Active:      req.Active == nil || *req.Active,
+	}
+	if subscription.Secret == "" {
+		subscription.Secret = generateSecret()
+	}
+
+	if err := validate(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *Service) GetAll(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	return s.Repo.GetSubscriptions(ctx)
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+	subscription, err := s.Repo.GetSubscriptionByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, notFound(id)
+	}
+	return subscription, err
+}
+
+func (s *Service) Update(ctx context.Context, id int64, req *dto.UpdateWebhookRequest) (*domain.WebhookSubscription, error) {
+	subscription, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		subscription.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.Secret != nil {
+		subscription.Secret = *req.Secret
+	}
+	if req.Events != nil {
+		subscription.Events = req.Events
+	}
+	if req.Description != nil {
+		subscription.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+
+	if err := validate(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repo.UpdateSubscription(ctx, subscription); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, notFound(id)
+		}
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// Delete borra la suscripción junto con su log de entregas
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	err := s.Repo.DeleteSubscription(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return notFound(id)
+	}
+	return err
+}
+
+func validate(subscription *domain.WebhookSubscription) error {
+	u, err := url.ParseRequestURI(subscription.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "url must be an absolute http(s) URL")
+	}
+
+	if len(subscription.Secret) < 16 {
+		return domain.NewAppError(domain.ErrCodeInvalidParams, "secret must be at least 16 characters")
+	}
+
+	if len(subscription.Events) == 0 {
+		return domain.NewAppError(domain.ErrCodeInvalidParams,
+			fmt.Sprintf("events is required: use %q or any of %s", domain.WebhookAllEvents, strings.Join(domain.EventTypes, ", ")))
+	}
+	for _, event := range subscription.Events {
+		if event != domain.WebhookAllEvents && !slices.Contains(domain.EventTypes, event) {
+			return domain.NewAppError(domain.ErrCodeInvalidParams, fmt.Sprintf("unknown event: %s", event))
+		}
+	}
+
+	return nil
+}
+
+func generateSecret() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
+
+func notFound(id int64) error {
+	return domain.NewAppError(domain.ErrCodeNotFound, fmt.Sprintf("webhook with ID %d not found", id))
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunWorker envía las entregas pendientes cada DispatchInterval hasta que se cancele el contexto
+func (s *Service) RunWorker(ctx context.Context) {
+	interval := time.Duration(s.Config.DispatchInterval)
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	slog.Info("webhook worker started", "interval", interval.String())
+
+	s.runDispatch(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("webhook worker stopped")
+			return
+		case <-ticker.C:
+			s.runDispatch(ctx)
+		}
+	}
+}
+
+func (s *Service) runDispatch(ctx context.Context) {
+	if _, err := s.Dispatch(ctx); err != nil && ctx.Err() == nil {
+		slog.Error("webhook dispatch failed", "error", err)
+	}
+}
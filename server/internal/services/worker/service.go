@@ -2,17 +2,23 @@ package worker
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/benitez96/gostore/internal/domain"
+	"github.com/benitez96/gostore/internal/metrics"
+	"github.com/benitez96/gostore/internal/ports"
 	"github.com/benitez96/gostore/internal/repositories/db/sqlc"
 	"github.com/benitez96/gostore/internal/repositories/utils"
+	"github.com/benitez96/gostore/internal/shared/logger"
 )
 
 type Service struct {
-	Queries *sqlc.Queries
+	Queries  *sqlc.Queries
+	Interval time.Duration            // Frecuencia de la actualización programada (24h si es cero)
+	Events   ports.EventPublisher     // Opcional: publica client.state_changed
+	Promises ports.InteractionService // Opcional: marca las promesas de pago vencidas
 }
 
 // QuotaStateUpdate representa una actualización de estado de cuota
@@ -29,77 +35,114 @@ type SaleStateUpdate struct {
 
 // ClientStateUpdate representa una actualización de estado de cliente
 type ClientStateUpdate struct {
-	ID      int64
-	StateID int64
+	ID              int64
+	StateID         int64
+	PreviousStateID int64
 }
 
 // RunStateUpdateWorker ejecuta el worker de actualización de estados
 func (s *Service) RunStateUpdateWorker(ctx context.Context) {
-	log.Println("🚀 Starting state update worker...")
+	// Configurar ticker para ejecutar cada 24 horas salvo que se configure otro intervalo
+	interval := s.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	slog.Info("state update worker started", "interval", interval.String())
 
 	// Ejecutar inmediatamente al iniciar
-	s.updateStates()
+	s.updateStates(ctx)
 
-	// Configurar ticker para ejecutar cada 24 horas
-	ticker := time.NewTicker(24 * time.Hour)
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			log.Println("🛑 State update worker stopped")
+			slog.Info("state update worker stopped")
 			return
 		case <-ticker.C:
-			log.Println("⏰ Running scheduled state update...")
-			s.updateStates()
+			s.updateStates(ctx)
 		}
 	}
 }
 
-// UpdateStates actualiza los estados de cuotas, ventas y clientes
-func (s *Service) UpdateStates() {
+// UpdateStates actualiza los estados de cuotas, ventas y clientes. Los logs y eventos llevan
+// el request_id de ctx cuando la actualización se pide a mano desde la API.
+func (s *Service) UpdateStates(ctx context.Context) {
+	log := logger.FromContext(ctx)
 	start := time.Now()
-	log.Println("🔄 Starting state update process...")
+	log.Info("state update started")
 
 	// Actualizar estados de cuotas
-	quotaUpdates, err := s.updateQuotaStates()
+	quotaUpdates, err := s.updateQuotaStates(ctx)
 	if err != nil {
-		log.Printf("❌ Error updating quota states: %v", err)
+		log.Error("error updating quota states", "error", err)
+		metrics.StateWorkerRunFailures.Inc()
 		return
 	}
 
 	// Actualizar estados de ventas
-	saleUpdates, err := s.updateSaleStates()
+	saleUpdates, err := s.updateSaleStates(ctx)
 	if err != nil {
-		log.Printf("❌ Error updating sale states: %v", err)
+		log.Error("error updating sale states", "error", err)
+		metrics.StateWorkerRunFailures.Inc()
 		return
 	}
 
 	// Actualizar estados de clientes
-	clientUpdates, err := s.updateClientStates()
+	clientUpdates, err := s.updateClientStates(ctx)
+	if err != nil {
+		log.Error("error updating client states", "error", err)
+		metrics.StateWorkerRunFailures.Inc()
+		return
+	}
+
+	// Recalcular el puntaje de riesgo con los estados ya actualizados
+	riskUpdates, err := s.updateRiskScores(ctx)
 	if err != nil {
-		log.Printf("❌ Error updating client states: %v", err)
+		log.Error("error updating risk scores", "error", err)
+		metrics.StateWorkerRunFailures.Inc()
+		return
+	}
+
+	// Cerrar las promesas de pago cubiertas o vencidas
+	promiseUpdates, err := s.resolvePromises(ctx)
+	if err != nil {
+		log.Error("error resolving payment promises", "error", err)
+		metrics.StateWorkerRunFailures.Inc()
 		return
 	}
 
 	duration := time.Since(start)
-	log.Printf("✅ State update completed in %v", duration)
-	log.Printf("📊 Updated: %d quotas, %d sales, %d clients",
-		len(quotaUpdates), len(saleUpdates), len(clientUpdates))
+	metrics.StateWorkerRunDuration.Observe(duration.Seconds())
+	metrics.StateWorkerUpdates.WithLabelValues("quota").Add(float64(len(quotaUpdates)))
+	metrics.StateWorkerUpdates.WithLabelValues("sale").Add(float64(len(saleUpdates)))
+	metrics.StateWorkerUpdates.WithLabelValues("client").Add(float64(len(clientUpdates)))
+	metrics.StateWorkerUpdates.WithLabelValues("risk_score").Add(float64(riskUpdates))
+	metrics.StateWorkerUpdates.WithLabelValues("payment_promise").Add(float64(promiseUpdates))
+	log.Info("state update completed",
+		"duration", duration.String(),
+		"quotas", len(quotaUpdates),
+		"sales", len(saleUpdates),
+		"clients", len(clientUpdates),
+		"risk_scores", riskUpdates,
+		"payment_promises", promiseUpdates,
+	)
 }
 
 // updateStates actualiza los estados de cuotas, ventas y clientes (método privado para uso interno)
-func (s *Service) updateStates() {
-	s.UpdateStates()
+func (s *Service) updateStates(ctx context.Context) {
+	s.UpdateStates(ctx)
 }
 
 // updateQuotaStates actualiza los estados de las cuotas no pagadas
-func (s *Service) updateQuotaStates() ([]QuotaStateUpdate, error) {
-	ctx, cancel := utils.GetContext()
+func (s *Service) updateQuotaStates(ctx context.Context) ([]QuotaStateUpdate, error) {
+	log := logger.FromContext(ctx)
+	dbCtx, cancel := utils.GetContext()
 	defer cancel()
 
 	// Obtener todas las cuotas no pagadas
-	quotas, err := s.Queries.GetUnpaidQuotasForStateUpdate(ctx)
+	quotas, err := s.Queries.GetUnpaidQuotasForStateUpdate(dbCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -142,12 +185,12 @@ func (s *Service) updateQuotaStates() ([]QuotaStateUpdate, error) {
 	// Aplicar actualizaciones en lotes
 	if len(updates) > 0 {
 		for _, update := range updates {
-			err := s.Queries.UpdateQuotaStateBulk(ctx, sqlc.UpdateQuotaStateBulkParams{
+			err := s.Queries.UpdateQuotaStateBulk(dbCtx, sqlc.UpdateQuotaStateBulkParams{
 				StateID: update.StateID,
 				ID:      update.ID,
 			})
 			if err != nil {
-				log.Printf("❌ Error updating quota %d: %v", update.ID, err)
+				log.Error("error updating quota state", "quota_id", update.ID, "error", err)
 			}
 		}
 	}
@@ -156,12 +199,13 @@ func (s *Service) updateQuotaStates() ([]QuotaStateUpdate, error) {
 }
 
 // updateSaleStates actualiza los estados de las ventas basándose en sus cuotas
-func (s *Service) updateSaleStates() ([]SaleStateUpdate, error) {
-	ctx, cancel := utils.GetContext()
+func (s *Service) updateSaleStates(ctx context.Context) ([]SaleStateUpdate, error) {
+	log := logger.FromContext(ctx)
+	dbCtx, cancel := utils.GetContext()
 	defer cancel()
 
 	// Obtener ventas que tienen cuotas no pagadas
-	sales, err := s.Queries.GetSalesByQuotaStates(ctx)
+	sales, err := s.Queries.GetSalesByQuotaStates(dbCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -178,9 +222,9 @@ func (s *Service) updateSaleStates() ([]SaleStateUpdate, error) {
 			defer wg.Done()
 
 			// Obtener todas las cuotas de esta venta
-			quotas, err := s.Queries.GetSaleQuotas(ctx, saleRow.ID)
+			quotas, err := s.Queries.GetSaleQuotas(dbCtx, saleRow.ID)
 			if err != nil {
-				log.Printf("❌ Error getting quotas for sale %d: %v", saleRow.ID, err)
+				log.Error("error getting sale quotas", "sale_id", saleRow.ID, "error", err)
 				return
 			}
 
@@ -211,12 +255,12 @@ func (s *Service) updateSaleStates() ([]SaleStateUpdate, error) {
 	// Aplicar actualizaciones
 	if len(updates) > 0 {
 		for _, update := range updates {
-			err := s.Queries.UpdateSaleStateBulk(ctx, sqlc.UpdateSaleStateBulkParams{
+			err := s.Queries.UpdateSaleStateBulk(dbCtx, sqlc.UpdateSaleStateBulkParams{
 				StateID: update.StateID,
 				ID:      update.ID,
 			})
 			if err != nil {
-				log.Printf("❌ Error updating sale %d: %v", update.ID, err)
+				log.Error("error updating sale state", "sale_id", update.ID, "error", err)
 			}
 		}
 	}
@@ -225,12 +269,13 @@ func (s *Service) updateSaleStates() ([]SaleStateUpdate, error) {
 }
 
 // updateClientStates actualiza los estados de los clientes basándose en sus ventas
-func (s *Service) updateClientStates() ([]ClientStateUpdate, error) {
-	ctx, cancel := utils.GetContext()
+func (s *Service) updateClientStates(ctx context.Context) ([]ClientStateUpdate, error) {
+	log := logger.FromContext(ctx)
+	dbCtx, cancel := utils.GetContext()
 	defer cancel()
 
 	// Obtener clientes que tienen ventas no pagadas
-	clients, err := s.Queries.GetClientsBySaleStates(ctx)
+	clients, err := s.Queries.GetClientsBySaleStates(dbCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -247,9 +292,9 @@ func (s *Service) updateClientStates() ([]ClientStateUpdate, error) {
 			defer wg.Done()
 
 			// Obtener todas las ventas de este cliente
-			sales, err := s.Queries.GetSalesByClientID(ctx, clientRow.ID)
+			sales, err := s.Queries.GetSalesByClientID(dbCtx, clientRow.ID)
 			if err != nil {
-				log.Printf("❌ Error getting sales for client %d: %v", clientRow.ID, err)
+				log.Error("error getting client sales", "client_id", clientRow.ID, "error", err)
 				return
 			}
 
@@ -259,8 +304,9 @@ func (s *Service) updateClientStates() ([]ClientStateUpdate, error) {
 			// Solo actualizar si el estado cambió
 			if newStateID != clientRow.StateID {
 				updateChan <- ClientStateUpdate{
-					ID:      clientRow.ID,
-					StateID: newStateID,
+					ID:              clientRow.ID,
+					StateID:         newStateID,
+					PreviousStateID: clientRow.StateID,
 				}
 			}
 		}(client)
@@ -280,12 +326,20 @@ func (s *Service) updateClientStates() ([]ClientStateUpdate, error) {
 	// Aplicar actualizaciones
 	if len(updates) > 0 {
 		for _, update := range updates {
-			err := s.Queries.UpdateClientStateBulk(ctx, sqlc.UpdateClientStateBulkParams{
+			err := s.Queries.UpdateClientStateBulk(dbCtx, sqlc.UpdateClientStateBulkParams{
 				StateID: update.StateID,
 				ID:      update.ID,
 			})
 			if err != nil {
-				log.Printf("❌ Error updating client %d: %v", update.ID, err)
+				log.Error("error updating client state", "client_id", update.ID, "error", err)
+				continue
+			}
+			if s.Events != nil {
+				s.Events.Publish(context.WithoutCancel(ctx), domain.NewEvent(domain.EventClientStateChanged, domain.ClientStateChangedData{
+					ClientID:        update.ID,
+					PreviousStateID: int(update.PreviousStateID),
+					StateID:         int(update.StateID),
+				}))
 			}
 		}
 	}
@@ -293,6 +347,64 @@ func (s *Service) updateClientStates() ([]ClientStateUpdate, error) {
 	return updates, nil
 }
 
+// updateRiskScores recalcula el puntaje de riesgo de todos los clientes con su historial de pagos
+// y guarda los que cambiaron; devuelve cuántos se actualizaron
+func (s *Service) updateRiskScores(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+	dbCtx, cancel := utils.GetContext()
+	defer cancel()
+
+	clients, err := s.Queries.GetClientsRiskCounters(dbCtx)
+	if err != nil {
+		return 0, err
+	}
+	quotas, err := s.Queries.GetQuotasForRiskScore(dbCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	stats := make(map[int64]*domain.RiskStats, len(clients))
+	for _, client := range clients {
+		stats[client.ID] = &domain.RiskStats{
+			WarningCount:   client.WarningCount,
+			SuspendedCount: client.SuspendedCount,
+		}
+	}
+	for _, q := range quotas {
+		if st, ok := stats[q.ClientID]; ok {
+			st.AddQuota(q.Amount, q.PaidAmount, q.DueDate, q.IsPaid.Bool, q.DaysLatePaid, now)
+		}
+	}
+
+	updated := 0
+	for _, client := range clients {
+		score := stats[client.ID].Score()
+		if int64(score) == client.RiskScore {
+			continue
+		}
+		err := s.Queries.UpdateClientRiskScore(dbCtx, sqlc.UpdateClientRiskScoreParams{
+			RiskScore: int64(score),
+			ID:        client.ID,
+		})
+		if err != nil {
+			log.Error("error updating risk score", "client_id", client.ID, "error", err)
+			continue
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// resolvePromises marca como cumplidas o rotas las promesas de pago pendientes de todos los clientes
+func (s *Service) resolvePromises(ctx context.Context) (int, error) {
+	if s.Promises == nil {
+		return 0, nil
+	}
+	return s.Promises.ResolvePromises(ctx, 0)
+}
+
 // determineQuotaState determina el estado de una cuota basándose en su fecha de vencimiento
 func (s *Service) determineQuotaState(dueDate time.Time) int {
 	now := time.Now()
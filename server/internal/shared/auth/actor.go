@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/benitez96/gostore/internal/domain"
+)
+
+type contextKey string
+
+const actorKey contextKey = "actor"
+
+// WithActor guarda en el contexto quién realiza la operación (lo hace el middleware de autenticación)
+func WithActor(ctx context.Context, actor *domain.Actor) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFromContext permite a los servicios identificar quién realiza la operación
+// sin depender del middleware HTTP. Sin actor (procesos internos) devuelve false.
+func ActorFromContext(ctx context.Context) (*domain.Actor, bool) {
+	actor, ok := ctx.Value(actorKey).(*domain.Actor)
+	return actor, ok
+}
+
+// Author devuelve el usuario y el nombre que se guardan como autor de un registro.
+// Solo los usuarios tienen ID; las API keys quedan con su nombre y los procesos internos como "sistema".
+func Author(ctx context.Context) (*int64, string) {
+	actor, ok := ActorFromContext(ctx)
+	if !ok {
+		return nil, "sistema"
+	}
+	if actor.Type != domain.ActorTypeUser {
+		return nil, actor.Name
+	}
+	userID := actor.ID
+	return &userID, actor.Name
+}
@@ -8,6 +8,7 @@ const (
 	PermissionDashboard int64 = 4  // 100 - Opera sobre dashboards (gráficos, estadísticas, etc.)
 	PermissionSales     int64 = 8  // 1000 - Opera sobre ventas
 	PermissionUsers     int64 = 16 // 10000 - Opera sobre usuarios (típicamente solo admin)
+	PermissionCollector int64 = 32 // 100000 - Cobrador: ve su hoja de ruta y registra pagos de sus clientes
 
 	// Combinaciones útiles
 	PermissionOperator = PermissionClients | PermissionProducts | PermissionSales // Operador básico
@@ -28,6 +29,8 @@ func GetPermissionName(permission int64) string {
 		return "Ventas"
 	case PermissionUsers:
 		return "Usuarios"
+	case PermissionCollector:
+		return "Cobrador"
 	default:
 		return "Permiso Desconocido"
 	}
@@ -52,6 +55,9 @@ func GetPermissionNames(userPermissions int64) []string {
 	if HasPermission(userPermissions, PermissionUsers) {
 		permissions = append(permissions, "Usuarios")
 	}
+	if HasPermission(userPermissions, PermissionCollector) {
+		permissions = append(permissions, "Cobrador")
+	}
 
 	if len(permissions) == 0 {
 		return []string{"Sin permisos"}
@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "request_id"
+
+// New crea el logger JSON de la aplicación. En desarrollo también se emiten los mensajes de debug.
+func New(production bool) *slog.Logger {
+	level := slog.LevelDebug
+	if production {
+		level = slog.LevelInfo
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level}))
+}
+
+// WithRequestID guarda el ID del request en el contexto
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID devuelve el ID del request guardado en el contexto, o "" si no hay
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// FromContext devuelve el logger por defecto con el request_id del contexto,
+// para que los logs de servicios se puedan correlacionar con el request.
+func FromContext(ctx context.Context) *slog.Logger {
+	if id := RequestID(ctx); id != "" {
+		return slog.Default().With("request_id", id)
+	}
+	return slog.Default()
+}
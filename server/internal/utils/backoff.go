@@ -0,0 +1,13 @@
+package utils
+
+import "time"
+
+// Backoff duplica la espera en cada intento a partir de base, sin pasar de limit.
+// El intento 1 espera base.
+func Backoff(attempt int, base, limit time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempt && wait < limit; i++ {
+		wait *= 2
+	}
+	return min(wait, limit)
+}
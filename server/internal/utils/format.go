@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatMoney formatea un monto con separador de miles (punto) y decimales (coma) - formato argentino
+func FormatMoney(amount float64) string {
+	// Convertir a string con 2 decimales
+	formatted := fmt.Sprintf("%.2f", amount)
+
+	// Separar la parte entera de los decimales
+	parts := strings.Split(formatted, ".")
+	integerPart := parts[0]
+	decimalPart := parts[1]
+
+	// Agregar separadores de miles (puntos) a la parte entera
+	if len(integerPart) > 3 {
+		var result strings.Builder
+		for i, digit := range integerPart {
+			if i > 0 && (len(integerPart)-i)%3 == 0 {
+				result.WriteString(".")
+			}
+			result.WriteRune(digit)
+		}
+		integerPart = result.String()
+	}
+
+	// Retornar con formato argentino: separador de miles (.) y decimales (,)
+	return integerPart + "," + decimalPart
+}
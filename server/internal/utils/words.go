@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var unitWords = []string{
+	"", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+	"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+var tensWords = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+
+var hundredsWords = []string{"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos"}
+
+// AmountInWords escribe un monto en letras como se usa en pagarés y contratos,
+// por ejemplo 1521.5 -> "mil quinientos veintiuno con 50/100"
+func AmountInWords(amount float64) string {
+	cents := int64(math.Round(math.Abs(amount) * 100))
+	return fmt.Sprintf("%s con %02d/100", NumberToWords(cents/100), cents%100)
+}
+
+// NumberToWords escribe un número entero no negativo en letras (en minúsculas)
+func NumberToWords(n int64) string {
+	if n == 0 {
+		return "cero"
+	}
+	return integerWords(n, false)
+}
+
+// integerWords arma las palabras de n; con apocope el "uno" final pasa a "un" (delante de mil/millones)
+func integerWords(n int64, apocope bool) string {
+	var parts []string
+
+	if millions := n / 1_000_000; millions > 0 {
+		if millions == 1 {
+			parts = append(parts, "un millón")
+		} else {
+			parts = append(parts, integerWords(millions, true)+" millones")
+		}
+	}
+
+	if thousands := n % 1_000_000 / 1000; thousands > 0 {
+		if thousands == 1 {
+			parts = append(parts, "mil")
+		} else {
+			parts = append(parts, hundredWords(thousands, true)+" mil")
+		}
+	}
+
+	if rest := n % 1000; rest > 0 {
+		parts = append(parts, hundredWords(rest, apocope))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// hundredWords escribe un número de 1 a 999
+func hundredWords(n int64, apocope bool) string {
+	if n == 100 {
+		return "cien"
+	}
+
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, hundredsWords[n/100])
+	}
+	if rest := n % 100; rest > 0 {
+		parts = append(parts, tenWords(rest, apocope))
+	}
+	return strings.Join(parts, " ")
+}
+
+// tenWords escribe un número de 1 a 99
+func tenWords(n int64, apocope bool) string {
+	if n < 30 {
+		switch {
+		case apocope && n == 1:
+			return "un"
+		case apocope && n == 21:
+			return "veintiún"
+		}
+		return unitWords[n]
+	}
+
+	words := tensWords[n/10]
+	if unit := n % 10; unit > 0 {
+		if apocope && unit == 1 {
+			return words + " y un"
+		}
+		return words + " y " + unitWords[unit]
+	}
+	return words
+}
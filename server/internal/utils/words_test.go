@@ -0,0 +1,25 @@
+package utils
+
+import "testing"
+
+func TestAmountInWords(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   string
+	}{
+		{amount: 1, want: "uno con 00/100"},
+		{amount: 21, want: "veintiuno con 00/100"},
+		{amount: 100, want: "cien con 00/100"},
+		{amount: 101, want: "ciento uno con 00/100"},
+		{amount: 1521.5, want: "mil quinientos veintiuno con 50/100"},
+		{amount: 21000, want: "veintiún mil con 00/100"},
+		{amount: 1_000_000, want: "un millón con 00/100"},
+		{amount: 1_000_000_000, want: "mil millones con 00/100"},
+	}
+
+	for _, tt := range tests {
+		if got := AmountInWords(tt.amount); got != tt.want {
+			t.Errorf("AmountInWords(%v) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}